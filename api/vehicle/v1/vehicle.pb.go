@@ -9,6 +9,9 @@ package vehiclev1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +24,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FuelType int32
+
+const (
+	FuelType_FUEL_TYPE_UNSPECIFIED FuelType = 0
+	FuelType_FUEL_TYPE_ICE         FuelType = 1 //燃油
+	FuelType_FUEL_TYPE_EV          FuelType = 2 //纯电
+	FuelType_FUEL_TYPE_HYBRID      FuelType = 3 //混动
+)
+
+// Enum value maps for FuelType.
+var (
+	FuelType_name = map[int32]string{
+		0: "FUEL_TYPE_UNSPECIFIED",
+		1: "FUEL_TYPE_ICE",
+		2: "FUEL_TYPE_EV",
+		3: "FUEL_TYPE_HYBRID",
+	}
+	FuelType_value = map[string]int32{
+		"FUEL_TYPE_UNSPECIFIED": 0,
+		"FUEL_TYPE_ICE":         1,
+		"FUEL_TYPE_EV":          2,
+		"FUEL_TYPE_HYBRID":      3,
+	}
+)
+
+func (x FuelType) Enum() *FuelType {
+	p := new(FuelType)
+	*p = x
+	return p
+}
+
+func (x FuelType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FuelType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vehicle_v1_vehicle_proto_enumTypes[0].Descriptor()
+}
+
+func (FuelType) Type() protoreflect.EnumType {
+	return &file_vehicle_v1_vehicle_proto_enumTypes[0]
+}
+
+func (x FuelType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FuelType.Descriptor instead.
+func (FuelType) EnumDescriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{0}
+}
+
 type VehicleStatus int32
 
 const (
@@ -54,11 +109,11 @@ func (x VehicleStatus) String() string {
 }
 
 func (VehicleStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_vehicle_v1_vehicle_proto_enumTypes[0].Descriptor()
+	return file_vehicle_v1_vehicle_proto_enumTypes[1].Descriptor()
 }
 
 func (VehicleStatus) Type() protoreflect.EnumType {
-	return &file_vehicle_v1_vehicle_proto_enumTypes[0]
+	return &file_vehicle_v1_vehicle_proto_enumTypes[1]
 }
 
 func (x VehicleStatus) Number() protoreflect.EnumNumber {
@@ -67,7 +122,65 @@ func (x VehicleStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VehicleStatus.Descriptor instead.
 func (VehicleStatus) EnumDescriptor() ([]byte, []int) {
-	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{0}
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{1}
+}
+
+type VehicleEventType int32
+
+const (
+	VehicleEventType_VEHICLE_EVENT_TYPE_UNSPECIFIED VehicleEventType = 0
+	VehicleEventType_VEHICLE_EVENT_TYPE_SNAPSHOT    VehicleEventType = 1 //全量列表中的车辆
+	VehicleEventType_VEHICLE_EVENT_TYPE_SYNCED      VehicleEventType = 2 //全量列表发送完毕，没有 vehicle
+	VehicleEventType_VEHICLE_EVENT_TYPE_CREATED     VehicleEventType = 3 //新增或恢复
+	VehicleEventType_VEHICLE_EVENT_TYPE_UPDATED     VehicleEventType = 4
+	VehicleEventType_VEHICLE_EVENT_TYPE_DELETED     VehicleEventType = 5 //vehicle 为删除前的数据
+)
+
+// Enum value maps for VehicleEventType.
+var (
+	VehicleEventType_name = map[int32]string{
+		0: "VEHICLE_EVENT_TYPE_UNSPECIFIED",
+		1: "VEHICLE_EVENT_TYPE_SNAPSHOT",
+		2: "VEHICLE_EVENT_TYPE_SYNCED",
+		3: "VEHICLE_EVENT_TYPE_CREATED",
+		4: "VEHICLE_EVENT_TYPE_UPDATED",
+		5: "VEHICLE_EVENT_TYPE_DELETED",
+	}
+	VehicleEventType_value = map[string]int32{
+		"VEHICLE_EVENT_TYPE_UNSPECIFIED": 0,
+		"VEHICLE_EVENT_TYPE_SNAPSHOT":    1,
+		"VEHICLE_EVENT_TYPE_SYNCED":      2,
+		"VEHICLE_EVENT_TYPE_CREATED":     3,
+		"VEHICLE_EVENT_TYPE_UPDATED":     4,
+		"VEHICLE_EVENT_TYPE_DELETED":     5,
+	}
+)
+
+func (x VehicleEventType) Enum() *VehicleEventType {
+	p := new(VehicleEventType)
+	*p = x
+	return p
+}
+
+func (x VehicleEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VehicleEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_vehicle_v1_vehicle_proto_enumTypes[2].Descriptor()
+}
+
+func (VehicleEventType) Type() protoreflect.EnumType {
+	return &file_vehicle_v1_vehicle_proto_enumTypes[2]
+}
+
+func (x VehicleEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VehicleEventType.Descriptor instead.
+func (VehicleEventType) EnumDescriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{2}
 }
 
 type GetVehicleRequest struct {
@@ -162,6 +275,14 @@ type CreateVehicleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
 	LicensePlate  string                 `protobuf:"bytes,2,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` //所属租户，只有平台管理员可以指定，其他角色固定为自己的租户
+	Make          string                 `protobuf:"bytes,4,opt,name=make,proto3" json:"make,omitempty"`
+	Model         string                 `protobuf:"bytes,5,opt,name=model,proto3" json:"model,omitempty"`
+	Year          int32                  `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	FuelType      FuelType               `protobuf:"varint,7,opt,name=fuel_type,json=fuelType,proto3,enum=vehicle.v1.FuelType" json:"fuel_type,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,9,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CapacityKg    int32                  `protobuf:"varint,10,opt,name=capacity_kg,json=capacityKg,proto3" json:"capacity_kg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,6 +331,62 @@ func (x *CreateVehicleRequest) GetLicensePlate() string {
 	return ""
 }
 
+func (x *CreateVehicleRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateVehicleRequest) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *CreateVehicleRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CreateVehicleRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CreateVehicleRequest) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+func (x *CreateVehicleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateVehicleRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateVehicleRequest) GetCapacityKg() int32 {
+	if x != nil {
+		return x.CapacityKg
+	}
+	return 0
+}
+
 type CreateVehicleReponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VehicleId     string                 `protobuf:"bytes,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
@@ -260,6 +437,20 @@ type Vehicle struct {
 	Vin           string                 `protobuf:"bytes,2,opt,name=vin,proto3" json:"vin,omitempty"`
 	LicensePlate  string                 `protobuf:"bytes,3,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
 	Status        VehicleStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=vehicle.v1.VehicleStatus" json:"status,omitempty"`
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                //所属租户
+	Location      *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`                                //最近一次上报的位置，没有上报过时为空
+	LastHeartbeat *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"` //没有上报过心跳时为空
+	Telemetry     *structpb.Struct       `protobuf:"bytes,8,opt,name=telemetry,proto3" json:"telemetry,omitempty"`                              //最近一次上报的完整遥测数据
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Version       int64                  `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"` //修改车辆信息时加一，用于乐观并发控制 (网关的 ETag)
+	Make          string                 `protobuf:"bytes,13,opt,name=make,proto3" json:"make,omitempty"`        //品牌
+	Model         string                 `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`      //车型
+	Year          int32                  `protobuf:"varint,15,opt,name=year,proto3" json:"year,omitempty"`       //年款，0 表示未填写
+	FuelType      FuelType               `protobuf:"varint,16,opt,name=fuel_type,json=fuelType,proto3,enum=vehicle.v1.FuelType" json:"fuel_type,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,17,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` //客户自定义属性
+	CapacityKg    int32                  `protobuf:"varint,18,opt,name=capacity_kg,json=capacityKg,proto3" json:"capacity_kg,omitempty"`                                                        //额定载质量 (kg)，0 表示未填写
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -322,28 +513,127 @@ func (x *Vehicle) GetStatus() VehicleStatus {
 	return VehicleStatus_VEHICLE_STATUS_UNSPECIFIED
 }
 
-type ListVehiclesRequest struct {
+func (x *Vehicle) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Vehicle) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Vehicle) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+func (x *Vehicle) GetTelemetry() *structpb.Struct {
+	if x != nil {
+		return x.Telemetry
+	}
+	return nil
+}
+
+func (x *Vehicle) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Vehicle) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Vehicle) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Vehicle) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Vehicle) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Vehicle) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+func (x *Vehicle) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Vehicle) GetCapacityKg() int32 {
+	if x != nil {
+		return x.CapacityKg
+	}
+	return 0
+}
+
+type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         //页码
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` //一页有多少
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVehiclesRequest) Reset() {
-	*x = ListVehiclesRequest{}
+func (x *Location) Reset() {
+	*x = Location{}
 	mi := &file_vehicle_v1_vehicle_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVehiclesRequest) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVehiclesRequest) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_vehicle_v1_vehicle_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,47 +645,83 @@ func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
-func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListVehiclesRequest) GetPage() int32 {
+func (x *Location) GetLatitude() float64 {
 	if x != nil {
-		return x.Page
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *ListVehiclesRequest) GetPageSize() int32 {
+func (x *Location) GetLongitude() float64 {
 	if x != nil {
-		return x.PageSize
+		return x.Longitude
 	}
 	return 0
 }
 
-type ListVehiclesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vehicles      []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`                        //车辆列表
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` //总数
+func (x *Location) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListVehiclesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         //页码，旧的 offset 分页；传了 page_token 时忽略
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` //一页有多少，最大 500
+	//过滤条件，多个条件同时满足
+	Status             VehicleStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=vehicle.v1.VehicleStatus" json:"status,omitempty"`
+	LicensePlatePrefix string                 `protobuf:"bytes,4,opt,name=license_plate_prefix,json=licensePlatePrefix,proto3" json:"license_plate_prefix,omitempty"`
+	VinPrefix          string                 `protobuf:"bytes,5,opt,name=vin_prefix,json=vinPrefix,proto3" json:"vin_prefix,omitempty"`
+	HeartbeatAfter     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=heartbeat_after,json=heartbeatAfter,proto3" json:"heartbeat_after,omitempty"`    //last_heartbeat >= heartbeat_after
+	HeartbeatBefore    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=heartbeat_before,json=heartbeatBefore,proto3" json:"heartbeat_before,omitempty"` //last_heartbeat < heartbeat_before
+	Tags               []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                              //同时带有全部标签
+	//排序，格式参考 AIP-132: "<字段> [asc|desc]"，默认 "created_at desc"
+	//可选字段: created_at, updated_at, last_heartbeat, vin, license_plate, status
+	OrderBy string `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	//游标分页: 传入上一页返回的 next_page_token，翻页期间新增的车辆不会导致重复或遗漏
+	//游标与过滤条件和排序绑定，条件变化后需要从第一页重新开始
+	PageToken string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	//是否返回 total_count，需要额外执行一次 COUNT，不需要总数时不要传
+	IncludeTotal bool `protobuf:"varint,11,opt,name=include_total,json=includeTotal,proto3" json:"include_total,omitempty"`
+	//只返回该分组的车辆，include_subgroups 为 true 时包括所有下级分组
+	GroupId          string `protobuf:"bytes,12,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	IncludeSubgroups bool   `protobuf:"varint,13,opt,name=include_subgroups,json=includeSubgroups,proto3" json:"include_subgroups,omitempty"`
+	//品牌、车型忽略大小写精确匹配；年款范围包含两端，0 表示不限
+	Make     string   `protobuf:"bytes,14,opt,name=make,proto3" json:"make,omitempty"`
+	Model    string   `protobuf:"bytes,15,opt,name=model,proto3" json:"model,omitempty"`
+	YearMin  int32    `protobuf:"varint,16,opt,name=year_min,json=yearMin,proto3" json:"year_min,omitempty"`
+	YearMax  int32    `protobuf:"varint,17,opt,name=year_max,json=yearMax,proto3" json:"year_max,omitempty"`
+	FuelType FuelType `protobuf:"varint,18,opt,name=fuel_type,json=fuelType,proto3,enum=vehicle.v1.FuelType" json:"fuel_type,omitempty"`
+	//同时带有全部属性且值相同
+	Attributes map[string]string `protobuf:"bytes,19,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	//额定载质量范围 (kg)，包含两端，0 表示不限
+	CapacityKgMin int32 `protobuf:"varint,20,opt,name=capacity_kg_min,json=capacityKgMin,proto3" json:"capacity_kg_min,omitempty"`
+	CapacityKgMax int32 `protobuf:"varint,21,opt,name=capacity_kg_max,json=capacityKgMax,proto3" json:"capacity_kg_max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListVehiclesResponse) Reset() {
-	*x = ListVehiclesResponse{}
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
 	mi := &file_vehicle_v1_vehicle_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListVehiclesResponse) String() string {
+func (x *ListVehiclesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListVehiclesResponse) ProtoMessage() {}
+func (*ListVehiclesRequest) ProtoMessage() {}
 
-func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_vehicle_v1_vehicle_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,106 +733,4158 @@ func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
-func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
 	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+func (x *ListVehiclesRequest) GetPage() int32 {
 	if x != nil {
-		return x.Vehicles
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *ListVehiclesResponse) GetTotalCount() int32 {
+func (x *ListVehiclesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.PageSize
 	}
 	return 0
 }
 
-var File_vehicle_v1_vehicle_proto protoreflect.FileDescriptor
-
-const file_vehicle_v1_vehicle_proto_rawDesc = "" +
-	"\n" +
-	"\x18vehicle/v1/vehicle.proto\x12\n" +
-	"vehicle.v1\"2\n" +
-	"\x11GetVehicleRequest\x12\x1d\n" +
-	"\n" +
-	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\"C\n" +
-	"\x12GetVehicleResponse\x12-\n" +
-	"\avehicle\x18\x01 \x01(\v2\x13.vehicle.v1.VehicleR\avehicle\"M\n" +
-	"\x14CreateVehicleRequest\x12\x10\n" +
-	"\x03vin\x18\x01 \x01(\tR\x03vin\x12#\n" +
-	"\rlicense_plate\x18\x02 \x01(\tR\flicensePlate\"5\n" +
-	"\x14CreateVehicleReponse\x12\x1d\n" +
-	"\n" +
-	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\"\x83\x01\n" +
-	"\aVehicle\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03vin\x18\x02 \x01(\tR\x03vin\x12#\n" +
-	"\rlicense_plate\x18\x03 \x01(\tR\flicensePlate\x121\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x19.vehicle.v1.VehicleStatusR\x06status\"F\n" +
-	"\x13ListVehiclesRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"h\n" +
-	"\x14ListVehiclesResponse\x12/\n" +
-	"\bvehicles\x18\x01 \x03(\v2\x13.vehicle.v1.VehicleR\bvehicles\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount*f\n" +
-	"\rVehicleStatus\x12\x1e\n" +
-	"\x1aVEHICLE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
-	"\x16VEHICLE_STATUS_OFFLINE\x10\x01\x12\x19\n" +
-	"\x15VEHICLE_STATUS_ONLINE\x10\x022\x85\x02\n" +
-	"\x0eVehicleService\x12K\n" +
-	"\n" +
-	"GetVehicle\x12\x1d.vehicle.v1.GetVehicleRequest\x1a\x1e.vehicle.v1.GetVehicleResponse\x12S\n" +
-	"\rCreateVehicle\x12 .vehicle.v1.CreateVehicleRequest\x1a .vehicle.v1.CreateVehicleReponse\x12Q\n" +
-	"\fListVehicles\x12\x1f.vehicle.v1.ListVehiclesRequest\x1a .vehicle.v1.ListVehiclesResponseB\x9c\x01\n" +
-	"\x0ecom.vehicle.v1B\fVehicleProtoP\x01Z3github.com/xuewentao/cheya/api/vehicle/v1;vehiclev1\xa2\x02\x03VXX\xaa\x02\n" +
-	"Vehicle.V1\xca\x02\n" +
-	"Vehicle\\V1\xe2\x02\x16Vehicle\\V1\\GPBMetadata\xea\x02\vVehicle::V1b\x06proto3"
+func (x *ListVehiclesRequest) GetStatus() VehicleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VehicleStatus_VEHICLE_STATUS_UNSPECIFIED
+}
 
-var (
-	file_vehicle_v1_vehicle_proto_rawDescOnce sync.Once
-	file_vehicle_v1_vehicle_proto_rawDescData []byte
-)
+func (x *ListVehiclesRequest) GetLicensePlatePrefix() string {
+	if x != nil {
+		return x.LicensePlatePrefix
+	}
+	return ""
+}
 
-func file_vehicle_v1_vehicle_proto_rawDescGZIP() []byte {
+func (x *ListVehiclesRequest) GetVinPrefix() string {
+	if x != nil {
+		return x.VinPrefix
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetHeartbeatAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeartbeatAfter
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetHeartbeatBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.HeartbeatBefore
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetIncludeTotal() bool {
+	if x != nil {
+		return x.IncludeTotal
+	}
+	return false
+}
+
+func (x *ListVehiclesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetIncludeSubgroups() bool {
+	if x != nil {
+		return x.IncludeSubgroups
+	}
+	return false
+}
+
+func (x *ListVehiclesRequest) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ListVehiclesRequest) GetYearMin() int32 {
+	if x != nil {
+		return x.YearMin
+	}
+	return 0
+}
+
+func (x *ListVehiclesRequest) GetYearMax() int32 {
+	if x != nil {
+		return x.YearMax
+	}
+	return 0
+}
+
+func (x *ListVehiclesRequest) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+func (x *ListVehiclesRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ListVehiclesRequest) GetCapacityKgMin() int32 {
+	if x != nil {
+		return x.CapacityKgMin
+	}
+	return 0
+}
+
+func (x *ListVehiclesRequest) GetCapacityKgMax() int32 {
+	if x != nil {
+		return x.CapacityKgMax
+	}
+	return 0
+}
+
+type ListVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicles      []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`                                  //车辆列表
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           //总数
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` //为空表示没有下一页
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *ListVehiclesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListVehiclesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateVehicleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Vin          string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	LicensePlate string                 `protobuf:"bytes,2,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	Tags         []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	//要更新的字段: license_plate, tags, make, model, year, fuel_type, attributes, capacity_kg；为空时只更新非空的字段
+	//update_mask 中的字段值为空时清除该字段 (license_plate 除外)
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	//大于 0 时只有当前版本号相同才更新，否则返回带 PreconditionFailure 详情的 FAILED_PRECONDITION
+	ExpectedVersion int64             `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Make            string            `protobuf:"bytes,6,opt,name=make,proto3" json:"make,omitempty"`
+	Model           string            `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`
+	Year            int32             `protobuf:"varint,8,opt,name=year,proto3" json:"year,omitempty"`
+	FuelType        FuelType          `protobuf:"varint,9,opt,name=fuel_type,json=fuelType,proto3,enum=vehicle.v1.FuelType" json:"fuel_type,omitempty"`
+	Attributes      map[string]string `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` //整体替换
+	CapacityKg      int32             `protobuf:"varint,11,opt,name=capacity_kg,json=capacityKg,proto3" json:"capacity_kg,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateVehicleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateVehicleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetMake() string {
+	if x != nil {
+		return x.Make
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetFuelType() FuelType {
+	if x != nil {
+		return x.FuelType
+	}
+	return FuelType_FUEL_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateVehicleRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVehicleRequest) GetCapacityKg() int32 {
+	if x != nil {
+		return x.CapacityKg
+	}
+	return 0
+}
+
+type UpdateVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicle       *Vehicle               `protobuf:"bytes,1,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleResponse) Reset() {
+	*x = UpdateVehicleResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleResponse) ProtoMessage() {}
+
+func (x *UpdateVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateVehicleResponse) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type DeleteVehicleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Vin             string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` //同 UpdateVehicleRequest.expected_version
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleRequest) Reset() {
+	*x = DeleteVehicleRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleRequest) ProtoMessage() {}
+
+func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *DeleteVehicleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleResponse) Reset() {
+	*x = DeleteVehicleResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleResponse) ProtoMessage() {}
+
+func (x *DeleteVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{11}
+}
+
+type RestoreVehicleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Vin             string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` //同 UpdateVehicleRequest.expected_version
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RestoreVehicleRequest) Reset() {
+	*x = RestoreVehicleRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVehicleRequest) ProtoMessage() {}
+
+func (x *RestoreVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVehicleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestoreVehicleRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *RestoreVehicleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RestoreVehicleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicle       *Vehicle               `protobuf:"bytes,1,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVehicleResponse) Reset() {
+	*x = RestoreVehicleResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVehicleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVehicleResponse) ProtoMessage() {}
+
+func (x *RestoreVehicleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVehicleResponse.ProtoReflect.Descriptor instead.
+func (*RestoreVehicleResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreVehicleResponse) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+type GetVehicleHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` //默认 20，最大 100
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleHistoryRequest) Reset() {
+	*x = GetVehicleHistoryRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleHistoryRequest) ProtoMessage() {}
+
+func (x *GetVehicleHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetVehicleHistoryRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *GetVehicleHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetVehicleHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetVehicleHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*VehicleHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` //为空表示没有更多
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleHistoryResponse) Reset() {
+	*x = GetVehicleHistoryResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleHistoryResponse) ProtoMessage() {}
+
+func (x *GetVehicleHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetVehicleHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetVehicleHistoryResponse) GetEntries() []*VehicleHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetVehicleHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type VehicleHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` //create, update, delete, restore
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`   //操作人用户名，设备为 device:<VIN>，系统任务为 system
+	Changes       []*FieldChange         `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleHistoryEntry) Reset() {
+	*x = VehicleHistoryEntry{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleHistoryEntry) ProtoMessage() {}
+
+func (x *VehicleHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleHistoryEntry.ProtoReflect.Descriptor instead.
+func (*VehicleHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VehicleHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VehicleHistoryEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *VehicleHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *VehicleHistoryEntry) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *VehicleHistoryEntry) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      *structpb.Value        `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` //为空表示原来未设置
+	NewValue      *structpb.Value        `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` //为空表示被清空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetOldValue() *structpb.Value {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *FieldChange) GetNewValue() *structpb.Value {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+type WatchVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken   string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` //上次收到的 resume_token，为空表示从全量列表开始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchVehiclesRequest) Reset() {
+	*x = WatchVehiclesRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVehiclesRequest) ProtoMessage() {}
+
+func (x *WatchVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*WatchVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchVehiclesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          VehicleEventType       `protobuf:"varint,1,opt,name=type,proto3,enum=vehicle.v1.VehicleEventType" json:"type,omitempty"`
+	Vehicle       *Vehicle               `protobuf:"bytes,2,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` //SNAPSHOT 为空，其它事件处理完后保存，用于断线重连
+	EventTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`       //变更发生的时间，SNAPSHOT 为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchVehiclesResponse) Reset() {
+	*x = WatchVehiclesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVehiclesResponse) ProtoMessage() {}
+
+func (x *WatchVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*WatchVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WatchVehiclesResponse) GetType() VehicleEventType {
+	if x != nil {
+		return x.Type
+	}
+	return VehicleEventType_VEHICLE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchVehiclesResponse) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *WatchVehiclesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchVehiclesResponse) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+type Group struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` //为空表示顶级分组
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	VehicleCount  int32                  `protobuf:"varint,6,opt,name=vehicle_count,json=vehicleCount,proto3" json:"vehicle_count,omitempty"` //直属成员车辆数，不含下级分组
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Group) Reset() {
+	*x = Group{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Group) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Group) ProtoMessage() {}
+
+func (x *Group) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Group) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Group) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Group) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Group) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Group) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Group) GetVehicleCount() int32 {
+	if x != nil {
+		return x.VehicleCount
+	}
+	return 0
+}
+
+func (x *Group) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Group) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` //只有平台管理员可以指定，有上级分组时与上级分组相同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type CreateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupResponse) Reset() {
+	*x = CreateGroupResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponse) ProtoMessage() {}
+
+func (x *CreateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupResponse) Reset() {
+	*x = GetGroupResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupResponse) ProtoMessage() {}
+
+func (x *GetGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListGroupsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	//为空返回全部分组，调用方按 parent_id 组装成树
+	ParentId      string `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsRequest) Reset() {
+	*x = ListGroupsRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsRequest) ProtoMessage() {}
+
+func (x *ListGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListGroupsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type ListGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*Group               `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGroupsResponse) Reset() {
+	*x = ListGroupsResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupsResponse) ProtoMessage() {}
+
+func (x *ListGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListGroupsResponse) GetGroups() []*Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type UpdateGroupRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId    string                 `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` //为空表示移到顶级
+	//要更新的字段: name, description, parent_id；为空时只更新非空的字段
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupRequest) Reset() {
+	*x = UpdateGroupRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupRequest) ProtoMessage() {}
+
+func (x *UpdateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *UpdateGroupRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *Group                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupResponse) Reset() {
+	*x = UpdateGroupResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponse) ProtoMessage() {}
+
+func (x *UpdateGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateGroupResponse) GetGroup() *Group {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupRequest) Reset() {
+	*x = DeleteGroupRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupRequest) ProtoMessage() {}
+
+func (x *DeleteGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteGroupRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGroupResponse) Reset() {
+	*x = DeleteGroupResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGroupResponse) ProtoMessage() {}
+
+func (x *DeleteGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{30}
+}
+
+type AddGroupVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Vins          []string               `protobuf:"bytes,2,rep,name=vins,proto3" json:"vins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupVehiclesRequest) Reset() {
+	*x = AddGroupVehiclesRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupVehiclesRequest) ProtoMessage() {}
+
+func (x *AddGroupVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*AddGroupVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddGroupVehiclesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AddGroupVehiclesRequest) GetVins() []string {
+	if x != nil {
+		return x.Vins
+	}
+	return nil
+}
+
+type AddGroupVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"` //已经是成员的车辆不计入
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddGroupVehiclesResponse) Reset() {
+	*x = AddGroupVehiclesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddGroupVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGroupVehiclesResponse) ProtoMessage() {}
+
+func (x *AddGroupVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGroupVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*AddGroupVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddGroupVehiclesResponse) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+type RemoveGroupVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Vins          []string               `protobuf:"bytes,2,rep,name=vins,proto3" json:"vins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupVehiclesRequest) Reset() {
+	*x = RemoveGroupVehiclesRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupVehiclesRequest) ProtoMessage() {}
+
+func (x *RemoveGroupVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGroupVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RemoveGroupVehiclesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RemoveGroupVehiclesRequest) GetVins() []string {
+	if x != nil {
+		return x.Vins
+	}
+	return nil
+}
+
+type RemoveGroupVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGroupVehiclesResponse) Reset() {
+	*x = RemoveGroupVehiclesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGroupVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGroupVehiclesResponse) ProtoMessage() {}
+
+func (x *RemoveGroupVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGroupVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGroupVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveGroupVehiclesResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+type Driver struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	LicenseExpiry *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=license_expiry,json=licenseExpiry,proto3" json:"license_expiry,omitempty"` //为空表示未登记有效期
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Driver) Reset() {
+	*x = Driver{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Driver) ProtoMessage() {}
+
+func (x *Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Driver.ProtoReflect.Descriptor instead.
+func (*Driver) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Driver) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Driver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Driver) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *Driver) GetLicenseExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenseExpiry
+	}
+	return nil
+}
+
+func (x *Driver) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Driver) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Driver) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Driver) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,2,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	LicenseExpiry *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=license_expiry,json=licenseExpiry,proto3" json:"license_expiry,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` //只有 platform_admin 可以指定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDriverRequest) Reset() {
+	*x = CreateDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverRequest) ProtoMessage() {}
+
+func (x *CreateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDriverRequest.ProtoReflect.Descriptor instead.
+func (*CreateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateDriverRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetLicenseExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenseExpiry
+	}
+	return nil
+}
+
+func (x *CreateDriverRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type CreateDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDriverResponse) Reset() {
+	*x = CreateDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverResponse) ProtoMessage() {}
+
+func (x *CreateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDriverResponse.ProtoReflect.Descriptor instead.
+func (*CreateDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type GetDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverRequest) Reset() {
+	*x = GetDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverRequest) ProtoMessage() {}
+
+func (x *GetDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDriverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverResponse) Reset() {
+	*x = GetDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverResponse) ProtoMessage() {}
+
+func (x *GetDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type ListDriversRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                        //按姓名或驾驶证号模糊匹配
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` //默认 50，最大 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriversRequest) Reset() {
+	*x = ListDriversRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversRequest) ProtoMessage() {}
+
+func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversRequest.ProtoReflect.Descriptor instead.
+func (*ListDriversRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListDriversRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDriversRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*Driver              `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriversResponse) Reset() {
+	*x = ListDriversResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversResponse) ProtoMessage() {}
+
+func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversResponse.ProtoReflect.Descriptor instead.
+func (*ListDriversResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDriversResponse) GetDrivers() []*Driver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+func (x *ListDriversResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,3,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	LicenseExpiry *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=license_expiry,json=licenseExpiry,proto3" json:"license_expiry,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` //为空时只更新非空字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDriverRequest) Reset() {
+	*x = UpdateDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverRequest) ProtoMessage() {}
+
+func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDriverRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateDriverRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetLicenseExpiry() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LicenseExpiry
+	}
+	return nil
+}
+
+func (x *UpdateDriverRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDriverResponse) Reset() {
+	*x = UpdateDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverResponse) ProtoMessage() {}
+
+func (x *UpdateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDriverResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+// DriverAssignment 驾驶记录，时间段为 [start_time, end_time)
+type DriverAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	DriverName    string                 `protobuf:"bytes,3,opt,name=driver_name,json=driverName,proto3" json:"driver_name,omitempty"`
+	Vin           string                 `protobuf:"bytes,4,opt,name=vin,proto3" json:"vin,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` //为空表示正在驾驶
+	AssignedBy    string                 `protobuf:"bytes,7,opt,name=assigned_by,json=assignedBy,proto3" json:"assigned_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverAssignment) Reset() {
+	*x = DriverAssignment{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverAssignment) ProtoMessage() {}
+
+func (x *DriverAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverAssignment.ProtoReflect.Descriptor instead.
+func (*DriverAssignment) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DriverAssignment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DriverAssignment) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *DriverAssignment) GetDriverName() string {
+	if x != nil {
+		return x.DriverName
+	}
+	return ""
+}
+
+func (x *DriverAssignment) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *DriverAssignment) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DriverAssignment) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DriverAssignment) GetAssignedBy() string {
+	if x != nil {
+		return x.AssignedBy
+	}
+	return ""
+}
+
+type AssignDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` //默认当前时间，可以补录过去的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDriverRequest) Reset() {
+	*x = AssignDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDriverRequest) ProtoMessage() {}
+
+func (x *AssignDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDriverRequest.ProtoReflect.Descriptor instead.
+func (*AssignDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AssignDriverRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *AssignDriverRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *AssignDriverRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+type AssignDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *DriverAssignment      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignDriverResponse) Reset() {
+	*x = AssignDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignDriverResponse) ProtoMessage() {}
+
+func (x *AssignDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignDriverResponse.ProtoReflect.Descriptor instead.
+func (*AssignDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AssignDriverResponse) GetAssignment() *DriverAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type UnassignDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` //默认当前时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignDriverRequest) Reset() {
+	*x = UnassignDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignDriverRequest) ProtoMessage() {}
+
+func (x *UnassignDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignDriverRequest.ProtoReflect.Descriptor instead.
+func (*UnassignDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UnassignDriverRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *UnassignDriverRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type UnassignDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignment    *DriverAssignment      `protobuf:"bytes,1,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignDriverResponse) Reset() {
+	*x = UnassignDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignDriverResponse) ProtoMessage() {}
+
+func (x *UnassignDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignDriverResponse.ProtoReflect.Descriptor instead.
+func (*UnassignDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UnassignDriverResponse) GetAssignment() *DriverAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type GetCurrentDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentDriverRequest) Reset() {
+	*x = GetCurrentDriverRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentDriverRequest) ProtoMessage() {}
+
+func (x *GetCurrentDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentDriverRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentDriverRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCurrentDriverRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type GetCurrentDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Assignment    *DriverAssignment      `protobuf:"bytes,2,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentDriverResponse) Reset() {
+	*x = GetCurrentDriverResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentDriverResponse) ProtoMessage() {}
+
+func (x *GetCurrentDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentDriverResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentDriverResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCurrentDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+func (x *GetCurrentDriverResponse) GetAssignment() *DriverAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type ListDriverAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      string                 `protobuf:"bytes,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"` //driver_id 和 vin 至少传一个
+	Vin           string                 `protobuf:"bytes,2,opt,name=vin,proto3" json:"vin,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` //为空表示不限
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriverAssignmentsRequest) Reset() {
+	*x = ListDriverAssignmentsRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListDriverAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriverAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDriverAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListDriverAssignmentsRequest) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *ListDriverAssignmentsRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *ListDriverAssignmentsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListDriverAssignmentsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ListDriverAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*DriverAssignment    `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"` //按开始时间排序，最多 1000 条
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriverAssignmentsResponse) Reset() {
+	*x = ListDriverAssignmentsResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriverAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriverAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListDriverAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriverAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDriverAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListDriverAssignmentsResponse) GetAssignments() []*DriverAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ImportVehiclesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ImportVehiclesRequest_Options
+	//	*ImportVehiclesRequest_Chunk
+	Payload       isImportVehiclesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVehiclesRequest) Reset() {
+	*x = ImportVehiclesRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVehiclesRequest) ProtoMessage() {}
+
+func (x *ImportVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ImportVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ImportVehiclesRequest) GetPayload() isImportVehiclesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ImportVehiclesRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportVehiclesRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *ImportVehiclesRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ImportVehiclesRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isImportVehiclesRequest_Payload interface {
+	isImportVehiclesRequest_Payload()
+}
+
+type ImportVehiclesRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportVehiclesRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` //CSV 内容，UTF-8 (可带 BOM) 或 Excel 导出的 GB18030
+}
+
+func (*ImportVehiclesRequest_Options) isImportVehiclesRequest_Payload() {}
+
+func (*ImportVehiclesRequest_Chunk) isImportVehiclesRequest_Payload() {}
+
+type ImportOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`      //只校验，不写入
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` //导入到的租户，只有平台管理员可以指定
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportOptions) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ImportVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     int32                  `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged     int32                  `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Committed     bool                   `protobuf:"varint,7,opt,name=committed,proto3" json:"committed,omitempty"` //已写入数据库；试运行或有错误时为 false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVehiclesResponse) Reset() {
+	*x = ImportVehiclesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVehiclesResponse) ProtoMessage() {}
+
+func (x *ImportVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ImportVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportVehiclesResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportVehiclesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportVehiclesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportVehiclesResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportVehiclesResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportVehiclesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportVehiclesResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` //文件中的行号，表头为第 1 行
+	Vin           string                 `protobuf:"bytes,2,opt,name=vin,proto3" json:"vin,omitempty"`
+	Field         string                 `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"` //出错的列，整行的错误为空
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"` //凭证的公开前缀
+	Vin           string                 `protobuf:"bytes,3,opt,name=vin,proto3" json:"vin,omitempty"`       //绑定的车辆
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` //为空表示从未上报
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`      //为空表示未停用
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Device) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Device) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Device) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Device) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ProvisionDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionDeviceRequest) Reset() {
+	*x = ProvisionDeviceRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionDeviceRequest) ProtoMessage() {}
+
+func (x *ProvisionDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionDeviceRequest.ProtoReflect.Descriptor instead.
+func (*ProvisionDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProvisionDeviceRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *ProvisionDeviceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ProvisionDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Device        *Device                `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Credential    string                 `protobuf:"bytes,2,opt,name=credential,proto3" json:"credential,omitempty"` //设备凭证，只返回这一次
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvisionDeviceResponse) Reset() {
+	*x = ProvisionDeviceResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvisionDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvisionDeviceResponse) ProtoMessage() {}
+
+func (x *ProvisionDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvisionDeviceResponse.ProtoReflect.Descriptor instead.
+func (*ProvisionDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProvisionDeviceResponse) GetDevice() *Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+func (x *ProvisionDeviceResponse) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vin           string                 `protobuf:"bytes,1,opt,name=vin,proto3" json:"vin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListDevicesRequest) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Devices       []*Device              `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type RevokeDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDeviceRequest) Reset() {
+	*x = RevokeDeviceRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDeviceRequest) ProtoMessage() {}
+
+func (x *RevokeDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RevokeDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDeviceResponse) Reset() {
+	*x = RevokeDeviceResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDeviceResponse) ProtoMessage() {}
+
+func (x *RevokeDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RevokeDeviceResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type AuthenticateDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credential    string                 `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateDeviceRequest) Reset() {
+	*x = AuthenticateDeviceRequest{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateDeviceRequest) ProtoMessage() {}
+
+func (x *AuthenticateDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateDeviceRequest.ProtoReflect.Descriptor instead.
+func (*AuthenticateDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AuthenticateDeviceRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type AuthenticateDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        bool                   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"` //凭证有效且设备未停用
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Vin           string                 `protobuf:"bytes,3,opt,name=vin,proto3" json:"vin,omitempty"`
+	TenantId      string                 `protobuf:"bytes,4,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticateDeviceResponse) Reset() {
+	*x = AuthenticateDeviceResponse{}
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticateDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticateDeviceResponse) ProtoMessage() {}
+
+func (x *AuthenticateDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_vehicle_v1_vehicle_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticateDeviceResponse.ProtoReflect.Descriptor instead.
+func (*AuthenticateDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_vehicle_v1_vehicle_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AuthenticateDeviceResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *AuthenticateDeviceResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *AuthenticateDeviceResponse) GetVin() string {
+	if x != nil {
+		return x.Vin
+	}
+	return ""
+}
+
+func (x *AuthenticateDeviceResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+var File_vehicle_v1_vehicle_proto protoreflect.FileDescriptor
+
+const file_vehicle_v1_vehicle_proto_rawDesc = "" +
+	"\n" +
+	"\x18vehicle/v1/vehicle.proto\x12\n" +
+	"vehicle.v1\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\x11GetVehicleRequest\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\"C\n" +
+	"\x12GetVehicleResponse\x12-\n" +
+	"\avehicle\x18\x01 \x01(\v2\x13.vehicle.v1.VehicleR\avehicle\"\xa1\x03\n" +
+	"\x14CreateVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12#\n" +
+	"\rlicense_plate\x18\x02 \x01(\tR\flicensePlate\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04make\x18\x04 \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x05 \x01(\tR\x05model\x12\x12\n" +
+	"\x04year\x18\x06 \x01(\x05R\x04year\x121\n" +
+	"\tfuel_type\x18\a \x01(\x0e2\x14.vehicle.v1.FuelTypeR\bfuelType\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12P\n" +
+	"\n" +
+	"attributes\x18\t \x03(\v20.vehicle.v1.CreateVehicleRequest.AttributesEntryR\n" +
+	"attributes\x12\x1f\n" +
+	"\vcapacity_kg\x18\n" +
+	" \x01(\x05R\n" +
+	"capacityKg\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"5\n" +
+	"\x14CreateVehicleReponse\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\"\x86\x06\n" +
+	"\aVehicle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03vin\x18\x02 \x01(\tR\x03vin\x12#\n" +
+	"\rlicense_plate\x18\x03 \x01(\tR\flicensePlate\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.vehicle.v1.VehicleStatusR\x06status\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x120\n" +
+	"\blocation\x18\x06 \x01(\v2\x14.vehicle.v1.LocationR\blocation\x12A\n" +
+	"\x0elast_heartbeat\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rlastHeartbeat\x125\n" +
+	"\ttelemetry\x18\b \x01(\v2\x17.google.protobuf.StructR\ttelemetry\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\x12\x12\n" +
+	"\x04make\x18\r \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x0e \x01(\tR\x05model\x12\x12\n" +
+	"\x04year\x18\x0f \x01(\x05R\x04year\x121\n" +
+	"\tfuel_type\x18\x10 \x01(\x0e2\x14.vehicle.v1.FuelTypeR\bfuelType\x12C\n" +
+	"\n" +
+	"attributes\x18\x11 \x03(\v2#.vehicle.v1.Vehicle.AttributesEntryR\n" +
+	"attributes\x12\x1f\n" +
+	"\vcapacity_kg\x18\x12 \x01(\x05R\n" +
+	"capacityKg\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\"\x84\a\n" +
+	"\x13ListVehiclesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x121\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.vehicle.v1.VehicleStatusR\x06status\x120\n" +
+	"\x14license_plate_prefix\x18\x04 \x01(\tR\x12licensePlatePrefix\x12\x1d\n" +
+	"\n" +
+	"vin_prefix\x18\x05 \x01(\tR\tvinPrefix\x12C\n" +
+	"\x0fheartbeat_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0eheartbeatAfter\x12E\n" +
+	"\x10heartbeat_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0fheartbeatBefore\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12#\n" +
+	"\rinclude_total\x18\v \x01(\bR\fincludeTotal\x12\x19\n" +
+	"\bgroup_id\x18\f \x01(\tR\agroupId\x12+\n" +
+	"\x11include_subgroups\x18\r \x01(\bR\x10includeSubgroups\x12\x12\n" +
+	"\x04make\x18\x0e \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\x0f \x01(\tR\x05model\x12\x19\n" +
+	"\byear_min\x18\x10 \x01(\x05R\ayearMin\x12\x19\n" +
+	"\byear_max\x18\x11 \x01(\x05R\ayearMax\x121\n" +
+	"\tfuel_type\x18\x12 \x01(\x0e2\x14.vehicle.v1.FuelTypeR\bfuelType\x12O\n" +
+	"\n" +
+	"attributes\x18\x13 \x03(\v2/.vehicle.v1.ListVehiclesRequest.AttributesEntryR\n" +
+	"attributes\x12&\n" +
+	"\x0fcapacity_kg_min\x18\x14 \x01(\x05R\rcapacityKgMin\x12&\n" +
+	"\x0fcapacity_kg_max\x18\x15 \x01(\x05R\rcapacityKgMax\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x90\x01\n" +
+	"\x14ListVehiclesResponse\x12/\n" +
+	"\bvehicles\x18\x01 \x03(\v2\x13.vehicle.v1.VehicleR\bvehicles\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xec\x03\n" +
+	"\x14UpdateVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12#\n" +
+	"\rlicense_plate\x18\x02 \x01(\tR\flicensePlate\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\x12\x12\n" +
+	"\x04make\x18\x06 \x01(\tR\x04make\x12\x14\n" +
+	"\x05model\x18\a \x01(\tR\x05model\x12\x12\n" +
+	"\x04year\x18\b \x01(\x05R\x04year\x121\n" +
+	"\tfuel_type\x18\t \x01(\x0e2\x14.vehicle.v1.FuelTypeR\bfuelType\x12P\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v20.vehicle.v1.UpdateVehicleRequest.AttributesEntryR\n" +
+	"attributes\x12\x1f\n" +
+	"\vcapacity_kg\x18\v \x01(\x05R\n" +
+	"capacityKg\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
+	"\x15UpdateVehicleResponse\x12-\n" +
+	"\avehicle\x18\x01 \x01(\v2\x13.vehicle.v1.VehicleR\avehicle\"S\n" +
+	"\x14DeleteVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"\x17\n" +
+	"\x15DeleteVehicleResponse\"T\n" +
+	"\x15RestoreVehicleRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"G\n" +
+	"\x16RestoreVehicleResponse\x12-\n" +
+	"\avehicle\x18\x01 \x01(\v2\x13.vehicle.v1.VehicleR\avehicle\"h\n" +
+	"\x18GetVehicleHistoryRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"~\n" +
+	"\x19GetVehicleHistoryResponse\x129\n" +
+	"\aentries\x18\x01 \x03(\v2\x1f.vehicle.v1.VehicleHistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc3\x01\n" +
+	"\x13VehicleHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x121\n" +
+	"\achanges\x18\x04 \x03(\v2\x17.vehicle.v1.FieldChangeR\achanges\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\"\x8d\x01\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x123\n" +
+	"\told_value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\boldValue\x123\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x16.google.protobuf.ValueR\bnewValue\"9\n" +
+	"\x14WatchVehiclesRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xd6\x01\n" +
+	"\x15WatchVehiclesResponse\x120\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1c.vehicle.v1.VehicleEventTypeR\x04type\x12-\n" +
+	"\avehicle\x18\x02 \x01(\v2\x13.vehicle.v1.VehicleR\avehicle\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x129\n" +
+	"\n" +
+	"event_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\teventTime\"\xa2\x02\n" +
+	"\x05Group\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\x12#\n" +
+	"\rvehicle_count\x18\x06 \x01(\x05R\fvehicleCount\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x84\x01\n" +
+	"\x12CreateGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId\">\n" +
+	"\x13CreateGroupResponse\x12'\n" +
+	"\x05group\x18\x01 \x01(\v2\x11.vehicle.v1.GroupR\x05group\"!\n" +
+	"\x0fGetGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x10GetGroupResponse\x12'\n" +
+	"\x05group\x18\x01 \x01(\v2\x11.vehicle.v1.GroupR\x05group\"0\n" +
+	"\x11ListGroupsRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\tR\bparentId\"?\n" +
+	"\x12ListGroupsResponse\x12)\n" +
+	"\x06groups\x18\x01 \x03(\v2\x11.vehicle.v1.GroupR\x06groups\"\xb4\x01\n" +
+	"\x12UpdateGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\tR\bparentId\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\">\n" +
+	"\x13UpdateGroupResponse\x12'\n" +
+	"\x05group\x18\x01 \x01(\v2\x11.vehicle.v1.GroupR\x05group\"$\n" +
+	"\x12DeleteGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13DeleteGroupResponse\"H\n" +
+	"\x17AddGroupVehiclesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04vins\x18\x02 \x03(\tR\x04vins\"0\n" +
+	"\x18AddGroupVehiclesResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\"K\n" +
+	"\x1aRemoveGroupVehiclesRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04vins\x18\x02 \x03(\tR\x04vins\"7\n" +
+	"\x1bRemoveGroupVehiclesResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"\xbf\x02\n" +
+	"\x06Driver\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0elicense_number\x18\x03 \x01(\tR\rlicenseNumber\x12A\n" +
+	"\x0elicense_expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlicenseExpiry\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc6\x01\n" +
+	"\x13CreateDriverRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0elicense_number\x18\x02 \x01(\tR\rlicenseNumber\x12A\n" +
+	"\x0elicense_expiry\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rlicenseExpiry\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\"B\n" +
+	"\x14CreateDriverResponse\x12*\n" +
+	"\x06driver\x18\x01 \x01(\v2\x12.vehicle.v1.DriverR\x06driver\"\"\n" +
+	"\x10GetDriverRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x11GetDriverResponse\x12*\n" +
+	"\x06driver\x18\x01 \x01(\v2\x12.vehicle.v1.DriverR\x06driver\"f\n" +
+	"\x12ListDriversRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"k\n" +
+	"\x13ListDriversResponse\x12,\n" +
+	"\adrivers\x18\x01 \x03(\v2\x12.vehicle.v1.DriverR\adrivers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf6\x01\n" +
+	"\x13UpdateDriverRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0elicense_number\x18\x03 \x01(\tR\rlicenseNumber\x12A\n" +
+	"\x0elicense_expiry\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlicenseExpiry\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12;\n" +
+	"\vupdate_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"B\n" +
+	"\x14UpdateDriverResponse\x12*\n" +
+	"\x06driver\x18\x01 \x01(\v2\x12.vehicle.v1.DriverR\x06driver\"\x85\x02\n" +
+	"\x10DriverAssignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x1f\n" +
+	"\vdriver_name\x18\x03 \x01(\tR\n" +
+	"driverName\x12\x10\n" +
+	"\x03vin\x18\x04 \x01(\tR\x03vin\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1f\n" +
+	"\vassigned_by\x18\a \x01(\tR\n" +
+	"assignedBy\"\x7f\n" +
+	"\x13AssignDriverRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"T\n" +
+	"\x14AssignDriverResponse\x12<\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x1c.vehicle.v1.DriverAssignmentR\n" +
+	"assignment\"`\n" +
+	"\x15UnassignDriverRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x125\n" +
+	"\bend_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"V\n" +
+	"\x16UnassignDriverResponse\x12<\n" +
+	"\n" +
+	"assignment\x18\x01 \x01(\v2\x1c.vehicle.v1.DriverAssignmentR\n" +
+	"assignment\"+\n" +
+	"\x17GetCurrentDriverRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"\x84\x01\n" +
+	"\x18GetCurrentDriverResponse\x12*\n" +
+	"\x06driver\x18\x01 \x01(\v2\x12.vehicle.v1.DriverR\x06driver\x12<\n" +
+	"\n" +
+	"assignment\x18\x02 \x01(\v2\x1c.vehicle.v1.DriverAssignmentR\n" +
+	"assignment\"\xbf\x01\n" +
+	"\x1cListDriverAssignmentsRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\tR\bdriverId\x12\x10\n" +
+	"\x03vin\x18\x02 \x01(\tR\x03vin\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"_\n" +
+	"\x1dListDriverAssignmentsResponse\x12>\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1c.vehicle.v1.DriverAssignmentR\vassignments\"q\n" +
+	"\x15ImportVehiclesRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x19.vehicle.v1.ImportOptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\t\n" +
+	"\apayload\"E\n" +
+	"\rImportOptions\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"\xf4\x01\n" +
+	"\x16ImportVehiclesResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\x05R\ttotalRows\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\x05R\tunchanged\x122\n" +
+	"\x06errors\x18\x05 \x03(\v2\x1a.vehicle.v1.ImportRowErrorR\x06errors\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\tcommitted\x18\a \x01(\bR\tcommitted\"d\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x10\n" +
+	"\x03vin\x18\x02 \x01(\tR\x03vin\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8a\x02\n" +
+	"\x06Device\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x10\n" +
+	"\x03vin\x18\x03 \x01(\tR\x03vin\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x16ProvisionDeviceRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"e\n" +
+	"\x17ProvisionDeviceResponse\x12*\n" +
+	"\x06device\x18\x01 \x01(\v2\x12.vehicle.v1.DeviceR\x06device\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tR\n" +
+	"credential\"&\n" +
+	"\x12ListDevicesRequest\x12\x10\n" +
+	"\x03vin\x18\x01 \x01(\tR\x03vin\"C\n" +
+	"\x13ListDevicesResponse\x12,\n" +
+	"\adevices\x18\x01 \x03(\v2\x12.vehicle.v1.DeviceR\adevices\"%\n" +
+	"\x13RevokeDeviceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"&\n" +
+	"\x14RevokeDeviceResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x19AuthenticateDeviceRequest\x12\x1e\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\tR\n" +
+	"credential\"\x80\x01\n" +
+	"\x1aAuthenticateDeviceResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x10\n" +
+	"\x03vin\x18\x03 \x01(\tR\x03vin\x12\x1b\n" +
+	"\ttenant_id\x18\x04 \x01(\tR\btenantId*`\n" +
+	"\bFuelType\x12\x19\n" +
+	"\x15FUEL_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rFUEL_TYPE_ICE\x10\x01\x12\x10\n" +
+	"\fFUEL_TYPE_EV\x10\x02\x12\x14\n" +
+	"\x10FUEL_TYPE_HYBRID\x10\x03*f\n" +
+	"\rVehicleStatus\x12\x1e\n" +
+	"\x1aVEHICLE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16VEHICLE_STATUS_OFFLINE\x10\x01\x12\x19\n" +
+	"\x15VEHICLE_STATUS_ONLINE\x10\x02*\xd6\x01\n" +
+	"\x10VehicleEventType\x12\"\n" +
+	"\x1eVEHICLE_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bVEHICLE_EVENT_TYPE_SNAPSHOT\x10\x01\x12\x1d\n" +
+	"\x19VEHICLE_EVENT_TYPE_SYNCED\x10\x02\x12\x1e\n" +
+	"\x1aVEHICLE_EVENT_TYPE_CREATED\x10\x03\x12\x1e\n" +
+	"\x1aVEHICLE_EVENT_TYPE_UPDATED\x10\x04\x12\x1e\n" +
+	"\x1aVEHICLE_EVENT_TYPE_DELETED\x10\x052\x87\x13\n" +
+	"\x0eVehicleService\x12K\n" +
+	"\n" +
+	"GetVehicle\x12\x1d.vehicle.v1.GetVehicleRequest\x1a\x1e.vehicle.v1.GetVehicleResponse\x12S\n" +
+	"\rCreateVehicle\x12 .vehicle.v1.CreateVehicleRequest\x1a .vehicle.v1.CreateVehicleReponse\x12Q\n" +
+	"\fListVehicles\x12\x1f.vehicle.v1.ListVehiclesRequest\x1a .vehicle.v1.ListVehiclesResponse\x12T\n" +
+	"\rUpdateVehicle\x12 .vehicle.v1.UpdateVehicleRequest\x1a!.vehicle.v1.UpdateVehicleResponse\x12T\n" +
+	"\rDeleteVehicle\x12 .vehicle.v1.DeleteVehicleRequest\x1a!.vehicle.v1.DeleteVehicleResponse\x12W\n" +
+	"\x0eRestoreVehicle\x12!.vehicle.v1.RestoreVehicleRequest\x1a\".vehicle.v1.RestoreVehicleResponse\x12`\n" +
+	"\x11GetVehicleHistory\x12$.vehicle.v1.GetVehicleHistoryRequest\x1a%.vehicle.v1.GetVehicleHistoryResponse\x12V\n" +
+	"\rWatchVehicles\x12 .vehicle.v1.WatchVehiclesRequest\x1a!.vehicle.v1.WatchVehiclesResponse0\x01\x12N\n" +
+	"\vCreateGroup\x12\x1e.vehicle.v1.CreateGroupRequest\x1a\x1f.vehicle.v1.CreateGroupResponse\x12E\n" +
+	"\bGetGroup\x12\x1b.vehicle.v1.GetGroupRequest\x1a\x1c.vehicle.v1.GetGroupResponse\x12K\n" +
+	"\n" +
+	"ListGroups\x12\x1d.vehicle.v1.ListGroupsRequest\x1a\x1e.vehicle.v1.ListGroupsResponse\x12N\n" +
+	"\vUpdateGroup\x12\x1e.vehicle.v1.UpdateGroupRequest\x1a\x1f.vehicle.v1.UpdateGroupResponse\x12N\n" +
+	"\vDeleteGroup\x12\x1e.vehicle.v1.DeleteGroupRequest\x1a\x1f.vehicle.v1.DeleteGroupResponse\x12]\n" +
+	"\x10AddGroupVehicles\x12#.vehicle.v1.AddGroupVehiclesRequest\x1a$.vehicle.v1.AddGroupVehiclesResponse\x12f\n" +
+	"\x13RemoveGroupVehicles\x12&.vehicle.v1.RemoveGroupVehiclesRequest\x1a'.vehicle.v1.RemoveGroupVehiclesResponse\x12Q\n" +
+	"\fCreateDriver\x12\x1f.vehicle.v1.CreateDriverRequest\x1a .vehicle.v1.CreateDriverResponse\x12H\n" +
+	"\tGetDriver\x12\x1c.vehicle.v1.GetDriverRequest\x1a\x1d.vehicle.v1.GetDriverResponse\x12N\n" +
+	"\vListDrivers\x12\x1e.vehicle.v1.ListDriversRequest\x1a\x1f.vehicle.v1.ListDriversResponse\x12Q\n" +
+	"\fUpdateDriver\x12\x1f.vehicle.v1.UpdateDriverRequest\x1a .vehicle.v1.UpdateDriverResponse\x12Q\n" +
+	"\fAssignDriver\x12\x1f.vehicle.v1.AssignDriverRequest\x1a .vehicle.v1.AssignDriverResponse\x12W\n" +
+	"\x0eUnassignDriver\x12!.vehicle.v1.UnassignDriverRequest\x1a\".vehicle.v1.UnassignDriverResponse\x12]\n" +
+	"\x10GetCurrentDriver\x12#.vehicle.v1.GetCurrentDriverRequest\x1a$.vehicle.v1.GetCurrentDriverResponse\x12l\n" +
+	"\x15ListDriverAssignments\x12(.vehicle.v1.ListDriverAssignmentsRequest\x1a).vehicle.v1.ListDriverAssignmentsResponse\x12Y\n" +
+	"\x0eImportVehicles\x12!.vehicle.v1.ImportVehiclesRequest\x1a\".vehicle.v1.ImportVehiclesResponse(\x01\x12Z\n" +
+	"\x0fProvisionDevice\x12\".vehicle.v1.ProvisionDeviceRequest\x1a#.vehicle.v1.ProvisionDeviceResponse\x12N\n" +
+	"\vListDevices\x12\x1e.vehicle.v1.ListDevicesRequest\x1a\x1f.vehicle.v1.ListDevicesResponse\x12Q\n" +
+	"\fRevokeDevice\x12\x1f.vehicle.v1.RevokeDeviceRequest\x1a .vehicle.v1.RevokeDeviceResponse\x12c\n" +
+	"\x12AuthenticateDevice\x12%.vehicle.v1.AuthenticateDeviceRequest\x1a&.vehicle.v1.AuthenticateDeviceResponseB\x9c\x01\n" +
+	"\x0ecom.vehicle.v1B\fVehicleProtoP\x01Z3github.com/xuewentao/cheya/api/vehicle/v1;vehiclev1\xa2\x02\x03VXX\xaa\x02\n" +
+	"Vehicle.V1\xca\x02\n" +
+	"Vehicle\\V1\xe2\x02\x16Vehicle\\V1\\GPBMetadata\xea\x02\vVehicle::V1b\x06proto3"
+
+var (
+	file_vehicle_v1_vehicle_proto_rawDescOnce sync.Once
+	file_vehicle_v1_vehicle_proto_rawDescData []byte
+)
+
+func file_vehicle_v1_vehicle_proto_rawDescGZIP() []byte {
 	file_vehicle_v1_vehicle_proto_rawDescOnce.Do(func() {
 		file_vehicle_v1_vehicle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_vehicle_v1_vehicle_proto_rawDesc), len(file_vehicle_v1_vehicle_proto_rawDesc)))
 	})
 	return file_vehicle_v1_vehicle_proto_rawDescData
 }
 
-var file_vehicle_v1_vehicle_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_vehicle_v1_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_vehicle_v1_vehicle_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vehicle_v1_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_vehicle_v1_vehicle_proto_goTypes = []any{
-	(VehicleStatus)(0),           // 0: vehicle.v1.VehicleStatus
-	(*GetVehicleRequest)(nil),    // 1: vehicle.v1.GetVehicleRequest
-	(*GetVehicleResponse)(nil),   // 2: vehicle.v1.GetVehicleResponse
-	(*CreateVehicleRequest)(nil), // 3: vehicle.v1.CreateVehicleRequest
-	(*CreateVehicleReponse)(nil), // 4: vehicle.v1.CreateVehicleReponse
-	(*Vehicle)(nil),              // 5: vehicle.v1.Vehicle
-	(*ListVehiclesRequest)(nil),  // 6: vehicle.v1.ListVehiclesRequest
-	(*ListVehiclesResponse)(nil), // 7: vehicle.v1.ListVehiclesResponse
+	(FuelType)(0),                         // 0: vehicle.v1.FuelType
+	(VehicleStatus)(0),                    // 1: vehicle.v1.VehicleStatus
+	(VehicleEventType)(0),                 // 2: vehicle.v1.VehicleEventType
+	(*GetVehicleRequest)(nil),             // 3: vehicle.v1.GetVehicleRequest
+	(*GetVehicleResponse)(nil),            // 4: vehicle.v1.GetVehicleResponse
+	(*CreateVehicleRequest)(nil),          // 5: vehicle.v1.CreateVehicleRequest
+	(*CreateVehicleReponse)(nil),          // 6: vehicle.v1.CreateVehicleReponse
+	(*Vehicle)(nil),                       // 7: vehicle.v1.Vehicle
+	(*Location)(nil),                      // 8: vehicle.v1.Location
+	(*ListVehiclesRequest)(nil),           // 9: vehicle.v1.ListVehiclesRequest
+	(*ListVehiclesResponse)(nil),          // 10: vehicle.v1.ListVehiclesResponse
+	(*UpdateVehicleRequest)(nil),          // 11: vehicle.v1.UpdateVehicleRequest
+	(*UpdateVehicleResponse)(nil),         // 12: vehicle.v1.UpdateVehicleResponse
+	(*DeleteVehicleRequest)(nil),          // 13: vehicle.v1.DeleteVehicleRequest
+	(*DeleteVehicleResponse)(nil),         // 14: vehicle.v1.DeleteVehicleResponse
+	(*RestoreVehicleRequest)(nil),         // 15: vehicle.v1.RestoreVehicleRequest
+	(*RestoreVehicleResponse)(nil),        // 16: vehicle.v1.RestoreVehicleResponse
+	(*GetVehicleHistoryRequest)(nil),      // 17: vehicle.v1.GetVehicleHistoryRequest
+	(*GetVehicleHistoryResponse)(nil),     // 18: vehicle.v1.GetVehicleHistoryResponse
+	(*VehicleHistoryEntry)(nil),           // 19: vehicle.v1.VehicleHistoryEntry
+	(*FieldChange)(nil),                   // 20: vehicle.v1.FieldChange
+	(*WatchVehiclesRequest)(nil),          // 21: vehicle.v1.WatchVehiclesRequest
+	(*WatchVehiclesResponse)(nil),         // 22: vehicle.v1.WatchVehiclesResponse
+	(*Group)(nil),                         // 23: vehicle.v1.Group
+	(*CreateGroupRequest)(nil),            // 24: vehicle.v1.CreateGroupRequest
+	(*CreateGroupResponse)(nil),           // 25: vehicle.v1.CreateGroupResponse
+	(*GetGroupRequest)(nil),               // 26: vehicle.v1.GetGroupRequest
+	(*GetGroupResponse)(nil),              // 27: vehicle.v1.GetGroupResponse
+	(*ListGroupsRequest)(nil),             // 28: vehicle.v1.ListGroupsRequest
+	(*ListGroupsResponse)(nil),            // 29: vehicle.v1.ListGroupsResponse
+	(*UpdateGroupRequest)(nil),            // 30: vehicle.v1.UpdateGroupRequest
+	(*UpdateGroupResponse)(nil),           // 31: vehicle.v1.UpdateGroupResponse
+	(*DeleteGroupRequest)(nil),            // 32: vehicle.v1.DeleteGroupRequest
+	(*DeleteGroupResponse)(nil),           // 33: vehicle.v1.DeleteGroupResponse
+	(*AddGroupVehiclesRequest)(nil),       // 34: vehicle.v1.AddGroupVehiclesRequest
+	(*AddGroupVehiclesResponse)(nil),      // 35: vehicle.v1.AddGroupVehiclesResponse
+	(*RemoveGroupVehiclesRequest)(nil),    // 36: vehicle.v1.RemoveGroupVehiclesRequest
+	(*RemoveGroupVehiclesResponse)(nil),   // 37: vehicle.v1.RemoveGroupVehiclesResponse
+	(*Driver)(nil),                        // 38: vehicle.v1.Driver
+	(*CreateDriverRequest)(nil),           // 39: vehicle.v1.CreateDriverRequest
+	(*CreateDriverResponse)(nil),          // 40: vehicle.v1.CreateDriverResponse
+	(*GetDriverRequest)(nil),              // 41: vehicle.v1.GetDriverRequest
+	(*GetDriverResponse)(nil),             // 42: vehicle.v1.GetDriverResponse
+	(*ListDriversRequest)(nil),            // 43: vehicle.v1.ListDriversRequest
+	(*ListDriversResponse)(nil),           // 44: vehicle.v1.ListDriversResponse
+	(*UpdateDriverRequest)(nil),           // 45: vehicle.v1.UpdateDriverRequest
+	(*UpdateDriverResponse)(nil),          // 46: vehicle.v1.UpdateDriverResponse
+	(*DriverAssignment)(nil),              // 47: vehicle.v1.DriverAssignment
+	(*AssignDriverRequest)(nil),           // 48: vehicle.v1.AssignDriverRequest
+	(*AssignDriverResponse)(nil),          // 49: vehicle.v1.AssignDriverResponse
+	(*UnassignDriverRequest)(nil),         // 50: vehicle.v1.UnassignDriverRequest
+	(*UnassignDriverResponse)(nil),        // 51: vehicle.v1.UnassignDriverResponse
+	(*GetCurrentDriverRequest)(nil),       // 52: vehicle.v1.GetCurrentDriverRequest
+	(*GetCurrentDriverResponse)(nil),      // 53: vehicle.v1.GetCurrentDriverResponse
+	(*ListDriverAssignmentsRequest)(nil),  // 54: vehicle.v1.ListDriverAssignmentsRequest
+	(*ListDriverAssignmentsResponse)(nil), // 55: vehicle.v1.ListDriverAssignmentsResponse
+	(*ImportVehiclesRequest)(nil),         // 56: vehicle.v1.ImportVehiclesRequest
+	(*ImportOptions)(nil),                 // 57: vehicle.v1.ImportOptions
+	(*ImportVehiclesResponse)(nil),        // 58: vehicle.v1.ImportVehiclesResponse
+	(*ImportRowError)(nil),                // 59: vehicle.v1.ImportRowError
+	(*Device)(nil),                        // 60: vehicle.v1.Device
+	(*ProvisionDeviceRequest)(nil),        // 61: vehicle.v1.ProvisionDeviceRequest
+	(*ProvisionDeviceResponse)(nil),       // 62: vehicle.v1.ProvisionDeviceResponse
+	(*ListDevicesRequest)(nil),            // 63: vehicle.v1.ListDevicesRequest
+	(*ListDevicesResponse)(nil),           // 64: vehicle.v1.ListDevicesResponse
+	(*RevokeDeviceRequest)(nil),           // 65: vehicle.v1.RevokeDeviceRequest
+	(*RevokeDeviceResponse)(nil),          // 66: vehicle.v1.RevokeDeviceResponse
+	(*AuthenticateDeviceRequest)(nil),     // 67: vehicle.v1.AuthenticateDeviceRequest
+	(*AuthenticateDeviceResponse)(nil),    // 68: vehicle.v1.AuthenticateDeviceResponse
+	nil,                                   // 69: vehicle.v1.CreateVehicleRequest.AttributesEntry
+	nil,                                   // 70: vehicle.v1.Vehicle.AttributesEntry
+	nil,                                   // 71: vehicle.v1.ListVehiclesRequest.AttributesEntry
+	nil,                                   // 72: vehicle.v1.UpdateVehicleRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),         // 73: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),               // 74: google.protobuf.Struct
+	(*fieldmaskpb.FieldMask)(nil),         // 75: google.protobuf.FieldMask
+	(*structpb.Value)(nil),                // 76: google.protobuf.Value
 }
 var file_vehicle_v1_vehicle_proto_depIdxs = []int32{
-	5, // 0: vehicle.v1.GetVehicleResponse.vehicle:type_name -> vehicle.v1.Vehicle
-	0, // 1: vehicle.v1.Vehicle.status:type_name -> vehicle.v1.VehicleStatus
-	5, // 2: vehicle.v1.ListVehiclesResponse.vehicles:type_name -> vehicle.v1.Vehicle
-	1, // 3: vehicle.v1.VehicleService.GetVehicle:input_type -> vehicle.v1.GetVehicleRequest
-	3, // 4: vehicle.v1.VehicleService.CreateVehicle:input_type -> vehicle.v1.CreateVehicleRequest
-	6, // 5: vehicle.v1.VehicleService.ListVehicles:input_type -> vehicle.v1.ListVehiclesRequest
-	2, // 6: vehicle.v1.VehicleService.GetVehicle:output_type -> vehicle.v1.GetVehicleResponse
-	4, // 7: vehicle.v1.VehicleService.CreateVehicle:output_type -> vehicle.v1.CreateVehicleReponse
-	7, // 8: vehicle.v1.VehicleService.ListVehicles:output_type -> vehicle.v1.ListVehiclesResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	7,  // 0: vehicle.v1.GetVehicleResponse.vehicle:type_name -> vehicle.v1.Vehicle
+	0,  // 1: vehicle.v1.CreateVehicleRequest.fuel_type:type_name -> vehicle.v1.FuelType
+	69, // 2: vehicle.v1.CreateVehicleRequest.attributes:type_name -> vehicle.v1.CreateVehicleRequest.AttributesEntry
+	1,  // 3: vehicle.v1.Vehicle.status:type_name -> vehicle.v1.VehicleStatus
+	8,  // 4: vehicle.v1.Vehicle.location:type_name -> vehicle.v1.Location
+	73, // 5: vehicle.v1.Vehicle.last_heartbeat:type_name -> google.protobuf.Timestamp
+	74, // 6: vehicle.v1.Vehicle.telemetry:type_name -> google.protobuf.Struct
+	73, // 7: vehicle.v1.Vehicle.created_at:type_name -> google.protobuf.Timestamp
+	73, // 8: vehicle.v1.Vehicle.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: vehicle.v1.Vehicle.fuel_type:type_name -> vehicle.v1.FuelType
+	70, // 10: vehicle.v1.Vehicle.attributes:type_name -> vehicle.v1.Vehicle.AttributesEntry
+	1,  // 11: vehicle.v1.ListVehiclesRequest.status:type_name -> vehicle.v1.VehicleStatus
+	73, // 12: vehicle.v1.ListVehiclesRequest.heartbeat_after:type_name -> google.protobuf.Timestamp
+	73, // 13: vehicle.v1.ListVehiclesRequest.heartbeat_before:type_name -> google.protobuf.Timestamp
+	0,  // 14: vehicle.v1.ListVehiclesRequest.fuel_type:type_name -> vehicle.v1.FuelType
+	71, // 15: vehicle.v1.ListVehiclesRequest.attributes:type_name -> vehicle.v1.ListVehiclesRequest.AttributesEntry
+	7,  // 16: vehicle.v1.ListVehiclesResponse.vehicles:type_name -> vehicle.v1.Vehicle
+	75, // 17: vehicle.v1.UpdateVehicleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 18: vehicle.v1.UpdateVehicleRequest.fuel_type:type_name -> vehicle.v1.FuelType
+	72, // 19: vehicle.v1.UpdateVehicleRequest.attributes:type_name -> vehicle.v1.UpdateVehicleRequest.AttributesEntry
+	7,  // 20: vehicle.v1.UpdateVehicleResponse.vehicle:type_name -> vehicle.v1.Vehicle
+	7,  // 21: vehicle.v1.RestoreVehicleResponse.vehicle:type_name -> vehicle.v1.Vehicle
+	19, // 22: vehicle.v1.GetVehicleHistoryResponse.entries:type_name -> vehicle.v1.VehicleHistoryEntry
+	20, // 23: vehicle.v1.VehicleHistoryEntry.changes:type_name -> vehicle.v1.FieldChange
+	73, // 24: vehicle.v1.VehicleHistoryEntry.create_time:type_name -> google.protobuf.Timestamp
+	76, // 25: vehicle.v1.FieldChange.old_value:type_name -> google.protobuf.Value
+	76, // 26: vehicle.v1.FieldChange.new_value:type_name -> google.protobuf.Value
+	2,  // 27: vehicle.v1.WatchVehiclesResponse.type:type_name -> vehicle.v1.VehicleEventType
+	7,  // 28: vehicle.v1.WatchVehiclesResponse.vehicle:type_name -> vehicle.v1.Vehicle
+	73, // 29: vehicle.v1.WatchVehiclesResponse.event_time:type_name -> google.protobuf.Timestamp
+	73, // 30: vehicle.v1.Group.created_at:type_name -> google.protobuf.Timestamp
+	73, // 31: vehicle.v1.Group.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 32: vehicle.v1.CreateGroupResponse.group:type_name -> vehicle.v1.Group
+	23, // 33: vehicle.v1.GetGroupResponse.group:type_name -> vehicle.v1.Group
+	23, // 34: vehicle.v1.ListGroupsResponse.groups:type_name -> vehicle.v1.Group
+	75, // 35: vehicle.v1.UpdateGroupRequest.update_mask:type_name -> google.protobuf.FieldMask
+	23, // 36: vehicle.v1.UpdateGroupResponse.group:type_name -> vehicle.v1.Group
+	73, // 37: vehicle.v1.Driver.license_expiry:type_name -> google.protobuf.Timestamp
+	73, // 38: vehicle.v1.Driver.created_at:type_name -> google.protobuf.Timestamp
+	73, // 39: vehicle.v1.Driver.updated_at:type_name -> google.protobuf.Timestamp
+	73, // 40: vehicle.v1.CreateDriverRequest.license_expiry:type_name -> google.protobuf.Timestamp
+	38, // 41: vehicle.v1.CreateDriverResponse.driver:type_name -> vehicle.v1.Driver
+	38, // 42: vehicle.v1.GetDriverResponse.driver:type_name -> vehicle.v1.Driver
+	38, // 43: vehicle.v1.ListDriversResponse.drivers:type_name -> vehicle.v1.Driver
+	73, // 44: vehicle.v1.UpdateDriverRequest.license_expiry:type_name -> google.protobuf.Timestamp
+	75, // 45: vehicle.v1.UpdateDriverRequest.update_mask:type_name -> google.protobuf.FieldMask
+	38, // 46: vehicle.v1.UpdateDriverResponse.driver:type_name -> vehicle.v1.Driver
+	73, // 47: vehicle.v1.DriverAssignment.start_time:type_name -> google.protobuf.Timestamp
+	73, // 48: vehicle.v1.DriverAssignment.end_time:type_name -> google.protobuf.Timestamp
+	73, // 49: vehicle.v1.AssignDriverRequest.start_time:type_name -> google.protobuf.Timestamp
+	47, // 50: vehicle.v1.AssignDriverResponse.assignment:type_name -> vehicle.v1.DriverAssignment
+	73, // 51: vehicle.v1.UnassignDriverRequest.end_time:type_name -> google.protobuf.Timestamp
+	47, // 52: vehicle.v1.UnassignDriverResponse.assignment:type_name -> vehicle.v1.DriverAssignment
+	38, // 53: vehicle.v1.GetCurrentDriverResponse.driver:type_name -> vehicle.v1.Driver
+	47, // 54: vehicle.v1.GetCurrentDriverResponse.assignment:type_name -> vehicle.v1.DriverAssignment
+	73, // 55: vehicle.v1.ListDriverAssignmentsRequest.start_time:type_name -> google.protobuf.Timestamp
+	73, // 56: vehicle.v1.ListDriverAssignmentsRequest.end_time:type_name -> google.protobuf.Timestamp
+	47, // 57: vehicle.v1.ListDriverAssignmentsResponse.assignments:type_name -> vehicle.v1.DriverAssignment
+	57, // 58: vehicle.v1.ImportVehiclesRequest.options:type_name -> vehicle.v1.ImportOptions
+	59, // 59: vehicle.v1.ImportVehiclesResponse.errors:type_name -> vehicle.v1.ImportRowError
+	73, // 60: vehicle.v1.Device.last_seen_at:type_name -> google.protobuf.Timestamp
+	73, // 61: vehicle.v1.Device.revoked_at:type_name -> google.protobuf.Timestamp
+	73, // 62: vehicle.v1.Device.created_at:type_name -> google.protobuf.Timestamp
+	60, // 63: vehicle.v1.ProvisionDeviceResponse.device:type_name -> vehicle.v1.Device
+	60, // 64: vehicle.v1.ListDevicesResponse.devices:type_name -> vehicle.v1.Device
+	3,  // 65: vehicle.v1.VehicleService.GetVehicle:input_type -> vehicle.v1.GetVehicleRequest
+	5,  // 66: vehicle.v1.VehicleService.CreateVehicle:input_type -> vehicle.v1.CreateVehicleRequest
+	9,  // 67: vehicle.v1.VehicleService.ListVehicles:input_type -> vehicle.v1.ListVehiclesRequest
+	11, // 68: vehicle.v1.VehicleService.UpdateVehicle:input_type -> vehicle.v1.UpdateVehicleRequest
+	13, // 69: vehicle.v1.VehicleService.DeleteVehicle:input_type -> vehicle.v1.DeleteVehicleRequest
+	15, // 70: vehicle.v1.VehicleService.RestoreVehicle:input_type -> vehicle.v1.RestoreVehicleRequest
+	17, // 71: vehicle.v1.VehicleService.GetVehicleHistory:input_type -> vehicle.v1.GetVehicleHistoryRequest
+	21, // 72: vehicle.v1.VehicleService.WatchVehicles:input_type -> vehicle.v1.WatchVehiclesRequest
+	24, // 73: vehicle.v1.VehicleService.CreateGroup:input_type -> vehicle.v1.CreateGroupRequest
+	26, // 74: vehicle.v1.VehicleService.GetGroup:input_type -> vehicle.v1.GetGroupRequest
+	28, // 75: vehicle.v1.VehicleService.ListGroups:input_type -> vehicle.v1.ListGroupsRequest
+	30, // 76: vehicle.v1.VehicleService.UpdateGroup:input_type -> vehicle.v1.UpdateGroupRequest
+	32, // 77: vehicle.v1.VehicleService.DeleteGroup:input_type -> vehicle.v1.DeleteGroupRequest
+	34, // 78: vehicle.v1.VehicleService.AddGroupVehicles:input_type -> vehicle.v1.AddGroupVehiclesRequest
+	36, // 79: vehicle.v1.VehicleService.RemoveGroupVehicles:input_type -> vehicle.v1.RemoveGroupVehiclesRequest
+	39, // 80: vehicle.v1.VehicleService.CreateDriver:input_type -> vehicle.v1.CreateDriverRequest
+	41, // 81: vehicle.v1.VehicleService.GetDriver:input_type -> vehicle.v1.GetDriverRequest
+	43, // 82: vehicle.v1.VehicleService.ListDrivers:input_type -> vehicle.v1.ListDriversRequest
+	45, // 83: vehicle.v1.VehicleService.UpdateDriver:input_type -> vehicle.v1.UpdateDriverRequest
+	48, // 84: vehicle.v1.VehicleService.AssignDriver:input_type -> vehicle.v1.AssignDriverRequest
+	50, // 85: vehicle.v1.VehicleService.UnassignDriver:input_type -> vehicle.v1.UnassignDriverRequest
+	52, // 86: vehicle.v1.VehicleService.GetCurrentDriver:input_type -> vehicle.v1.GetCurrentDriverRequest
+	54, // 87: vehicle.v1.VehicleService.ListDriverAssignments:input_type -> vehicle.v1.ListDriverAssignmentsRequest
+	56, // 88: vehicle.v1.VehicleService.ImportVehicles:input_type -> vehicle.v1.ImportVehiclesRequest
+	61, // 89: vehicle.v1.VehicleService.ProvisionDevice:input_type -> vehicle.v1.ProvisionDeviceRequest
+	63, // 90: vehicle.v1.VehicleService.ListDevices:input_type -> vehicle.v1.ListDevicesRequest
+	65, // 91: vehicle.v1.VehicleService.RevokeDevice:input_type -> vehicle.v1.RevokeDeviceRequest
+	67, // 92: vehicle.v1.VehicleService.AuthenticateDevice:input_type -> vehicle.v1.AuthenticateDeviceRequest
+	4,  // 93: vehicle.v1.VehicleService.GetVehicle:output_type -> vehicle.v1.GetVehicleResponse
+	6,  // 94: vehicle.v1.VehicleService.CreateVehicle:output_type -> vehicle.v1.CreateVehicleReponse
+	10, // 95: vehicle.v1.VehicleService.ListVehicles:output_type -> vehicle.v1.ListVehiclesResponse
+	12, // 96: vehicle.v1.VehicleService.UpdateVehicle:output_type -> vehicle.v1.UpdateVehicleResponse
+	14, // 97: vehicle.v1.VehicleService.DeleteVehicle:output_type -> vehicle.v1.DeleteVehicleResponse
+	16, // 98: vehicle.v1.VehicleService.RestoreVehicle:output_type -> vehicle.v1.RestoreVehicleResponse
+	18, // 99: vehicle.v1.VehicleService.GetVehicleHistory:output_type -> vehicle.v1.GetVehicleHistoryResponse
+	22, // 100: vehicle.v1.VehicleService.WatchVehicles:output_type -> vehicle.v1.WatchVehiclesResponse
+	25, // 101: vehicle.v1.VehicleService.CreateGroup:output_type -> vehicle.v1.CreateGroupResponse
+	27, // 102: vehicle.v1.VehicleService.GetGroup:output_type -> vehicle.v1.GetGroupResponse
+	29, // 103: vehicle.v1.VehicleService.ListGroups:output_type -> vehicle.v1.ListGroupsResponse
+	31, // 104: vehicle.v1.VehicleService.UpdateGroup:output_type -> vehicle.v1.UpdateGroupResponse
+	33, // 105: vehicle.v1.VehicleService.DeleteGroup:output_type -> vehicle.v1.DeleteGroupResponse
+	35, // 106: vehicle.v1.VehicleService.AddGroupVehicles:output_type -> vehicle.v1.AddGroupVehiclesResponse
+	37, // 107: vehicle.v1.VehicleService.RemoveGroupVehicles:output_type -> vehicle.v1.RemoveGroupVehiclesResponse
+	40, // 108: vehicle.v1.VehicleService.CreateDriver:output_type -> vehicle.v1.CreateDriverResponse
+	42, // 109: vehicle.v1.VehicleService.GetDriver:output_type -> vehicle.v1.GetDriverResponse
+	44, // 110: vehicle.v1.VehicleService.ListDrivers:output_type -> vehicle.v1.ListDriversResponse
+	46, // 111: vehicle.v1.VehicleService.UpdateDriver:output_type -> vehicle.v1.UpdateDriverResponse
+	49, // 112: vehicle.v1.VehicleService.AssignDriver:output_type -> vehicle.v1.AssignDriverResponse
+	51, // 113: vehicle.v1.VehicleService.UnassignDriver:output_type -> vehicle.v1.UnassignDriverResponse
+	53, // 114: vehicle.v1.VehicleService.GetCurrentDriver:output_type -> vehicle.v1.GetCurrentDriverResponse
+	55, // 115: vehicle.v1.VehicleService.ListDriverAssignments:output_type -> vehicle.v1.ListDriverAssignmentsResponse
+	58, // 116: vehicle.v1.VehicleService.ImportVehicles:output_type -> vehicle.v1.ImportVehiclesResponse
+	62, // 117: vehicle.v1.VehicleService.ProvisionDevice:output_type -> vehicle.v1.ProvisionDeviceResponse
+	64, // 118: vehicle.v1.VehicleService.ListDevices:output_type -> vehicle.v1.ListDevicesResponse
+	66, // 119: vehicle.v1.VehicleService.RevokeDevice:output_type -> vehicle.v1.RevokeDeviceResponse
+	68, // 120: vehicle.v1.VehicleService.AuthenticateDevice:output_type -> vehicle.v1.AuthenticateDeviceResponse
+	93, // [93:121] is the sub-list for method output_type
+	65, // [65:93] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_vehicle_v1_vehicle_proto_init() }
@@ -514,13 +4892,17 @@ func file_vehicle_v1_vehicle_proto_init() {
 	if File_vehicle_v1_vehicle_proto != nil {
 		return
 	}
+	file_vehicle_v1_vehicle_proto_msgTypes[53].OneofWrappers = []any{
+		(*ImportVehiclesRequest_Options)(nil),
+		(*ImportVehiclesRequest_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_vehicle_v1_vehicle_proto_rawDesc), len(file_vehicle_v1_vehicle_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
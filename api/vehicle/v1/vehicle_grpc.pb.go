@@ -19,9 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VehicleService_GetVehicle_FullMethodName    = "/vehicle.v1.VehicleService/GetVehicle"
-	VehicleService_CreateVehicle_FullMethodName = "/vehicle.v1.VehicleService/CreateVehicle"
-	VehicleService_ListVehicles_FullMethodName  = "/vehicle.v1.VehicleService/ListVehicles"
+	VehicleService_GetVehicle_FullMethodName            = "/vehicle.v1.VehicleService/GetVehicle"
+	VehicleService_CreateVehicle_FullMethodName         = "/vehicle.v1.VehicleService/CreateVehicle"
+	VehicleService_ListVehicles_FullMethodName          = "/vehicle.v1.VehicleService/ListVehicles"
+	VehicleService_UpdateVehicle_FullMethodName         = "/vehicle.v1.VehicleService/UpdateVehicle"
+	VehicleService_DeleteVehicle_FullMethodName         = "/vehicle.v1.VehicleService/DeleteVehicle"
+	VehicleService_RestoreVehicle_FullMethodName        = "/vehicle.v1.VehicleService/RestoreVehicle"
+	VehicleService_GetVehicleHistory_FullMethodName     = "/vehicle.v1.VehicleService/GetVehicleHistory"
+	VehicleService_WatchVehicles_FullMethodName         = "/vehicle.v1.VehicleService/WatchVehicles"
+	VehicleService_CreateGroup_FullMethodName           = "/vehicle.v1.VehicleService/CreateGroup"
+	VehicleService_GetGroup_FullMethodName              = "/vehicle.v1.VehicleService/GetGroup"
+	VehicleService_ListGroups_FullMethodName            = "/vehicle.v1.VehicleService/ListGroups"
+	VehicleService_UpdateGroup_FullMethodName           = "/vehicle.v1.VehicleService/UpdateGroup"
+	VehicleService_DeleteGroup_FullMethodName           = "/vehicle.v1.VehicleService/DeleteGroup"
+	VehicleService_AddGroupVehicles_FullMethodName      = "/vehicle.v1.VehicleService/AddGroupVehicles"
+	VehicleService_RemoveGroupVehicles_FullMethodName   = "/vehicle.v1.VehicleService/RemoveGroupVehicles"
+	VehicleService_CreateDriver_FullMethodName          = "/vehicle.v1.VehicleService/CreateDriver"
+	VehicleService_GetDriver_FullMethodName             = "/vehicle.v1.VehicleService/GetDriver"
+	VehicleService_ListDrivers_FullMethodName           = "/vehicle.v1.VehicleService/ListDrivers"
+	VehicleService_UpdateDriver_FullMethodName          = "/vehicle.v1.VehicleService/UpdateDriver"
+	VehicleService_AssignDriver_FullMethodName          = "/vehicle.v1.VehicleService/AssignDriver"
+	VehicleService_UnassignDriver_FullMethodName        = "/vehicle.v1.VehicleService/UnassignDriver"
+	VehicleService_GetCurrentDriver_FullMethodName      = "/vehicle.v1.VehicleService/GetCurrentDriver"
+	VehicleService_ListDriverAssignments_FullMethodName = "/vehicle.v1.VehicleService/ListDriverAssignments"
+	VehicleService_ImportVehicles_FullMethodName        = "/vehicle.v1.VehicleService/ImportVehicles"
+	VehicleService_ProvisionDevice_FullMethodName       = "/vehicle.v1.VehicleService/ProvisionDevice"
+	VehicleService_ListDevices_FullMethodName           = "/vehicle.v1.VehicleService/ListDevices"
+	VehicleService_RevokeDevice_FullMethodName          = "/vehicle.v1.VehicleService/RevokeDevice"
+	VehicleService_AuthenticateDevice_FullMethodName    = "/vehicle.v1.VehicleService/AuthenticateDevice"
 )
 
 // VehicleServiceClient is the client API for VehicleService service.
@@ -31,6 +56,48 @@ type VehicleServiceClient interface {
 	GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*GetVehicleResponse, error)
 	CreateVehicle(ctx context.Context, in *CreateVehicleRequest, opts ...grpc.CallOption) (*CreateVehicleReponse, error)
 	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
+	// 软删除，车辆从查询中隐藏，可以通过 RestoreVehicle 恢复
+	DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error)
+	RestoreVehicle(ctx context.Context, in *RestoreVehicleRequest, opts ...grpc.CallOption) (*RestoreVehicleResponse, error)
+	// 车辆变更记录，按时间倒序，已删除的车辆同样可以查询
+	GetVehicleHistory(ctx context.Context, in *GetVehicleHistoryRequest, opts ...grpc.CallOption) (*GetVehicleHistoryResponse, error)
+	// 订阅车辆变更: 不带 resume_token 时先推送当前全部车辆 (SNAPSHOT)，以 SYNCED 结束，之后推送新增/修改/删除
+	// 断线后带上最后收到的 resume_token 重连，从该位置继续推送 (至少一次，可能重复)；
+	// token 对应的事件已被清理时返回 OUT_OF_RANGE，需要不带 token 重新全量同步
+	WatchVehicles(ctx context.Context, in *WatchVehiclesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchVehiclesResponse], error)
+	// 车辆分组 (车队)，分组可以嵌套，一辆车可以属于多个分组
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error)
+	// 只能删除没有子分组的分组，成员关系一并删除，车辆本身不受影响
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	AddGroupVehicles(ctx context.Context, in *AddGroupVehiclesRequest, opts ...grpc.CallOption) (*AddGroupVehiclesResponse, error)
+	RemoveGroupVehicles(ctx context.Context, in *RemoveGroupVehiclesRequest, opts ...grpc.CallOption) (*RemoveGroupVehiclesResponse, error)
+	// 司机
+	CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error)
+	GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error)
+	ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error)
+	// 司机开始驾驶车辆，车辆或司机正在进行的驾驶记录在 start_time 结束 (交接班)
+	AssignDriver(ctx context.Context, in *AssignDriverRequest, opts ...grpc.CallOption) (*AssignDriverResponse, error)
+	// 结束车辆当前的驾驶记录
+	UnassignDriver(ctx context.Context, in *UnassignDriverRequest, opts ...grpc.CallOption) (*UnassignDriverResponse, error)
+	// 车辆当前的司机，没有司机时 driver 为空
+	GetCurrentDriver(ctx context.Context, in *GetCurrentDriverRequest, opts ...grpc.CallOption) (*GetCurrentDriverResponse, error)
+	// 按司机或车辆查询与时间段有交集的驾驶记录，例如 "司机 X 在 t1 到 t2 之间驾驶过哪些车"
+	ListDriverAssignments(ctx context.Context, in *ListDriverAssignmentsRequest, opts ...grpc.CallOption) (*ListDriverAssignmentsResponse, error)
+	// 批量导入 CSV: 第一条消息是 options，之后是文件内容的分块
+	// 按 VIN 新增或更新，全部行在一个事务中写入，任意一行有错误时不写入
+	ImportVehicles(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportVehiclesRequest, ImportVehiclesResponse], error)
+	// 为车辆开通车载终端，设备凭证只在响应中返回一次
+	ProvisionDevice(ctx context.Context, in *ProvisionDeviceRequest, opts ...grpc.CallOption) (*ProvisionDeviceResponse, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	// 停用设备，遥测服务的凭证缓存过期后生效
+	RevokeDevice(ctx context.Context, in *RevokeDeviceRequest, opts ...grpc.CallOption) (*RevokeDeviceResponse, error)
+	// 校验设备凭证并返回绑定的车辆，供遥测等设备接入服务调用，不需要用户 token
+	AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error)
 }
 
 type vehicleServiceClient struct {
@@ -71,6 +138,268 @@ func (c *vehicleServiceClient) ListVehicles(ctx context.Context, in *ListVehicle
 	return out, nil
 }
 
+func (c *vehicleServiceClient) UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*DeleteVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_DeleteVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) RestoreVehicle(ctx context.Context, in *RestoreVehicleRequest, opts ...grpc.CallOption) (*RestoreVehicleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_RestoreVehicle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetVehicleHistory(ctx context.Context, in *GetVehicleHistoryRequest, opts ...grpc.CallOption) (*GetVehicleHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVehicleHistoryResponse)
+	err := c.cc.Invoke(ctx, VehicleService_GetVehicleHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) WatchVehicles(ctx context.Context, in *WatchVehiclesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchVehiclesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[0], VehicleService_WatchVehicles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchVehiclesRequest, WatchVehiclesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VehicleService_WatchVehiclesClient = grpc.ServerStreamingClient[WatchVehiclesResponse]
+
+func (c *vehicleServiceClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CreateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetGroup(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*GetGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupResponse)
+	err := c.cc.Invoke(ctx, VehicleService_GetGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UpdateGroup(ctx context.Context, in *UpdateGroupRequest, opts ...grpc.CallOption) (*UpdateGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGroupResponse)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, VehicleService_DeleteGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) AddGroupVehicles(ctx context.Context, in *AddGroupVehiclesRequest, opts ...grpc.CallOption) (*AddGroupVehiclesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddGroupVehiclesResponse)
+	err := c.cc.Invoke(ctx, VehicleService_AddGroupVehicles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) RemoveGroupVehicles(ctx context.Context, in *RemoveGroupVehiclesRequest, opts ...grpc.CallOption) (*RemoveGroupVehiclesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveGroupVehiclesResponse)
+	err := c.cc.Invoke(ctx, VehicleService_RemoveGroupVehicles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CreateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_GetDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) AssignDriver(ctx context.Context, in *AssignDriverRequest, opts ...grpc.CallOption) (*AssignDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_AssignDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UnassignDriver(ctx context.Context, in *UnassignDriverRequest, opts ...grpc.CallOption) (*UnassignDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_UnassignDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetCurrentDriver(ctx context.Context, in *GetCurrentDriverRequest, opts ...grpc.CallOption) (*GetCurrentDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCurrentDriverResponse)
+	err := c.cc.Invoke(ctx, VehicleService_GetCurrentDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListDriverAssignments(ctx context.Context, in *ListDriverAssignmentsRequest, opts ...grpc.CallOption) (*ListDriverAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriverAssignmentsResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListDriverAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ImportVehicles(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportVehiclesRequest, ImportVehiclesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[1], VehicleService_ImportVehicles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportVehiclesRequest, ImportVehiclesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VehicleService_ImportVehiclesClient = grpc.ClientStreamingClient[ImportVehiclesRequest, ImportVehiclesResponse]
+
+func (c *vehicleServiceClient) ProvisionDevice(ctx context.Context, in *ProvisionDeviceRequest, opts ...grpc.CallOption) (*ProvisionDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvisionDeviceResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ProvisionDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) RevokeDevice(ctx context.Context, in *RevokeDeviceRequest, opts ...grpc.CallOption) (*RevokeDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDeviceResponse)
+	err := c.cc.Invoke(ctx, VehicleService_RevokeDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) AuthenticateDevice(ctx context.Context, in *AuthenticateDeviceRequest, opts ...grpc.CallOption) (*AuthenticateDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateDeviceResponse)
+	err := c.cc.Invoke(ctx, VehicleService_AuthenticateDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VehicleServiceServer is the server API for VehicleService service.
 // All implementations must embed UnimplementedVehicleServiceServer
 // for forward compatibility.
@@ -78,6 +407,48 @@ type VehicleServiceServer interface {
 	GetVehicle(context.Context, *GetVehicleRequest) (*GetVehicleResponse, error)
 	CreateVehicle(context.Context, *CreateVehicleRequest) (*CreateVehicleReponse, error)
 	ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error)
+	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error)
+	// 软删除，车辆从查询中隐藏，可以通过 RestoreVehicle 恢复
+	DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error)
+	RestoreVehicle(context.Context, *RestoreVehicleRequest) (*RestoreVehicleResponse, error)
+	// 车辆变更记录，按时间倒序，已删除的车辆同样可以查询
+	GetVehicleHistory(context.Context, *GetVehicleHistoryRequest) (*GetVehicleHistoryResponse, error)
+	// 订阅车辆变更: 不带 resume_token 时先推送当前全部车辆 (SNAPSHOT)，以 SYNCED 结束，之后推送新增/修改/删除
+	// 断线后带上最后收到的 resume_token 重连，从该位置继续推送 (至少一次，可能重复)；
+	// token 对应的事件已被清理时返回 OUT_OF_RANGE，需要不带 token 重新全量同步
+	WatchVehicles(*WatchVehiclesRequest, grpc.ServerStreamingServer[WatchVehiclesResponse]) error
+	// 车辆分组 (车队)，分组可以嵌套，一辆车可以属于多个分组
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error)
+	// 只能删除没有子分组的分组，成员关系一并删除，车辆本身不受影响
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	AddGroupVehicles(context.Context, *AddGroupVehiclesRequest) (*AddGroupVehiclesResponse, error)
+	RemoveGroupVehicles(context.Context, *RemoveGroupVehiclesRequest) (*RemoveGroupVehiclesResponse, error)
+	// 司机
+	CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error)
+	GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error)
+	ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error)
+	UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error)
+	// 司机开始驾驶车辆，车辆或司机正在进行的驾驶记录在 start_time 结束 (交接班)
+	AssignDriver(context.Context, *AssignDriverRequest) (*AssignDriverResponse, error)
+	// 结束车辆当前的驾驶记录
+	UnassignDriver(context.Context, *UnassignDriverRequest) (*UnassignDriverResponse, error)
+	// 车辆当前的司机，没有司机时 driver 为空
+	GetCurrentDriver(context.Context, *GetCurrentDriverRequest) (*GetCurrentDriverResponse, error)
+	// 按司机或车辆查询与时间段有交集的驾驶记录，例如 "司机 X 在 t1 到 t2 之间驾驶过哪些车"
+	ListDriverAssignments(context.Context, *ListDriverAssignmentsRequest) (*ListDriverAssignmentsResponse, error)
+	// 批量导入 CSV: 第一条消息是 options，之后是文件内容的分块
+	// 按 VIN 新增或更新，全部行在一个事务中写入，任意一行有错误时不写入
+	ImportVehicles(grpc.ClientStreamingServer[ImportVehiclesRequest, ImportVehiclesResponse]) error
+	// 为车辆开通车载终端，设备凭证只在响应中返回一次
+	ProvisionDevice(context.Context, *ProvisionDeviceRequest) (*ProvisionDeviceResponse, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	// 停用设备，遥测服务的凭证缓存过期后生效
+	RevokeDevice(context.Context, *RevokeDeviceRequest) (*RevokeDeviceResponse, error)
+	// 校验设备凭证并返回绑定的车辆，供遥测等设备接入服务调用，不需要用户 token
+	AuthenticateDevice(context.Context, *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error)
 	mustEmbedUnimplementedVehicleServiceServer()
 }
 
@@ -97,6 +468,81 @@ func (UnimplementedVehicleServiceServer) CreateVehicle(context.Context, *CreateV
 func (UnimplementedVehicleServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
 }
+func (UnimplementedVehicleServiceServer) UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) DeleteVehicle(context.Context, *DeleteVehicleRequest) (*DeleteVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) RestoreVehicle(context.Context, *RestoreVehicleRequest) (*RestoreVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetVehicleHistory(context.Context, *GetVehicleHistoryRequest) (*GetVehicleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicleHistory not implemented")
+}
+func (UnimplementedVehicleServiceServer) WatchVehicles(*WatchVehiclesRequest, grpc.ServerStreamingServer[WatchVehiclesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetGroup(context.Context, *GetGroupRequest) (*GetGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroups not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateGroup(context.Context, *UpdateGroupRequest) (*UpdateGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedVehicleServiceServer) DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedVehicleServiceServer) AddGroupVehicles(context.Context, *AddGroupVehiclesRequest) (*AddGroupVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) RemoveGroupVehicles(context.Context, *RemoveGroupVehiclesRequest) (*RemoveGroupVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrivers not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) AssignDriver(context.Context, *AssignDriverRequest) (*AssignDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) UnassignDriver(context.Context, *UnassignDriverRequest) (*UnassignDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetCurrentDriver(context.Context, *GetCurrentDriverRequest) (*GetCurrentDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentDriver not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListDriverAssignments(context.Context, *ListDriverAssignmentsRequest) (*ListDriverAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriverAssignments not implemented")
+}
+func (UnimplementedVehicleServiceServer) ImportVehicles(grpc.ClientStreamingServer[ImportVehiclesRequest, ImportVehiclesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) ProvisionDevice(context.Context, *ProvisionDeviceRequest) (*ProvisionDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvisionDevice not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedVehicleServiceServer) RevokeDevice(context.Context, *RevokeDeviceRequest) (*RevokeDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDevice not implemented")
+}
+func (UnimplementedVehicleServiceServer) AuthenticateDevice(context.Context, *AuthenticateDeviceRequest) (*AuthenticateDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateDevice not implemented")
+}
 func (UnimplementedVehicleServiceServer) mustEmbedUnimplementedVehicleServiceServer() {}
 func (UnimplementedVehicleServiceServer) testEmbeddedByValue()                        {}
 
@@ -172,26 +618,561 @@ func _VehicleService_ListVehicles_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VehicleService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "vehicle.v1.VehicleService",
-	HandlerType: (*VehicleServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetVehicle",
-			Handler:    _VehicleService_GetVehicle_Handler,
-		},
-		{
-			MethodName: "CreateVehicle",
-			Handler:    _VehicleService_CreateVehicle_Handler,
-		},
-		{
-			MethodName: "ListVehicles",
-			Handler:    _VehicleService_ListVehicles_Handler,
+func _VehicleService_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, req.(*UpdateVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_DeleteVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, req.(*DeleteVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_RestoreVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).RestoreVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_RestoreVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).RestoreVehicle(ctx, req.(*RestoreVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetVehicleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetVehicleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetVehicleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetVehicleHistory(ctx, req.(*GetVehicleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_WatchVehicles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVehiclesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).WatchVehicles(m, &grpc.GenericServerStream[WatchVehiclesRequest, WatchVehiclesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VehicleService_WatchVehiclesServer = grpc.ServerStreamingServer[WatchVehiclesResponse]
+
+func _VehicleService_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CreateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetGroup(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateGroup(ctx, req.(*UpdateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_DeleteGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_AddGroupVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).AddGroupVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_AddGroupVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).AddGroupVehicles(ctx, req.(*AddGroupVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_RemoveGroupVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).RemoveGroupVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_RemoveGroupVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).RemoveGroupVehicles(ctx, req.(*RemoveGroupVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_CreateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CreateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CreateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CreateDriver(ctx, req.(*CreateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetDriver(ctx, req.(*GetDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListDrivers(ctx, req.(*ListDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UpdateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateDriver(ctx, req.(*UpdateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_AssignDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).AssignDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_AssignDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).AssignDriver(ctx, req.(*AssignDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UnassignDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UnassignDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UnassignDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UnassignDriver(ctx, req.(*UnassignDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetCurrentDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetCurrentDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetCurrentDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetCurrentDriver(ctx, req.(*GetCurrentDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListDriverAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriverAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListDriverAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListDriverAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListDriverAssignments(ctx, req.(*ListDriverAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ImportVehicles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VehicleServiceServer).ImportVehicles(&grpc.GenericServerStream[ImportVehiclesRequest, ImportVehiclesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VehicleService_ImportVehiclesServer = grpc.ClientStreamingServer[ImportVehiclesRequest, ImportVehiclesResponse]
+
+func _VehicleService_ProvisionDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvisionDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ProvisionDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ProvisionDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ProvisionDevice(ctx, req.(*ProvisionDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_RevokeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).RevokeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_RevokeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).RevokeDevice(ctx, req.(*RevokeDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_AuthenticateDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).AuthenticateDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_AuthenticateDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).AuthenticateDevice(ctx, req.(*AuthenticateDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VehicleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vehicle.v1.VehicleService",
+	HandlerType: (*VehicleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVehicle",
+			Handler:    _VehicleService_GetVehicle_Handler,
+		},
+		{
+			MethodName: "CreateVehicle",
+			Handler:    _VehicleService_CreateVehicle_Handler,
+		},
+		{
+			MethodName: "ListVehicles",
+			Handler:    _VehicleService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "UpdateVehicle",
+			Handler:    _VehicleService_UpdateVehicle_Handler,
+		},
+		{
+			MethodName: "DeleteVehicle",
+			Handler:    _VehicleService_DeleteVehicle_Handler,
+		},
+		{
+			MethodName: "RestoreVehicle",
+			Handler:    _VehicleService_RestoreVehicle_Handler,
+		},
+		{
+			MethodName: "GetVehicleHistory",
+			Handler:    _VehicleService_GetVehicleHistory_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _VehicleService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "GetGroup",
+			Handler:    _VehicleService_GetGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _VehicleService_ListGroups_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _VehicleService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _VehicleService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "AddGroupVehicles",
+			Handler:    _VehicleService_AddGroupVehicles_Handler,
+		},
+		{
+			MethodName: "RemoveGroupVehicles",
+			Handler:    _VehicleService_RemoveGroupVehicles_Handler,
+		},
+		{
+			MethodName: "CreateDriver",
+			Handler:    _VehicleService_CreateDriver_Handler,
+		},
+		{
+			MethodName: "GetDriver",
+			Handler:    _VehicleService_GetDriver_Handler,
+		},
+		{
+			MethodName: "ListDrivers",
+			Handler:    _VehicleService_ListDrivers_Handler,
+		},
+		{
+			MethodName: "UpdateDriver",
+			Handler:    _VehicleService_UpdateDriver_Handler,
+		},
+		{
+			MethodName: "AssignDriver",
+			Handler:    _VehicleService_AssignDriver_Handler,
+		},
+		{
+			MethodName: "UnassignDriver",
+			Handler:    _VehicleService_UnassignDriver_Handler,
+		},
+		{
+			MethodName: "GetCurrentDriver",
+			Handler:    _VehicleService_GetCurrentDriver_Handler,
+		},
+		{
+			MethodName: "ListDriverAssignments",
+			Handler:    _VehicleService_ListDriverAssignments_Handler,
+		},
+		{
+			MethodName: "ProvisionDevice",
+			Handler:    _VehicleService_ProvisionDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _VehicleService_ListDevices_Handler,
+		},
+		{
+			MethodName: "RevokeDevice",
+			Handler:    _VehicleService_RevokeDevice_Handler,
+		},
+		{
+			MethodName: "AuthenticateDevice",
+			Handler:    _VehicleService_AuthenticateDevice_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVehicles",
+			Handler:       _VehicleService_WatchVehicles_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportVehicles",
+			Handler:       _VehicleService_ImportVehicles_Handler,
+			ClientStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "vehicle/v1/vehicle.proto",
 }
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey 机器对机器调用使用的 API key
+// 完整 key 只在创建时返回一次，库中只保存 sha256；
+// key 和用户一样带有角色和租户，另外用 scopes 限制 key 能访问的接口。
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields 定义 api_keys 表字段
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 名称，方便管理员识别用途
+		field.String("name").
+			MaxLen(64).
+			NotEmpty(),
+
+		// 2. key 的公开前缀 (ck_xxxxxxxx)，用于在列表和日志中识别 key
+		field.String("prefix").
+			Unique().
+			NotEmpty(),
+
+		// 3. key 哈希 (sha256)
+		field.String("key_hash").
+			Sensitive().
+			Unique().
+			NotEmpty(),
+
+		// 4. 角色，不能高于创建者的角色
+		field.Enum("role").
+			Values("platform_admin", "admin", "operator", "viewer").
+			Default("viewer"),
+
+		// 5. 权限范围 (token.Scopes)，为空的 key 不能访问任何接口
+		field.Strings("scopes").
+			Optional(),
+
+		// 6. 所属租户，平台级 key 为空
+		field.Int("tenant_id").
+			Optional(),
+
+		// 7. 创建者用户 ID
+		field.Int("created_by").
+			Optional(),
+
+		// 8. 过期时间，为空表示永不过期
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		// 9. 最后使用时间
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+
+		// 10. 吊销时间，非空表示已吊销
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+
+		// 11. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("api_keys").
+			Field("tenant_id").
+			Unique(),
+	}
+}
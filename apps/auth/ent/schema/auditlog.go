@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog 安全审计日志
+// 记录账号锁定、解锁等安全相关事件，只追加不修改
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields 定义 audit_logs 表字段
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 事件类型，例如 account_throttled / account_unlocked / ip_locked / ip_unlocked
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+
+		// 2. 相关账号 (用户名)
+		field.String("username").
+			Optional().
+			Immutable(),
+
+		// 3. 来源 IP
+		field.String("ip").
+			Optional().
+			Immutable(),
+
+		// 4. 操作者，系统自动触发时为空
+		field.String("actor").
+			Optional().
+			Immutable(),
+
+		// 5. 补充说明
+		field.String("detail").
+			Optional().
+			Immutable(),
+
+		// 6. 发生时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes 定义索引
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按账号查询审计记录
+		index.Fields("username", "created_at"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// InviteCode 注册邀请码
+// 持有邀请码注册的账号无需审核，并直接获得邀请码上的角色和租户
+type InviteCode struct {
+	ent.Schema
+}
+
+// Fields 定义 invite_codes 表字段
+func (InviteCode) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 邀请码
+		field.String("code").
+			Unique().
+			NotEmpty(),
+
+		// 2. 注册后获得的角色
+		field.Enum("role").
+			Values("admin", "operator", "viewer").
+			Default("viewer"),
+
+		// 3. 最大使用次数
+		field.Int("max_uses").
+			Positive().
+			Default(1),
+
+		// 4. 已使用次数
+		field.Int("used_count").
+			NonNegative().
+			Default(0),
+
+		// 5. 过期时间，为空表示永不过期
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+
+		// 6. 创建者用户 ID
+		field.Int("created_by").
+			Optional(),
+
+		// 7. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		// 8. 注册后加入的租户
+		field.Int("tenant_id").
+			Optional(),
+	}
+}
+
+// Edges 定义关联关系
+func (InviteCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invite_codes").
+			Field("tenant_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Organization 租户 (客户公司)
+// 用户和车辆都归属于某个租户，只能看到本租户的数据；platform_admin 可以跨租户访问。
+// 车辆在 vehicle service 的库中，通过 tenant_id 引用这里的 ID
+type Organization struct {
+	ent.Schema
+}
+
+// Fields 定义 organizations 表字段
+func (Organization) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 名称
+		field.String("name").
+			MaxLen(64).
+			Unique().
+			NotEmpty(),
+
+		// 2. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (Organization) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("users", User.Type),
+		edge.To("invite_codes", InviteCode.Type),
+		edge.To("api_keys", APIKey.Type),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordResetToken 找回密码令牌
+// 通过邮件发给用户，只能使用一次，库中只保存 sha256
+type PasswordResetToken struct {
+	ent.Schema
+}
+
+// Fields 定义 password_reset_tokens 表字段
+func (PasswordResetToken) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 令牌哈希
+		field.String("token_hash").
+			Sensitive().
+			Unique().
+			NotEmpty(),
+
+		// 2. 所属用户
+		field.Int("user_id"),
+
+		// 3. 过期时间
+		field.Time("expires_at"),
+
+		// 4. 使用时间，非空表示已使用或已被新令牌作废
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+
+		// 5. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (PasswordResetToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes 定义索引
+func (PasswordResetToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 申请新令牌时作废该用户未使用的旧令牌
+		index.Fields("user_id"),
+	}
+}
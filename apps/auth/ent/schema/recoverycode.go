@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// RecoveryCode 两步验证恢复码
+// 丢失验证器时用来代替 TOTP 验证码，每个只能使用一次，库中只保存 sha256
+type RecoveryCode struct {
+	ent.Schema
+}
+
+// Fields 定义 recovery_codes 表字段
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 所属用户
+		field.Int("user_id"),
+
+		// 2. 恢复码哈希
+		field.String("code_hash").
+			Sensitive().
+			NotEmpty(),
+
+		// 3. 使用时间，非空表示已使用
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+
+		// 4. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
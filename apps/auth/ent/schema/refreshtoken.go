@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken 刷新令牌
+// 每次刷新都会作废旧令牌并签发新令牌 (rotation)，同一次登录产生的令牌属于同一个 family。
+// 已使用过的令牌再次出现说明令牌泄露，此时整个 family 都会被吊销。
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields 定义 refresh_tokens 表字段
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 令牌哈希 (sha256)，数据库中不保存明文
+		field.String("token_hash").
+			Sensitive().
+			Unique().
+			NotEmpty(),
+
+		// 2. 令牌家族 ID，同时作为 access token 中的 sid
+		field.String("family_id").
+			NotEmpty(),
+
+		// 3. 所属用户
+		field.Int("user_id"),
+
+		// 4. 过期时间
+		field.Time("expires_at"),
+
+		// 5. 使用时间，非空表示已经换过新令牌
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+
+		// 6. 是否已吊销
+		field.Bool("revoked").
+			Default(false),
+
+		// 7. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (RefreshToken) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes 定义索引
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按 family 批量吊销
+		index.Fields("family_id"),
+		// 按用户吊销全部会话 (修改密码等)
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,114 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type User struct {
+	ent.Schema
+}
+
+// Fields 定义 users 表字段
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 用户名 (登录名)
+		// 定义: VARCHAR(64) UNIQUE NOT NULL
+		field.String("username").
+			MaxLen(64).
+			Unique().
+			NotEmpty(),
+
+		// 2. 密码哈希 (bcrypt)
+		// 只保存哈希，Sensitive 保证不会出现在日志 / String() 输出中
+		field.String("password_hash").
+			Sensitive().
+			NotEmpty(),
+
+		// 3. 是否启用
+		// 被禁用的账号无法登录
+		field.Bool("enabled").
+			Default(true),
+
+		// 4. 是否已审核
+		// 开启注册审核时，新注册账号需要管理员审核后才能登录
+		field.Bool("approved").
+			Default(true),
+
+		// 5. 角色
+		// platform_admin: 平台管理员 (跨租户)  admin: 租户管理员  operator: 调度员  viewer: 只读
+		field.Enum("role").
+			Values("platform_admin", "admin", "operator", "viewer").
+			Default("viewer"),
+
+		// 6. 邮箱 (可选)
+		field.String("email").
+			Optional().
+			Nillable().
+			Unique(),
+
+		// 7. 最后登录时间
+		field.Time("last_login_at").
+			Optional().
+			Nillable(),
+
+		// 8. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+
+		// 9. 更新时间
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+
+		// 10. 所属租户
+		// 为空表示尚未分配租户，只有 platform_admin 可以不属于任何租户
+		field.Int("tenant_id").
+			Optional(),
+
+		// 11. TOTP 密钥 (base32)
+		// 开始绑定时写入，验证通过后 totp_enabled 才置为 true
+		field.String("totp_secret").
+			Optional().
+			Nillable().
+			Sensitive(),
+
+		// 12. 是否已开启两步验证
+		field.Bool("totp_enabled").
+			Default(false),
+
+		// 13. 单点登录身份 (OIDC issuer + sub)
+		// 通过单点登录首次登录时自动创建账号并写入，本地注册的账号为空
+		field.String("oidc_issuer").
+			Optional().
+			Nillable(),
+		field.String("oidc_subject").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges 定义关联关系
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("users").
+			Field("tenant_id").
+			Unique(),
+		edge.To("recovery_codes", RecoveryCode.Type),
+	}
+}
+
+// Indexes 定义索引
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		// 同一个 IdP 的同一个 sub 只对应一个账号
+		index.Fields("oidc_issuer", "oidc_subject").
+			Unique(),
+	}
+}
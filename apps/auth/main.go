@@ -4,51 +4,175 @@ import (
 	"context"
 	"log"
 	"net"
-	"time"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
 	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/notify"
+	"github.com/xuewentao/cheya/apps/auth/server"
+	"github.com/xuewentao/cheya/pkg/token"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+
+	_ "github.com/lib/pq"
 )
 
-var jwtSecret = []byte("cheya-super-secret-key-2025")
+// access token 签名私钥目录，每个 <kid>.pem 是一把私钥
+// 轮换时放入新私钥并把 activeKID 指向它，旧私钥保留到旧 token 全部过期后再删除
+const (
+	keysDir   = "keys/auth"
+	activeKID = "" // 为空时使用文件名排序最后的一把
+)
 
-type AuthServer struct {
-	authv1.UnimplementedAuthServiceServer
-}
+// 注册模式: open / approval / invite
+const registrationMode = server.RegistrationOpen
+
+// 必须开启两步验证的角色，这些角色的账号首次登录时会被要求绑定验证器
+// 默认的平台管理员 admin 供本地开发使用，这里没有包含 platform_admin，生产环境应加上
+var mfaRequiredRoles = []string{"admin", "operator"}
+
+// 前端重置密码页，找回密码邮件中的链接指向这里
+const passwordResetURL = "http://localhost:5173/reset-password"
+
+func main() {
+	//1.链接数据库
+	dns := "host=localhost port=5432 user=wentao_xue  dbname=cheya password=Woe89132 sslmode=disable"
+	client, err := ent.Open("postgres", dns)
+	if err != nil {
+		log.Fatalf("❌ failed opening connection to postgres: %v", err)
+	}
+	defer client.Close()
 
-func (c *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
-	//暂时模拟校验
-	if req.Username != "admin" || req.Password != "123456" {
-		return nil, status.Errorf(codes.Unauthenticated, "用户名或密码错误")
+	//2.自动迁移
+	log.Println("📦 Migrating database schema...")
+	if err := client.Schema.Create(context.Background()); err != nil {
+		log.Fatalf("❌ failed creating schema resources: %v", err)
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"user_id":  "u-001",
-		"username": req.Username,
-		"exp":      time.Now().Add(24 * time.Hour).Unix(),
-	})
+	log.Println("✅ Schema migrated successfully!")
 
-	tokenString, err := token.SignedString(jwtSecret)
+	//3.连接 Redis (token 吊销列表)
+	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	defer rdb.Close()
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		log.Fatalf("❌ Failed to connect to Redis: %v", err)
+	}
+
+	//4.初始化默认管理员
+	if err := server.EnsureAdmin(context.Background(), client, "admin", "123456"); err != nil {
+		log.Fatalf("❌ failed creating default admin: %v", err)
+	}
+
+	//5.加载签名密钥
+	keys, err := token.LoadKeySet(keysDir, activeKID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "生成token失败: %v", err)
+		log.Fatalf("❌ failed loading signing keys: %v", err)
 	}
+	log.Printf("🔑 Signing access tokens with key %s", keys.ActiveKID())
 
-	return &authv1.LoginResponse{
-		AccessToken: tokenString,
-		ExpiresIn:   86400, // 24小时 = 86400秒
-		Userme:      req.Username,
-	}, nil
-}
-func main() {
-	lis,err := net.Listen("tcp",":50054")
-	if err != nil { 
-		log.Fatalf("failed to listen : %v",err)
-	}	
+	//6.启动 grpc
+	lis, err := net.Listen("tcp", ":50054")
+	if err != nil {
+		log.Fatalf("failed to listen : %v", err)
+	}
 	s := grpc.NewServer()
-	authv1.RegisterAuthServiceServer(s,&AuthServer{})
+	authv1.RegisterAuthServiceServer(s, server.NewAuthServer(client, rdb, server.Config{
+		Keys:             keys,
+		RegistrationMode: registrationMode,
+		MFARequiredRoles: mfaRequiredRoles,
+		OIDC:             oidcConfigFromEnv(),
+		Notifier:         notifierFromEnv(),
+		PasswordResetURL: passwordResetURL,
+		TrustedProxies:   trustedProxiesFromEnv(),
+	}))
 
 	log.Println("Auth service is running on:50054")
 	s.Serve(lis)
-}
\ No newline at end of file
+}
+
+// trustedProxiesFromEnv AUTH_TRUSTED_PROXIES 为网关的地址，逗号分隔的 IP 或 CIDR，例如 "10.0.0.0/8,192.168.1.10"
+// 只有这些地址发来的请求才采信 x-forwarded-for；为空时只信任本机
+func trustedProxiesFromEnv() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, v := range strings.Split(os.Getenv("AUTH_TRUSTED_PROXIES"), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			addr, aerr := netip.ParseAddr(v)
+			if aerr != nil {
+				log.Fatalf("❌ invalid AUTH_TRUSTED_PROXIES entry %q", v)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes
+}
+
+// notifierFromEnv 配置了 SMTP_ADDR 时通过 SMTP 发送邮件，否则写到 MAIL_OUTBOX 目录 (默认 tmp/outbox)
+func notifierFromEnv() notify.Notifier {
+	if addr := os.Getenv("SMTP_ADDR"); addr != "" {
+		from := os.Getenv("SMTP_FROM")
+		if from == "" {
+			from = "no-reply@cheya.local"
+		}
+		log.Printf("📧 Sending mail via SMTP %s", addr)
+		return &notify.SMTPNotifier{
+			Addr:     addr,
+			From:     from,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+		}
+	}
+	dir := os.Getenv("MAIL_OUTBOX")
+	if dir == "" {
+		dir = "tmp/outbox"
+	}
+	n, err := notify.NewFileNotifier(dir)
+	if err != nil {
+		log.Fatalf("❌ failed creating mail outbox %s: %v", dir, err)
+	}
+	log.Printf("📧 SMTP not configured, writing mail to %s", dir)
+	return n
+}
+
+// oidcConfigFromEnv 从环境变量读取单点登录配置，OIDC_ISSUER 为空时不启用
+// OIDC_ROLE_MAPPING 形如 "fleet-admins=admin,dispatchers=operator"
+// 本地调试可以使用 tools/mockoidc 启动一个模拟 IdP
+func oidcConfigFromEnv() server.OIDCConfig {
+	cfg := server.OIDCConfig{
+		Issuer:       os.Getenv("OIDC_ISSUER"),
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		GroupsClaim:  os.Getenv("OIDC_GROUPS_CLAIM"),
+		DefaultRole:  os.Getenv("OIDC_DEFAULT_ROLE"),
+		RoleMapping:  map[string]string{},
+	}
+	if cfg.Issuer == "" {
+		return cfg
+	}
+	if cfg.RedirectURL == "" {
+		cfg.RedirectURL = "http://localhost:5173/login/oidc/callback"
+	}
+	for _, pair := range strings.Split(os.Getenv("OIDC_ROLE_MAPPING"), ",") {
+		group, role, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok {
+			cfg.RoleMapping[group] = role
+		}
+	}
+	if v := os.Getenv("OIDC_TENANT_ID"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("❌ invalid OIDC_TENANT_ID %q", v)
+		}
+		cfg.TenantID = id
+	}
+	log.Printf("🔐 OIDC single sign-on enabled, issuer %s", cfg.Issuer)
+	return cfg
+}
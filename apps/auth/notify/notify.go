@@ -0,0 +1,88 @@
+// Package notify 向用户发送通知 (找回密码邮件等)
+// 生产环境使用 SMTP，本地开发使用 FileNotifier 把邮件写到目录里
+package notify
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Message 一封通知
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Notifier 通知发送方式
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// FileNotifier 把邮件写成 .eml 文件，本地开发时代替 SMTP
+type FileNotifier struct {
+	Dir string
+}
+
+// NewFileNotifier 创建目录并返回 FileNotifier
+func NewFileNotifier(dir string) (*FileNotifier, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileNotifier{Dir: dir}, nil
+}
+
+// Send 每封邮件一个文件，文件名包含时间和收件人
+func (n *FileNotifier) Send(ctx context.Context, msg Message) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102-150405.000"), sanitize(msg.To))
+	return os.WriteFile(filepath.Join(n.Dir, name), format("cheya@localhost", msg), 0o600)
+}
+
+// SMTPNotifier 通过 SMTP 发送邮件
+// Username 为空时不做认证，适合本地 MailHog 之类的 SMTP 模拟器
+type SMTPNotifier struct {
+	Addr     string // host:port
+	From     string
+	Username string
+	Password string
+}
+
+// Send 发送邮件
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	var auth smtp.Auth
+	if n.Username != "" {
+		host, _, _ := strings.Cut(n.Addr, ":")
+		auth = smtp.PlainAuth("", n.Username, n.Password, host)
+	}
+	return smtp.SendMail(n.Addr, auth, n.From, []string{msg.To}, format(n.From, msg))
+}
+
+// format 生成 RFC 5322 邮件
+func format(from string, msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject)) // 中文主题按 RFC 2047 编码
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// sanitize 收件人地址用作文件名时去掉路径分隔符等字符
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '@' || r == '.' || r == '-' || r == '_' || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, s)
+}
@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/apikey"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// apiKeyTouchInterval last_used_at 的最小更新间隔，避免每次请求都写库
+const apiKeyTouchInterval = time.Minute
+
+var errInvalidAPIKey = errors.New("invalid, expired or revoked api key")
+
+// roleRank 角色高低，创建的 API key 不能高于创建者
+var roleRank = map[string]int{
+	"viewer":         1,
+	"operator":       2,
+	"admin":          3,
+	"platform_admin": 4,
+}
+
+// normalizeScopes 校验 API key 的权限范围并去重，至少需要一个
+func normalizeScopes(errs *grpcerr.FieldErrors, scopes []string) []string {
+	out := make([]string, 0, len(scopes))
+	for _, sc := range scopes {
+		sc = strings.TrimSpace(sc)
+		if !slices.Contains(token.Scopes, sc) {
+			errs.Add("scopes", "无效的权限范围 "+sc)
+			continue
+		}
+		if !slices.Contains(out, sc) {
+			out = append(out, sc)
+		}
+	}
+	if len(scopes) == 0 {
+		errs.Add("scopes", "请至少指定一个权限范围")
+	}
+	return out
+}
+
+// newAPIKey 生成 ck_<前缀>_<密钥>，前缀公开展示，密钥只返回一次
+func newAPIKey() (key, prefix string, err error) {
+	id, err := randomCode(5)
+	if err != nil {
+		return "", "", err
+	}
+	secret, err := randomCode(32)
+	if err != nil {
+		return "", "", err
+	}
+	prefix = token.APIKeyPrefix + strings.ToLower(id)
+	return prefix + "_" + secret, prefix, nil
+}
+
+// authenticateAPIKey 校验 API key 并记录使用时间
+func (s *AuthServer) authenticateAPIKey(ctx context.Context, key string) (*ent.APIKey, error) {
+	k, err := s.client.APIKey.Query().
+		Where(apikey.KeyHash(hashToken(key))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errInvalidAPIKey
+		}
+		return nil, err
+	}
+	now := time.Now()
+	if k.RevokedAt != nil || (k.ExpiresAt != nil && now.After(*k.ExpiresAt)) {
+		return nil, errInvalidAPIKey
+	}
+	if k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) > apiKeyTouchInterval {
+		if err := s.client.APIKey.UpdateOne(k).SetLastUsedAt(now).Exec(ctx); err != nil {
+			log.Printf("⚠️ update api key %s last_used_at failed: %v", k.Prefix, err)
+		}
+	}
+	return k, nil
+}
+
+// apiKeyClaims 把 API key 转换为与 access token 相同的身份信息
+func apiKeyClaims(k *ent.APIKey) *token.Claims {
+	subject := fmt.Sprintf("%s%d", token.APIKeySubjectPrefix, k.ID)
+	claims := &token.Claims{
+		UserID:   subject,
+		Username: k.Prefix,
+		Role:     k.Role.String(),
+		TenantID: formatTenantID(k.TenantID),
+		Scopes:   k.Scopes,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:   token.Issuer,
+			Subject:  subject,
+			IssuedAt: jwt.NewNumericDate(k.CreatedAt),
+		},
+	}
+	if k.ExpiresAt != nil {
+		claims.ExpiresAt = jwt.NewNumericDate(*k.ExpiresAt)
+	}
+	return claims
+}
+
+// isAPIKeyCaller 调用者是否使用 API key 认证
+func isAPIKeyCaller(claims *token.Claims) bool {
+	return token.IsAPIKeySubject(claims.Subject)
+}
+
+func toProtoAPIKey(k *ent.APIKey) *authv1.ApiKey {
+	pb := &authv1.ApiKey{
+		Id:        fmt.Sprintf("%d", k.ID),
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Role:      k.Role.String(),
+		TenantId:  formatTenantID(k.TenantID),
+		Scopes:    k.Scopes,
+		CreatedAt: timestamppb.New(k.CreatedAt),
+	}
+	if k.CreatedBy != 0 {
+		pb.CreatedBy = fmt.Sprintf("%d", k.CreatedBy)
+	}
+	if k.ExpiresAt != nil {
+		pb.ExpiresAt = timestamppb.New(*k.ExpiresAt)
+	}
+	if k.LastUsedAt != nil {
+		pb.LastUsedAt = timestamppb.New(*k.LastUsedAt)
+	}
+	if k.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*k.RevokedAt)
+	}
+	return pb
+}
+
+// requireKeyAdmin 管理 API key 需要用账号登录的管理员，泄露的 key 不能用来生成新 key
+func (s *AuthServer) requireKeyAdmin(ctx context.Context) (*token.Claims, error) {
+	claims, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isAPIKeyCaller(claims) {
+		return nil, status.Errorf(codes.PermissionDenied, "api keys cannot manage api keys")
+	}
+	return claims, nil
+}
+
+// CreateApiKey 创建 API key
+func (s *AuthServer) CreateApiKey(ctx context.Context, req *authv1.CreateApiKeyRequest) (*authv1.CreateApiKeyResponse, error) {
+	admin, err := s.requireKeyAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.参数校验
+	var errs grpcerr.FieldErrors
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 64 {
+		errs.Add("name", "名称不能为空且不能超过 64 个字符")
+	}
+	role := apikey.RoleViewer
+	if req.Role != "" {
+		role = apikey.Role(req.Role)
+		if apikey.RoleValidator(role) != nil {
+			errs.Add("role", "无效的角色")
+		} else if roleRank[req.Role] > roleRank[admin.Role] {
+			errs.Add("role", "不能创建高于自己角色的 API key")
+		}
+	}
+	scopes := normalizeScopes(&errs, req.Scopes)
+	if req.TtlSeconds < 0 {
+		errs.Add("ttl_seconds", "有效期不能为负数")
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	//2.租户: 平台管理员可以指定，其他管理员固定为自己的租户
+	tenantID := 0
+	if admin.Role == apikey.RolePlatformAdmin.String() {
+		if req.TenantId != "" {
+			if tenantID, err = parseTenantID(ctx, s.client, req.TenantId); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		tenantID, _ = strconv.Atoi(admin.TenantID)
+	}
+	if tenantID == 0 && role != apikey.RolePlatformAdmin {
+		errs.Add("tenant_id", "请指定 API key 所属的租户")
+		return nil, errs.Err()
+	}
+
+	//3.生成 key，只保存哈希
+	key, prefix, err := newAPIKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate api key: %v", err)
+	}
+	create := s.client.APIKey.Create().
+		SetName(name).
+		SetPrefix(prefix).
+		SetKeyHash(hashToken(key)).
+		SetRole(role).
+		SetScopes(scopes)
+	if tenantID != 0 {
+		create.SetTenantID(tenantID)
+	}
+	if adminID, err := strconv.Atoi(admin.UserID); err == nil {
+		create.SetCreatedBy(adminID)
+	}
+	if req.TtlSeconds > 0 {
+		create.SetExpiresAt(time.Now().Add(time.Duration(req.TtlSeconds) * time.Second))
+	}
+	k, err := create.Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create api key: %v", err)
+	}
+
+	log.Printf("🔑 API key %s (%s, role=%s, scopes=%v) created by %s", k.Prefix, k.Name, k.Role, k.Scopes, admin.Username)
+	return &authv1.CreateApiKeyResponse{ApiKey: toProtoAPIKey(k), Key: key}, nil
+}
+
+// ListApiKeys 列出 API key，租户管理员只能看到本租户的 key
+func (s *AuthServer) ListApiKeys(ctx context.Context, req *authv1.ListApiKeysRequest) (*authv1.ListApiKeysResponse, error) {
+	admin, err := s.requireKeyAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := s.client.APIKey.Query()
+	if admin.Role != apikey.RolePlatformAdmin.String() {
+		tenantID, _ := strconv.Atoi(admin.TenantID)
+		query.Where(apikey.TenantID(tenantID))
+	}
+	keys, err := query.Order(ent.Desc(apikey.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	resp := &authv1.ListApiKeysResponse{ApiKeys: make([]*authv1.ApiKey, len(keys))}
+	for i, k := range keys {
+		resp.ApiKeys[i] = toProtoAPIKey(k)
+	}
+	return resp, nil
+}
+
+// RevokeApiKey 吊销 API key
+// 网关和各服务每次请求都通过 Introspect 校验 API key，吊销后立即生效
+func (s *AuthServer) RevokeApiKey(ctx context.Context, req *authv1.RevokeApiKeyRequest) (*authv1.RevokeApiKeyResponse, error) {
+	admin, err := s.requireKeyAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %s", req.Id)
+	}
+	update := s.client.APIKey.Update().Where(apikey.ID(id), apikey.RevokedAtIsNil())
+	if admin.Role != apikey.RolePlatformAdmin.String() {
+		tenantID, _ := strconv.Atoi(admin.TenantID)
+		update.Where(apikey.TenantID(tenantID))
+	}
+	n, err := update.SetRevokedAt(time.Now()).Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		return nil, status.Errorf(codes.NotFound, "api key not found or already revoked: %s", req.Id)
+	}
+	log.Printf("🚫 API key %s revoked by %s", req.Id, admin.Username)
+	return &authv1.RevokeApiKeyResponse{Id: req.Id}, nil
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"log"
+	"net/netip"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/apps/auth/notify"
+	"github.com/xuewentao/cheya/pkg/token"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accessTokenTTL access token 有效期
+// 有效期较短，过期后通过 Refresh 用 refresh token 换取新 token
+const accessTokenTTL = 15 * time.Minute
+
+// dummyHash 用户不存在时也做一次 bcrypt 比较，避免通过响应时间枚举用户名
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("cheya-dummy-password"), bcrypt.DefaultCost)
+
+// Config 是 AuthServer 的配置
+type Config struct {
+	Keys             *token.KeySet // access token 签名私钥
+	RegistrationMode string        // open / approval / invite，默认 open
+	MFARequiredRoles []string      // 这些角色必须开启两步验证，未绑定的账号登录时强制绑定
+	OIDC             OIDCConfig    // 单点登录，Issuer 为空时不启用
+	Notifier         notify.Notifier
+	PasswordResetURL string         // 前端重置密码页地址，邮件中的链接为 <PasswordResetURL>?token=<令牌>
+	TrustedProxies   []netip.Prefix // 只采信这些地址 (网关) 转发的 x-forwarded-for，为空时只信任本机
+}
+
+// AuthServer 是对 AuthService 接口的具体实现
+type AuthServer struct {
+	authv1.UnimplementedAuthServiceServer
+	client           *ent.Client   //hold database client
+	rdb              *redis.Client //token 吊销列表
+	verifier         *token.Verifier
+	keys             *token.KeySet
+	registrationMode string
+	mfaRoles         map[string]bool
+	oidc             *oidcLogin // 未配置单点登录时为 nil
+	notifier         notify.Notifier
+	resetURL         string
+	trustedProxies   []netip.Prefix
+}
+
+// NewAuthServer 是构造函数
+func NewAuthServer(client *ent.Client, rdb *redis.Client, cfg Config) *AuthServer {
+	if cfg.RegistrationMode == "" {
+		cfg.RegistrationMode = RegistrationOpen
+	}
+	mfaRoles := make(map[string]bool, len(cfg.MFARequiredRoles))
+	for _, r := range cfg.MFARequiredRoles {
+		mfaRoles[r] = true
+	}
+	return &AuthServer{
+		client:           client,
+		rdb:              rdb,
+		verifier:         token.NewVerifier(cfg.Keys, rdb),
+		keys:             cfg.Keys,
+		registrationMode: cfg.RegistrationMode,
+		mfaRoles:         mfaRoles,
+		oidc:             newOIDCLogin(cfg.OIDC),
+		notifier:         cfg.Notifier,
+		resetURL:         cfg.PasswordResetURL,
+		trustedProxies:   cfg.TrustedProxies,
+	}
+}
+
+// Login 校验用户名密码并签发 JWT，需要两步验证时改为返回登录挑战
+func (s *AuthServer) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
+	if req.Username == "" || req.Password == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "用户名和密码不能为空")
+	}
+
+	//0.暴力破解防护: 账号或 IP 处于退避 / 锁定中时直接拒绝
+	ip := s.clientIP(ctx)
+	if err := s.checkLoginAllowed(ctx, req.Username, ip); err != nil {
+		return nil, err
+	}
+
+	//1.查询用户
+	u, err := s.client.User.Query().
+		Where(user.Username(req.Username)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			//不存在的用户名同样计数，行为与密码错误一致
+			bcrypt.CompareHashAndPassword(dummyHash, []byte(req.Password))
+			s.recordLoginFailure(ctx, req.Username, ip)
+			return nil, status.Errorf(codes.Unauthenticated, "用户名或密码错误")
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	//2.校验密码
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.Password)); err != nil {
+		s.recordLoginFailure(ctx, req.Username, ip)
+		return nil, status.Errorf(codes.Unauthenticated, "用户名或密码错误")
+	}
+	if !u.Enabled {
+		return nil, status.Errorf(codes.PermissionDenied, "账号已被禁用")
+	}
+	if !u.Approved {
+		return nil, status.Errorf(codes.PermissionDenied, "账号正在等待管理员审核")
+	}
+
+	//3.开启了两步验证或角色要求两步验证时，只返回登录挑战
+	//失败计数在第二步通过后才清零，避免知道密码的人借此重置计数
+	if u.TotpEnabled || s.mfaRequired(u) {
+		challenge, err := s.newMFAChallenge(ctx, u)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "生成登录挑战失败: %v", err)
+		}
+		return &authv1.LoginResponse{
+			Userme:                u.Username,
+			MfaRequired:           true,
+			MfaToken:              challenge,
+			MfaEnrollmentRequired: !u.TotpEnabled,
+		}, nil
+	}
+
+	//4.签发 token
+	return s.completeLogin(ctx, u)
+}
+
+// HashPassword 使用 bcrypt 生成密码哈希
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// EnsureAdmin 用户表为空时创建默认的平台管理员，方便本地开发
+func EnsureAdmin(ctx context.Context, client *ent.Client, username, password string) error {
+	n, err := client.User.Query().Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	hash, err := HashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := client.User.Create().
+		SetUsername(username).
+		SetPasswordHash(hash).
+		SetRole(user.RolePlatformAdmin).
+		Exec(ctx); err != nil {
+		return err
+	}
+	log.Printf("👤 Created default platform admin %q, please change the password", username)
+	return nil
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/enttest"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/pkg/token"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// testPassword 测试账号的密码
+const testPassword = "passw0rd-for-tests"
+
+// newTestServer 使用内存 SQLite 和 miniredis 创建 AuthServer
+func newTestServer(t *testing.T, cfg Config) (*AuthServer, *miniredis.Miniredis) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	if cfg.Keys == nil {
+		dir := t.TempDir()
+		if err := token.GenerateKeyFile(dir, "test"); err != nil {
+			t.Fatal(err)
+		}
+		keys, err := token.LoadKeySet(dir, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfg.Keys = keys
+	}
+	return NewAuthServer(client, rdb, cfg), mr
+}
+
+// createUser 创建一个可以登录的账号
+func createUser(t *testing.T, s *AuthServer, username string, role user.Role) *ent.User {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.client.User.Create().
+		SetUsername(username).
+		SetPasswordHash(string(hash)).
+		SetRole(role).
+		SaveX(context.Background())
+}
+
+// fromIP 模拟来自 ip 的直连请求
+func fromIP(ip string) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000}})
+}
+
+// withBearer 在 incoming metadata 中带上 access token
+func withBearer(ctx context.Context, accessToken string) context.Context {
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+accessToken))
+}
+
+// wantCode 断言 gRPC 错误码
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got %v (%v), want %v", got, err, code)
+	}
+}
@@ -0,0 +1,304 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Redis key 约定
+// auth:login:fail:<kind>:<id>       登录失败次数，窗口期内累计
+// auth:login:lock:<kind>:<id>       退避或锁定标记，value 为 backoff / lockout，过期自动解除
+// auth:login:known:<username>:<ip>  该 IP 最近成功登录过这个账号
+// kind 为 user (按用户名) 或 ip (按来源 IP)
+const (
+	loginFailPrefix  = "auth:login:fail:"
+	loginLockPrefix  = "auth:login:lock:"
+	loginKnownPrefix = "auth:login:known:"
+)
+
+const (
+	loginFailWindow = 30 * time.Minute    // 失败计数窗口，窗口内没有新的失败后清零
+	loginBackoffMax = 5 * time.Minute     // 单次退避上限
+	lockoutDuration = 30 * time.Minute    // 达到阈值后的锁定时长
+	knownIPDuration = 30 * 24 * time.Hour // 成功登录后该 IP 作为账号常用 IP 的时长
+)
+
+// 审计事件
+const (
+	auditAccountThrottled = "account_throttled"
+	auditAccountUnlocked  = "account_unlocked"
+	auditIPLocked         = "ip_locked"
+	auditIPUnlocked       = "ip_unlocked"
+)
+
+// loginLimit 一类登录失败计数的阈值
+// 前 free 次失败不限制，之后每次失败退避 1s、2s、4s... (最多 loginBackoffMax)；
+// 累计 lockAfter 次时写审计日志，lock 为 true 时同时锁定 lockoutDuration
+type loginLimit struct {
+	kind        string
+	free        int64
+	lockAfter   int64
+	lock        bool
+	lockedAudit string
+}
+
+var (
+	// 按来源 IP 计数是主要的防线: 攻击者换 IP 的成本远高于换用户名
+	// 同一出口 IP 后面可能有很多用户，阈值放宽
+	ipLoginLimit = loginLimit{kind: "ip", free: 20, lockAfter: 100, lock: true, lockedAudit: auditIPLocked}
+	// 按账号只退避不锁定，否则任何人都能从不同 IP 输错密码把 admin 等已知账号锁住；
+	// 最近从该 IP 成功登录过的用户不受账号退避影响 (见 knownLoginIP)
+	userLoginLimit = loginLimit{kind: "user", free: 5, lockAfter: 20, lockedAudit: auditAccountThrottled}
+)
+
+func (l loginLimit) failKey(id string) string { return loginFailPrefix + l.kind + ":" + id }
+func (l loginLimit) lockKey(id string) string { return loginLockPrefix + l.kind + ":" + id }
+
+// backoff 第 n 次失败后的等待时间
+func (l loginLimit) backoff(n int64) time.Duration {
+	if n <= l.free {
+		return 0
+	}
+	shift := n - l.free - 1
+	if shift > 16 {
+		return loginBackoffMax
+	}
+	return min(time.Second<<shift, loginBackoffMax)
+}
+
+// clientIP 取调用方 IP
+// 只有来自可信代理 (网关) 的请求才读取 x-forwarded-for，否则客户端可以伪造该头绕过 IP 限流，
+// 或者让任意 IP 被锁定；其它请求使用连接的对端地址
+func (s *AuthServer) clientIP(ctx context.Context) string {
+	var peerIP string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		peerIP = host
+	}
+	if !s.trustedProxy(peerIP) {
+		return peerIP
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		ip, _, _ := strings.Cut(values[0], ",")
+		if addr, err := netip.ParseAddr(strings.TrimSpace(ip)); err == nil {
+			return addr.Unmap().String()
+		}
+	}
+	return peerIP
+}
+
+// trustedProxy 对端地址是否为可信代理，没有配置时只信任本机
+func (s *AuthServer) trustedProxy(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if len(s.trustedProxies) == 0 {
+		return addr.IsLoopback()
+	}
+	for _, prefix := range s.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// knownLoginKey 记录 IP 最近成功登录过账号的 key
+func knownLoginKey(username, ip string) string { return loginKnownPrefix + username + ":" + ip }
+
+// knownLoginIP 该 IP 最近是否成功登录过这个账号
+// 用户在常用网络登录时不受账号退避影响，攻击者对账号的猜测不会把真实用户挡在外面
+func (s *AuthServer) knownLoginIP(ctx context.Context, username, ip string) bool {
+	if username == "" || ip == "" {
+		return false
+	}
+	n, err := s.rdb.Exists(ctx, knownLoginKey(username, ip)).Result()
+	if err != nil {
+		log.Printf("⚠️ check known login ip failed: %v", err)
+		return false
+	}
+	return n > 0
+}
+
+// checkLoginAllowed 账号或 IP 处于退避 / 锁定中时拒绝登录，在校验密码之前调用
+// Redis 不可用时放行，避免 Redis 故障导致所有人无法登录
+func (s *AuthServer) checkLoginAllowed(ctx context.Context, username, ip string) error {
+	type check struct {
+		limit loginLimit
+		id    string
+	}
+	checks := []check{{ipLoginLimit, ip}}
+	if !s.knownLoginIP(ctx, username, ip) {
+		checks = append(checks, check{userLoginLimit, username})
+	}
+	for _, c := range checks {
+		if c.id == "" {
+			continue
+		}
+		key := c.limit.lockKey(c.id)
+		pipe := s.rdb.Pipeline()
+		get := pipe.Get(ctx, key)
+		ttl := pipe.PTTL(ctx, key)
+		if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+			log.Printf("⚠️ check login lock failed: %v", err)
+			continue
+		}
+		if get.Err() == redis.Nil || ttl.Val() <= 0 {
+			continue
+		}
+		return tooManyAttempts(get.Val() == "lockout", ttl.Val())
+	}
+	return nil
+}
+
+// tooManyAttempts ResourceExhausted + RetryInfo，网关据此返回 429 和 Retry-After
+func tooManyAttempts(locked bool, retryAfter time.Duration) error {
+	seconds := int64((retryAfter + time.Second - 1) / time.Second)
+	msg := fmt.Sprintf("登录失败次数过多，请 %d 秒后再试", seconds)
+	if locked {
+		msg = fmt.Sprintf("登录失败次数过多，已被临时锁定，请 %d 分钟后再试或联系管理员解锁", (seconds+59)/60)
+	}
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(seconds) * time.Second),
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+	return st.Err()
+}
+
+// recordLoginFailure 累计失败次数，超过阈值后设置退避或锁定
+// 来自该账号常用 IP 的失败只按 IP 计数
+func (s *AuthServer) recordLoginFailure(ctx context.Context, username, ip string) {
+	if ip != "" {
+		s.recordFailure(ctx, ipLoginLimit, ip, username, ip)
+	}
+	if !s.knownLoginIP(ctx, username, ip) {
+		s.recordFailure(ctx, userLoginLimit, username, username, ip)
+	}
+}
+
+func (s *AuthServer) recordFailure(ctx context.Context, limit loginLimit, id, username, ip string) {
+	pipe := s.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, limit.failKey(id))
+	pipe.Expire(ctx, limit.failKey(id), loginFailWindow)
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("⚠️ record login failure failed: %v", err)
+		return
+	}
+	n := incr.Val()
+
+	switch {
+	case n >= limit.lockAfter && limit.lock:
+		//只在刚达到阈值时锁定并审计，锁定期间的请求在校验密码前就被拒绝了
+		if n != limit.lockAfter {
+			return
+		}
+		if err := s.rdb.Set(ctx, limit.lockKey(id), "lockout", lockoutDuration).Err(); err != nil {
+			log.Printf("⚠️ set login lock failed: %v", err)
+			return
+		}
+		log.Printf("🔒 Login locked: %s=%s after %d failures", limit.kind, id, n)
+		s.audit(ctx, limit.lockedAudit, username, ip, "", fmt.Sprintf("%d failed attempts, locked for %s", n, lockoutDuration))
+	case n > limit.free:
+		if n == limit.lockAfter {
+			log.Printf("⚠️ Login throttled: %s=%s after %d failures", limit.kind, id, n)
+			s.audit(ctx, limit.lockedAudit, username, ip, "", fmt.Sprintf("%d failed attempts", n))
+		}
+		if err := s.rdb.Set(ctx, limit.lockKey(id), "backoff", limit.backoff(n)).Err(); err != nil {
+			log.Printf("⚠️ set login backoff failed: %v", err)
+		}
+	}
+}
+
+// resetLoginFailures 登录成功后清空该账号的失败计数，ip 不为空时记为该账号的常用 IP
+// IP 计数不清零，否则攻击者可以用自己的账号反复重置
+func (s *AuthServer) resetLoginFailures(ctx context.Context, username, ip string) {
+	pipe := s.rdb.TxPipeline()
+	pipe.Del(ctx, userLoginLimit.failKey(username), userLoginLimit.lockKey(username))
+	if ip != "" {
+		pipe.Set(ctx, knownLoginKey(username, ip), 1, knownIPDuration)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("⚠️ reset login failures failed: %v", err)
+	}
+}
+
+// audit 写入审计日志，失败只记录日志
+func (s *AuthServer) audit(ctx context.Context, action, username, ip, actor, detail string) {
+	if err := s.client.AuditLog.Create().
+		SetAction(action).
+		SetUsername(username).
+		SetIP(ip).
+		SetActor(actor).
+		SetDetail(detail).
+		Exec(ctx); err != nil {
+		log.Printf("⚠️ write audit log %s failed: %v", action, err)
+	}
+}
+
+// UnlockAccount 解除账号或 IP 的登录锁定，同时清空失败计数
+func (s *AuthServer) UnlockAccount(ctx context.Context, req *authv1.UnlockAccountRequest) (*authv1.UnlockAccountResponse, error) {
+	admin, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Username == "" && req.Ip == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username or ip is required")
+	}
+	isPlatformAdmin := admin.Role == user.RolePlatformAdmin.String()
+	if req.Ip != "" && !isPlatformAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "platform_admin role required to unlock ip")
+	}
+
+	if req.Username != "" {
+		//租户管理员只能解锁本租户的账号
+		if !isPlatformAdmin {
+			tenantID, _ := strconv.Atoi(admin.TenantID)
+			exists, err := s.client.User.Query().
+				Where(user.Username(req.Username), user.TenantID(tenantID)).
+				Exist(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "database error %v", err)
+			}
+			if !exists {
+				return nil, status.Errorf(codes.NotFound, "user not found: %s", req.Username)
+			}
+		}
+		if err := s.rdb.Del(ctx, userLoginLimit.failKey(req.Username), userLoginLimit.lockKey(req.Username)).Err(); err != nil {
+			return nil, status.Errorf(codes.Internal, "unlock account: %v", err)
+		}
+		log.Printf("🔓 Account %s unlocked by %s", req.Username, admin.Username)
+		s.audit(ctx, auditAccountUnlocked, req.Username, "", admin.Username, "")
+	}
+	if req.Ip != "" {
+		if err := s.rdb.Del(ctx, ipLoginLimit.failKey(req.Ip), ipLoginLimit.lockKey(req.Ip)).Err(); err != nil {
+			return nil, status.Errorf(codes.Internal, "unlock ip: %v", err)
+		}
+		log.Printf("🔓 IP %s unlocked by %s", req.Ip, admin.Username)
+		s.audit(ctx, auditIPUnlocked, "", req.Ip, admin.Username, "")
+	}
+	return &authv1.UnlockAccountResponse{}, nil
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent/auditlog"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoginLimitBackoff(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want time.Duration
+	}{
+		{1, 0},
+		{5, 0},
+		{6, time.Second},
+		{7, 2 * time.Second},
+		{10, 16 * time.Second},
+		{13, 128 * time.Second},
+		{14, 256 * time.Second},
+		{15, loginBackoffMax},
+		{100, loginBackoffMax},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.n), func(t *testing.T) {
+			if got := userLoginLimit.backoff(tt.n); got != tt.want {
+				t.Errorf("backoff(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoginBackoffAfterFreeAttempts(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	createUser(t, s, "alice", user.RoleOperator)
+	ctx := fromIP("203.0.113.5")
+
+	//1.前 5 次失败只返回密码错误
+	for i := range 5 {
+		_, err := s.Login(ctx, &authv1.LoginRequest{Username: "alice", Password: "wrong"})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("attempt %d: got %v, want Unauthenticated", i+1, err)
+		}
+	}
+	//2.第 6 次失败后开始退避，即使密码正确也要等待
+	_, err := s.Login(ctx, &authv1.LoginRequest{Username: "alice", Password: "wrong"})
+	wantCode(t, err, codes.Unauthenticated)
+	_, err = s.Login(ctx, &authv1.LoginRequest{Username: "alice", Password: testPassword})
+	wantCode(t, err, codes.ResourceExhausted)
+
+	var retry *errdetails.RetryInfo
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() != time.Second {
+		t.Errorf("retry info = %v, want 1s", retry)
+	}
+}
+
+func TestAccountIsNeverHardLocked(t *testing.T) {
+	s, mr := newTestServer(t, Config{})
+	ctx := context.Background()
+
+	//攻击者从不同 IP 对同一账号输错密码，账号只退避不锁定
+	for i := range 25 {
+		s.recordLoginFailure(ctx, "admin", fmt.Sprintf("198.51.100.%d", i+1))
+	}
+	lockKey := userLoginLimit.lockKey("admin")
+	if got, _ := mr.Get(lockKey); got != "backoff" {
+		t.Fatalf("account lock = %q, want backoff", got)
+	}
+	if ttl := mr.TTL(lockKey); ttl <= 0 || ttl > loginBackoffMax {
+		t.Errorf("account backoff ttl = %s, want (0, %s]", ttl, loginBackoffMax)
+	}
+
+	err := s.checkLoginAllowed(ctx, "admin", "192.0.2.1")
+	wantCode(t, err, codes.ResourceExhausted)
+	if strings.Contains(status.Convert(err).Message(), "锁定") {
+		t.Errorf("account backoff reported as lockout: %s", status.Convert(err).Message())
+	}
+
+	//达到阈值时只审计一次
+	n := s.client.AuditLog.Query().
+		Where(auditlog.Action(auditAccountThrottled), auditlog.Username("admin")).
+		CountX(ctx)
+	if n != 1 {
+		t.Errorf("account_throttled audit rows = %d, want 1", n)
+	}
+}
+
+func TestIPLockout(t *testing.T) {
+	s, mr := newTestServer(t, Config{})
+	ctx := context.Background()
+	ip := "203.0.113.9"
+
+	//同一 IP 尝试大量不同账号，达到阈值后锁定该 IP
+	for i := range int(ipLoginLimit.lockAfter) - 1 {
+		s.recordLoginFailure(ctx, fmt.Sprintf("user%d", i), ip)
+	}
+	if got, _ := mr.Get(ipLoginLimit.lockKey(ip)); got != "backoff" {
+		t.Fatalf("ip lock before threshold = %q, want backoff", got)
+	}
+	s.recordLoginFailure(ctx, "user-last", ip)
+
+	lockKey := ipLoginLimit.lockKey(ip)
+	if got, _ := mr.Get(lockKey); got != "lockout" {
+		t.Fatalf("ip lock = %q, want lockout", got)
+	}
+	if ttl := mr.TTL(lockKey); ttl != lockoutDuration {
+		t.Errorf("ip lock ttl = %s, want %s", ttl, lockoutDuration)
+	}
+
+	err := s.checkLoginAllowed(ctx, "someone-else", ip)
+	wantCode(t, err, codes.ResourceExhausted)
+	if !strings.Contains(status.Convert(err).Message(), "锁定") {
+		t.Errorf("ip lockout message = %s", status.Convert(err).Message())
+	}
+	if err := s.checkLoginAllowed(ctx, "someone-else", "203.0.113.10"); err != nil {
+		t.Errorf("other ip blocked: %v", err)
+	}
+
+	n := s.client.AuditLog.Query().Where(auditlog.Action(auditIPLocked), auditlog.IP(ip)).CountX(ctx)
+	if n != 1 {
+		t.Errorf("ip_locked audit rows = %d, want 1", n)
+	}
+}
+
+func TestKnownIPBypassesAccountBackoff(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	createUser(t, s, "alice", user.RoleOperator)
+	home := "192.0.2.20"
+	attacker := "198.51.100.66"
+
+	//1.用户从常用 IP 登录成功过
+	if _, err := s.Login(fromIP(home), &authv1.LoginRequest{Username: "alice", Password: testPassword}); err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	//2.攻击者从别的 IP 猜密码，账号进入退避
+	for range 10 {
+		s.recordLoginFailure(context.Background(), "alice", attacker)
+	}
+	_, err := s.Login(fromIP(attacker), &authv1.LoginRequest{Username: "alice", Password: testPassword})
+	wantCode(t, err, codes.ResourceExhausted)
+
+	//3.真实用户从常用 IP 仍然可以登录
+	if _, err := s.Login(fromIP(home), &authv1.LoginRequest{Username: "alice", Password: testPassword}); err != nil {
+		t.Fatalf("Login from known ip: %v", err)
+	}
+}
+
+func TestKnownIPFailuresOnlyCountPerIP(t *testing.T) {
+	s, mr := newTestServer(t, Config{})
+	ctx := context.Background()
+	home := "192.0.2.20"
+	s.resetLoginFailures(ctx, "alice", home)
+
+	for range 10 {
+		s.recordLoginFailure(ctx, "alice", home)
+	}
+	if mr.Exists(userLoginLimit.failKey("alice")) {
+		t.Error("failures from a known ip counted against the account")
+	}
+	if got, _ := mr.Get(ipLoginLimit.failKey(home)); got != "10" {
+		t.Errorf("ip failures = %q, want 10", got)
+	}
+}
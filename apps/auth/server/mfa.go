@@ -0,0 +1,411 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+	"github.com/redis/go-redis/v9"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/recoverycode"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Redis key 约定
+// auth:mfa:challenge:<sha256>  登录挑战，hash {user_id, attempts}，过期自动作废
+// auth:mfa:used:<uid>:<code>   已使用的 TOTP 验证码，防止同一个验证码在有效期内被重放
+const (
+	mfaChallengePrefix = "auth:mfa:challenge:"
+	mfaUsedCodePrefix  = "auth:mfa:used:"
+)
+
+const (
+	totpIssuer        = "CheYa"
+	mfaChallengeTTL   = 5 * time.Minute
+	mfaMaxAttempts    = 5                // 同一个挑战最多尝试次数，超过后需要重新输入密码
+	recoveryCodeCount = 10               // 每次生成的恢复码数量
+	totpUsedCodeTTL   = 90 * time.Second // 覆盖 Skew 允许的前后三个周期
+)
+
+// 审计事件
+const (
+	auditMFAEnabled      = "mfa_enabled"
+	auditMFADisabled     = "mfa_disabled"
+	auditRecoveryUsed    = "mfa_recovery_code_used"
+	auditMFAChallengeEnd = "mfa_challenge_exhausted"
+)
+
+// totpValidateOpts 30 秒一个周期，前后各容忍一个周期的时钟偏差
+var totpValidateOpts = totp.ValidateOpts{
+	Period:    30,
+	Skew:      1,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// mfaRequired 该角色是否强制开启两步验证
+func (s *AuthServer) mfaRequired(u *ent.User) bool {
+	return s.mfaRoles[u.Role.String()]
+}
+
+// newMFAChallenge 密码校验通过后生成登录挑战，只保存哈希
+func (s *AuthServer) newMFAChallenge(ctx context.Context, u *ent.User) (string, error) {
+	challenge, err := randomCode(32)
+	if err != nil {
+		return "", err
+	}
+	key := mfaChallengePrefix + hashToken(challenge)
+	pipe := s.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "user_id", u.ID, "attempts", 0)
+	pipe.Expire(ctx, key, mfaChallengeTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return challenge, nil
+}
+
+// challengeUser 根据登录挑战找到用户，挑战不存在或已过期时返回 Unauthenticated
+func (s *AuthServer) challengeUser(ctx context.Context, challenge string) (*ent.User, error) {
+	if challenge == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "mfa_token is required")
+	}
+	id, err := s.rdb.HGet(ctx, mfaChallengePrefix+hashToken(challenge), "user_id").Int()
+	if err == redis.Nil {
+		return nil, status.Errorf(codes.Unauthenticated, "登录已过期，请重新输入密码")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "redis error: %v", err)
+	}
+	u, err := s.client.User.Get(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "登录已过期，请重新输入密码")
+	}
+	if !u.Enabled {
+		return nil, status.Errorf(codes.PermissionDenied, "账号已被禁用")
+	}
+	return u, nil
+}
+
+// failChallenge 记录一次验证码错误，超过次数后作废挑战
+func (s *AuthServer) failChallenge(ctx context.Context, challenge string, u *ent.User, ip string) {
+	key := mfaChallengePrefix + hashToken(challenge)
+	n, err := s.rdb.HIncrBy(ctx, key, "attempts", 1).Result()
+	if err != nil {
+		log.Printf("⚠️ record mfa failure failed: %v", err)
+	} else if n >= mfaMaxAttempts {
+		s.rdb.Del(ctx, key)
+		s.audit(ctx, auditMFAChallengeEnd, u.Username, ip, "", fmt.Sprintf("%d wrong codes", n))
+	}
+	//验证码错误同样计入登录失败，知道密码的攻击者也会被退避和锁定
+	s.recordLoginFailure(ctx, u.Username, ip)
+}
+
+// validateTOTP 校验验证码，同一个验证码只能使用一次
+func (s *AuthServer) validateTOTP(ctx context.Context, u *ent.User, code string) bool {
+	if u.TotpSecret == nil {
+		return false
+	}
+	code = strings.TrimSpace(code)
+	ok, err := totp.ValidateCustom(code, *u.TotpSecret, time.Now(), totpValidateOpts)
+	if err != nil || !ok {
+		return false
+	}
+	fresh, err := s.rdb.SetNX(ctx, mfaUsedCodePrefix+strconv.Itoa(u.ID)+":"+code, 1, totpUsedCodeTTL).Result()
+	if err != nil {
+		//Redis 不可用时放行，与登录限流的策略一致
+		log.Printf("⚠️ check totp replay failed: %v", err)
+		return true
+	}
+	return fresh
+}
+
+// useRecoveryCode 消耗一个恢复码，条件更新保证并发下也只能用一次
+func (s *AuthServer) useRecoveryCode(ctx context.Context, u *ent.User, code string) (bool, error) {
+	n, err := s.client.RecoveryCode.Update().
+		Where(
+			recoverycode.UserID(u.ID),
+			recoverycode.CodeHash(hashToken(normalizeRecoveryCode(code))),
+			recoverycode.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
+
+// verifySecondFactor 校验 TOTP 验证码或恢复码
+func (s *AuthServer) verifySecondFactor(ctx context.Context, u *ent.User, code, recovery, ip string) (bool, error) {
+	switch {
+	case code != "":
+		return s.validateTOTP(ctx, u, code), nil
+	case recovery != "":
+		ok, err := s.useRecoveryCode(ctx, u, recovery)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+		if ok {
+			s.audit(ctx, auditRecoveryUsed, u.Username, ip, u.Username, "")
+		}
+		return ok, nil
+	default:
+		return false, status.Errorf(codes.InvalidArgument, "code 或 recovery_code 不能为空")
+	}
+}
+
+// normalizeRecoveryCode 忽略大小写和分隔符，方便用户手动输入
+func normalizeRecoveryCode(code string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// enableTOTP 开启两步验证并生成新的恢复码，旧恢复码全部作废
+func (s *AuthServer) enableTOTP(ctx context.Context, u *ent.User) ([]string, error) {
+	recovery := make([]string, recoveryCodeCount)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(u.ID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	builders := make([]*ent.RecoveryCodeCreate, recoveryCodeCount)
+	for i := range recovery {
+		raw, err := randomCode(5)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		recovery[i] = raw[:4] + "-" + raw[4:]
+		builders[i] = tx.RecoveryCode.Create().
+			SetUserID(u.ID).
+			SetCodeHash(hashToken(raw))
+	}
+	if err := tx.RecoveryCode.CreateBulk(builders...).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.User.UpdateOneID(u.ID).SetTotpEnabled(true).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, auditMFAEnabled, u.Username, "", u.Username, "")
+	return recovery, nil
+}
+
+// completeLogin 登录全部校验通过后签发 token
+func (s *AuthServer) completeLogin(ctx context.Context, u *ent.User) (*authv1.LoginResponse, error) {
+	s.resetLoginFailures(ctx, u.Username, s.clientIP(ctx))
+
+	//每次登录开启一个新会话
+	pair, err := s.issueTokens(ctx, s.client, u, "")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成token失败: %v", err)
+	}
+
+	//记录登录时间，失败不影响登录
+	if err := u.Update().SetLastLoginAt(time.Now()).Exec(ctx); err != nil {
+		log.Printf("⚠️ update last_login_at failed: %v", err)
+	}
+
+	return &authv1.LoginResponse{
+		AccessToken:      pair.accessToken,
+		ExpiresIn:        int32(accessTokenTTL.Seconds()),
+		Userme:           u.Username,
+		RefreshToken:     pair.refreshToken,
+		RefreshExpiresIn: int32(refreshTokenTTL.Seconds()),
+	}, nil
+}
+
+// VerifyMfa 登录第二步
+// 已开启两步验证: 校验验证码或恢复码
+// 强制绑定中: 校验刚绑定的验证器生成的验证码，同时开启两步验证并返回恢复码
+func (s *AuthServer) VerifyMfa(ctx context.Context, req *authv1.VerifyMfaRequest) (*authv1.LoginResponse, error) {
+	//1.找到挑战对应的用户
+	u, err := s.challengeUser(ctx, req.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+	ip := s.clientIP(ctx)
+	if err := s.checkLoginAllowed(ctx, u.Username, ip); err != nil {
+		return nil, err
+	}
+
+	//2.强制绑定时只接受验证码，此时还没有恢复码
+	enrolling := !u.TotpEnabled
+	if enrolling && u.TotpSecret == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "请先调用 EnrollTotp 绑定验证器")
+	}
+	if enrolling && req.Code == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code is required")
+	}
+	ok, err := s.verifySecondFactor(ctx, u, req.Code, req.RecoveryCode, ip)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.failChallenge(ctx, req.MfaToken, u, ip)
+		return nil, status.Errorf(codes.Unauthenticated, "验证码错误")
+	}
+
+	//3.挑战只能使用一次
+	if err := s.rdb.Del(ctx, mfaChallengePrefix+hashToken(req.MfaToken)).Err(); err != nil {
+		log.Printf("⚠️ delete mfa challenge failed: %v", err)
+	}
+
+	var recovery []string
+	if enrolling {
+		if recovery, err = s.enableTOTP(ctx, u); err != nil {
+			return nil, status.Errorf(codes.Internal, "开启两步验证失败: %v", err)
+		}
+	}
+
+	//4.签发 token
+	resp, err := s.completeLogin(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	resp.RecoveryCodes = recovery
+	return resp, nil
+}
+
+// callerUser 找到当前操作的用户: 强制绑定 TOTP 时来自登录挑战，否则来自 access token
+func (s *AuthServer) callerUser(ctx context.Context, challenge string) (*ent.User, error) {
+	if challenge != "" {
+		return s.challengeUser(ctx, challenge)
+	}
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isAPIKeyCaller(claims) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key 不能执行此操作")
+	}
+	id, err := strconv.Atoi(claims.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid subject")
+	}
+	u, err := s.client.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	return u, nil
+}
+
+// EnrollTotp 生成新的 TOTP 密钥，确认之前不会生效
+func (s *AuthServer) EnrollTotp(ctx context.Context, req *authv1.EnrollTotpRequest) (*authv1.EnrollTotpResponse, error) {
+	u, err := s.callerUser(ctx, req.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "两步验证已开启，如需更换验证器请先关闭")
+	}
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: u.Username,
+		Period:      totpValidateOpts.Period,
+		Digits:      totpValidateOpts.Digits,
+		Algorithm:   totpValidateOpts.Algorithm,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成密钥失败: %v", err)
+	}
+	if err := u.Update().SetTotpSecret(key.Secret()).Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	return &authv1.EnrollTotpResponse{
+		Secret:     key.Secret(),
+		OtpauthUri: key.URL(),
+	}, nil
+}
+
+// ConfirmTotp 已登录用户确认绑定，验证码正确后开启两步验证
+func (s *AuthServer) ConfirmTotp(ctx context.Context, req *authv1.ConfirmTotpRequest) (*authv1.ConfirmTotpResponse, error) {
+	u, err := s.callerUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if u.TotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "两步验证已开启")
+	}
+	if u.TotpSecret == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "请先调用 EnrollTotp 绑定验证器")
+	}
+	if !s.validateTOTP(ctx, u, req.Code) {
+		return nil, status.Errorf(codes.InvalidArgument, "验证码错误")
+	}
+	recovery, err := s.enableTOTP(ctx, u)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "开启两步验证失败: %v", err)
+	}
+	return &authv1.ConfirmTotpResponse{RecoveryCodes: recovery}, nil
+}
+
+// DisableTotp 关闭两步验证，角色强制要求时不允许关闭
+func (s *AuthServer) DisableTotp(ctx context.Context, req *authv1.DisableTotpRequest) (*authv1.DisableTotpResponse, error) {
+	//1.校验身份
+	u, err := s.callerUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if !u.TotpEnabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "两步验证未开启")
+	}
+	if s.mfaRequired(u) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s 角色必须开启两步验证", u.Role)
+	}
+
+	//2.需要密码和第二因素，防止 access token 泄露后被关闭
+	//与登录共用失败计数和退避，泄露的 access token 不能用来无限次猜密码
+	ip := s.clientIP(ctx)
+	if err := s.checkLoginAllowed(ctx, u.Username, ip); err != nil {
+		return nil, err
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.Password)); err != nil {
+		s.recordLoginFailure(ctx, u.Username, ip)
+		return nil, status.Errorf(codes.Unauthenticated, "密码错误")
+	}
+	ok, err := s.verifySecondFactor(ctx, u, req.Code, req.RecoveryCode, ip)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		s.recordLoginFailure(ctx, u.Username, ip)
+		return nil, status.Errorf(codes.Unauthenticated, "验证码错误")
+	}
+
+	//3.清除密钥和恢复码
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if _, err := tx.RecoveryCode.Delete().Where(recoverycode.UserID(u.ID)).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if err := tx.User.UpdateOneID(u.ID).ClearTotpSecret().SetTotpEnabled(false).Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	s.audit(ctx, auditMFADisabled, u.Username, ip, u.Username, "")
+	return &authv1.DisableTotpResponse{}, nil
+}
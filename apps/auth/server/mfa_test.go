@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/auditlog"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"google.golang.org/grpc/codes"
+)
+
+// createMFAUser 创建已开启两步验证的账号，返回 TOTP 密钥和恢复码
+func createMFAUser(t *testing.T, s *AuthServer, username string) (*ent.User, string, []string) {
+	t.Helper()
+	u := createUser(t, s, username, user.RoleOperator)
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: totpIssuer, AccountName: username})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u = u.Update().SetTotpSecret(key.Secret()).SaveX(context.Background())
+	recovery, err := s.enableTOTP(context.Background(), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u, key.Secret(), recovery
+}
+
+// totpCode 当前周期的验证码
+func totpCode(t *testing.T, secret string) string {
+	t.Helper()
+	code, err := totp.GenerateCodeCustom(secret, time.Now(), totpValidateOpts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// wrongTOTPCode 在容忍的时钟偏差内都不成立的验证码
+func wrongTOTPCode(t *testing.T, secret string) string {
+	t.Helper()
+	now := time.Now()
+	valid := map[string]bool{}
+	for _, d := range []time.Duration{-30 * time.Second, 0, 30 * time.Second} {
+		code, err := totp.GenerateCodeCustom(secret, now.Add(d), totpValidateOpts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		valid[code] = true
+	}
+	for _, code := range []string{"000000", "111111", "222222", "333333"} {
+		if !valid[code] {
+			return code
+		}
+	}
+	t.Fatal("no invalid code found")
+	return ""
+}
+
+// mfaLogin 密码校验通过，返回登录挑战
+func mfaLogin(t *testing.T, s *AuthServer, ctx context.Context, username string) string {
+	t.Helper()
+	resp, err := s.Login(ctx, &authv1.LoginRequest{Username: username, Password: testPassword})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if !resp.MfaRequired || resp.MfaToken == "" || resp.AccessToken != "" {
+		t.Fatalf("Login did not return an mfa challenge: %+v", resp)
+	}
+	return resp.MfaToken
+}
+
+func TestVerifyMfaAttemptLimit(t *testing.T) {
+	s, mr := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	_, secret, _ := createMFAUser(t, s, "alice")
+	challenge := mfaLogin(t, s, ctx, "alice")
+	wrong := wrongTOTPCode(t, secret)
+
+	//1.错误次数达到上限后挑战作废
+	for i := range mfaMaxAttempts {
+		_, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: challenge, Code: wrong})
+		wantCode(t, err, codes.Unauthenticated)
+		if i < mfaMaxAttempts-1 && !mr.Exists(mfaChallengePrefix+hashToken(challenge)) {
+			t.Fatalf("challenge dropped after %d attempts", i+1)
+		}
+	}
+	if mr.Exists(mfaChallengePrefix + hashToken(challenge)) {
+		t.Fatal("challenge still valid after max attempts")
+	}
+
+	//2.之后即使验证码正确也必须重新输入密码
+	_, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: challenge, Code: totpCode(t, secret)})
+	wantCode(t, err, codes.Unauthenticated)
+
+	n := s.client.AuditLog.Query().Where(auditlog.Action(auditMFAChallengeEnd)).CountX(context.Background())
+	if n != 1 {
+		t.Errorf("mfa_challenge_exhausted audit rows = %d, want 1", n)
+	}
+	//验证码错误同样计入登录失败
+	if got, _ := mr.Get(userLoginLimit.failKey("alice")); got != "5" {
+		t.Errorf("login failures = %q, want 5", got)
+	}
+}
+
+func TestVerifyMfaChallengeSingleUse(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	_, _, recovery := createMFAUser(t, s, "alice")
+	challenge := mfaLogin(t, s, ctx, "alice")
+
+	resp, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: challenge, RecoveryCode: recovery[0]})
+	if err != nil {
+		t.Fatalf("VerifyMfa: %v", err)
+	}
+	if resp.AccessToken == "" || resp.RefreshToken == "" {
+		t.Fatalf("no tokens issued: %+v", resp)
+	}
+	_, err = s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: challenge, RecoveryCode: recovery[1]})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestVerifyMfaRejectsReplayedCode(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	_, secret, _ := createMFAUser(t, s, "alice")
+	code := totpCode(t, secret)
+
+	if _, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: mfaLogin(t, s, ctx, "alice"), Code: code}); err != nil {
+		t.Fatalf("VerifyMfa: %v", err)
+	}
+
+	//同一个验证码在有效期内不能再次使用，即使换了新的登录挑战
+	_, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: mfaLogin(t, s, ctx, "alice"), Code: code})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestRecoveryCodeSingleUse(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	_, _, recovery := createMFAUser(t, s, "alice")
+	if len(recovery) != recoveryCodeCount {
+		t.Fatalf("got %d recovery codes, want %d", len(recovery), recoveryCodeCount)
+	}
+
+	tests := []struct {
+		name string
+		code string
+		want codes.Code
+	}{
+		//忽略大小写和分隔符
+		{"first use", "  " + recovery[0][:4] + " " + recovery[0][5:], codes.OK},
+		{"reused", recovery[0], codes.Unauthenticated},
+		{"another code", recovery[1], codes.OK},
+		{"unknown", "ZZZZ-ZZZZ", codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: mfaLogin(t, s, ctx, "alice"), RecoveryCode: tt.code})
+			wantCode(t, err, tt.want)
+		})
+	}
+
+	n := s.client.AuditLog.Query().Where(auditlog.Action(auditRecoveryUsed)).CountX(context.Background())
+	if n != 2 {
+		t.Errorf("recovery code audit rows = %d, want 2", n)
+	}
+}
+
+func TestRecoveryCodesReplacedOnReenable(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	u, _, old := createMFAUser(t, s, "alice")
+
+	if _, err := s.enableTOTP(context.Background(), u); err != nil {
+		t.Fatal(err)
+	}
+	_, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: mfaLogin(t, s, ctx, "alice"), RecoveryCode: old[0]})
+	wantCode(t, err, codes.Unauthenticated)
+}
+
+func TestDisableTotpCountsFailures(t *testing.T) {
+	s, mr := newTestServer(t, Config{})
+	ctx := fromIP("203.0.113.5")
+	_, secret, recovery := createMFAUser(t, s, "alice")
+	resp, err := s.VerifyMfa(ctx, &authv1.VerifyMfaRequest{MfaToken: mfaLogin(t, s, ctx, "alice"), RecoveryCode: recovery[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	//从另一个 IP 使用泄露的 access token
+	authed := withBearer(fromIP("198.51.100.66"), resp.AccessToken)
+
+	//1.密码错误和验证码错误都计入登录失败
+	_, err = s.DisableTotp(authed, &authv1.DisableTotpRequest{Password: "wrong", Code: totpCode(t, secret)})
+	wantCode(t, err, codes.Unauthenticated)
+	_, err = s.DisableTotp(authed, &authv1.DisableTotpRequest{Password: testPassword, Code: wrongTOTPCode(t, secret)})
+	wantCode(t, err, codes.Unauthenticated)
+	if got, _ := mr.Get(userLoginLimit.failKey("alice")); got != "2" {
+		t.Fatalf("login failures = %q, want 2", got)
+	}
+
+	//2.退避期间直接拒绝，不再校验密码
+	for range 4 {
+		s.recordLoginFailure(context.Background(), "alice", "198.51.100.66")
+	}
+	_, err = s.DisableTotp(authed, &authv1.DisableTotpRequest{Password: testPassword, Code: totpCode(t, secret)})
+	wantCode(t, err, codes.ResourceExhausted)
+}
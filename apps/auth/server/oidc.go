@@ -0,0 +1,311 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Redis key 约定
+// auth:oidc:state:<state>  授权请求，hash {nonce, verifier}，回调时删除，过期自动作废
+const oidcStatePrefix = "auth:oidc:state:"
+
+const oidcStateTTL = 10 * time.Minute
+
+// 审计事件
+const (
+	auditOIDCLogin       = "oidc_login"
+	auditOIDCProvisioned = "oidc_user_provisioned"
+)
+
+// OIDCConfig 单点登录配置，Issuer 为空时不启用
+type OIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string            // 登录完成后 IdP 跳转的地址 (前端回调页)，需要在 IdP 登记
+	Scopes       []string          // 默认 openid profile email
+	GroupsClaim  string            // ID token 中的组声明，默认 groups
+	RoleMapping  map[string]string // IdP 组 -> cheya 角色，命中多个时取最高的角色
+	DefaultRole  string            // 没有命中任何组时使用的角色，为空时拒绝登录
+	TenantID     int               // 自动创建的账号归属的租户，0 表示不分配
+}
+
+// oidcLogin 单点登录客户端
+// IdP 的 discovery 文档在第一次使用时才拉取，IdP 暂时不可用不影响 auth service 启动
+type oidcLogin struct {
+	cfg OIDCConfig
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+}
+
+func newOIDCLogin(cfg OIDCConfig) *oidcLogin {
+	if cfg.Issuer == "" {
+		return nil
+	}
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{oidc.ScopeOpenID, "profile", "email"}
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = "groups"
+	}
+	//忽略不存在的角色，避免写库时才失败
+	for group, role := range cfg.RoleMapping {
+		if _, ok := roleRank[role]; !ok {
+			log.Printf("⚠️ OIDC role mapping %s=%s ignored: unknown role", group, role)
+			delete(cfg.RoleMapping, group)
+		}
+	}
+	if _, ok := roleRank[cfg.DefaultRole]; cfg.DefaultRole != "" && !ok {
+		log.Printf("⚠️ OIDC default role %s ignored: unknown role", cfg.DefaultRole)
+		cfg.DefaultRole = ""
+	}
+	return &oidcLogin{cfg: cfg}
+}
+
+// oauth2Config 拉取 discovery 文档并生成 OAuth2 配置，成功后缓存 provider
+func (o *oidcLogin) oauth2Config(ctx context.Context) (*oauth2.Config, *oidc.Provider, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.provider == nil {
+		provider, err := oidc.NewProvider(ctx, o.cfg.Issuer)
+		if err != nil {
+			return nil, nil, err
+		}
+		o.provider = provider
+	}
+	return &oauth2.Config{
+		ClientID:     o.cfg.ClientID,
+		ClientSecret: o.cfg.ClientSecret,
+		RedirectURL:  o.cfg.RedirectURL,
+		Endpoint:     o.provider.Endpoint(),
+		Scopes:       o.cfg.Scopes,
+	}, o.provider, nil
+}
+
+// mapRole 按组映射角色，命中多个组时取最高的角色
+func (o *oidcLogin) mapRole(groups []string) string {
+	role := o.cfg.DefaultRole
+	for _, g := range groups {
+		if r, ok := o.cfg.RoleMapping[g]; ok && roleRank[r] > roleRank[role] {
+			role = r
+		}
+	}
+	return role
+}
+
+// oidcClaims ID token 中用到的声明
+type oidcClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// groupsClaim 读取组声明，兼容字符串数组和单个字符串
+func groupsClaim(idToken *oidc.IDToken, claim string) []string {
+	var all map[string]any
+	if err := idToken.Claims(&all); err != nil {
+		return nil
+	}
+	switch v := all[claim].(type) {
+	case string:
+		return []string{v}
+	case []any:
+		groups := make([]string, 0, len(v))
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+		return groups
+	}
+	return nil
+}
+
+// StartOidcLogin 生成授权地址，state / nonce / PKCE verifier 保存在 Redis 中
+func (s *AuthServer) StartOidcLogin(ctx context.Context, req *authv1.StartOidcLoginRequest) (*authv1.StartOidcLoginResponse, error) {
+	if s.oidc == nil {
+		return nil, status.Errorf(codes.Unimplemented, "未配置单点登录")
+	}
+	conf, _, err := s.oidc.oauth2Config(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "单点登录服务不可用: %v", err)
+	}
+
+	state, err := randomCode(20)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成 state 失败: %v", err)
+	}
+	nonce, err := randomCode(20)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成 nonce 失败: %v", err)
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	key := oidcStatePrefix + state
+	pipe := s.rdb.TxPipeline()
+	pipe.HSet(ctx, key, "nonce", nonce, "verifier", verifier)
+	pipe.Expire(ctx, key, oidcStateTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "redis error: %v", err)
+	}
+
+	return &authv1.StartOidcLoginResponse{
+		AuthorizationUrl: conf.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(verifier)),
+		State:            state,
+	}, nil
+}
+
+// CompleteOidcLogin 用授权码换取 ID token，校验后找到或创建对应账号并签发 token
+// 单点登录账号的两步验证由 IdP 负责，这里不再要求 TOTP
+func (s *AuthServer) CompleteOidcLogin(ctx context.Context, req *authv1.CompleteOidcLoginRequest) (*authv1.LoginResponse, error) {
+	if s.oidc == nil {
+		return nil, status.Errorf(codes.Unimplemented, "未配置单点登录")
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "code 和 state 不能为空")
+	}
+
+	//1.取出授权请求，state 只能使用一次
+	key := oidcStatePrefix + req.State
+	pipe := s.rdb.TxPipeline()
+	get := pipe.HGetAll(ctx, key)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "redis error: %v", err)
+	}
+	pending := get.Val()
+	if pending["nonce"] == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "登录已过期，请重新登录")
+	}
+
+	//2.用授权码换取 token
+	conf, provider, err := s.oidc.oauth2Config(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "单点登录服务不可用: %v", err)
+	}
+	tok, err := conf.Exchange(ctx, req.Code, oauth2.VerifierOption(pending["verifier"]))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "授权码无效: %v", err)
+	}
+
+	//3.校验 ID token 的签名、issuer、audience、有效期和 nonce
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "IdP 没有返回 id_token")
+	}
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: s.oidc.cfg.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "id_token 校验失败: %v", err)
+	}
+	if idToken.Nonce != pending["nonce"] {
+		return nil, status.Errorf(codes.Unauthenticated, "id_token nonce 不匹配")
+	}
+	var claims oidcClaims
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "id_token 声明无效: %v", err)
+	}
+
+	//4.组映射为角色
+	role := s.oidc.mapRole(groupsClaim(idToken, s.oidc.cfg.GroupsClaim))
+	if role == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "没有使用本平台的权限，请联系管理员")
+	}
+
+	//5.找到或创建账号
+	u, err := s.provisionOIDCUser(ctx, idToken, claims, user.Role(role))
+	if err != nil {
+		return nil, err
+	}
+	if !u.Enabled {
+		return nil, status.Errorf(codes.PermissionDenied, "账号已被禁用")
+	}
+	s.audit(ctx, auditOIDCLogin, u.Username, s.clientIP(ctx), "", idToken.Issuer)
+
+	//6.签发 cheya 自己的 token
+	return s.completeLogin(ctx, u)
+}
+
+// provisionOIDCUser 按 issuer + sub 找到账号，不存在时创建
+// 角色以 IdP 为准，每次登录都会同步；租户只在创建时分配，之后由管理员调整
+func (s *AuthServer) provisionOIDCUser(ctx context.Context, idToken *oidc.IDToken, claims oidcClaims, role user.Role) (*ent.User, error) {
+	u, err := s.client.User.Query().
+		Where(user.OidcIssuer(idToken.Issuer), user.OidcSubject(idToken.Subject)).
+		Only(ctx)
+	if err == nil {
+		if u.Role != role {
+			if u, err = u.Update().SetRole(role).Save(ctx); err != nil {
+				return nil, status.Errorf(codes.Internal, "database error: %v", err)
+			}
+		}
+		return u, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	//单点登录账号不能用密码登录，写入一个随机密码的哈希
+	random, err := randomCode(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成密码失败: %v", err)
+	}
+	hash, err := HashPassword(random)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成密码失败: %v", err)
+	}
+
+	create := s.client.User.Create().
+		SetUsername(s.oidcUsername(ctx, idToken, claims)).
+		SetPasswordHash(hash).
+		SetRole(role).
+		SetOidcIssuer(idToken.Issuer).
+		SetOidcSubject(idToken.Subject)
+	//邮箱已被其他账号使用时不写入，不会自动关联到已有的本地账号
+	if claims.Email != "" && claims.EmailVerified {
+		taken, err := s.client.User.Query().Where(user.Email(claims.Email)).Exist(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error: %v", err)
+		}
+		if !taken {
+			create.SetEmail(claims.Email)
+		}
+	}
+	if s.oidc.cfg.TenantID != 0 {
+		create.SetTenantID(s.oidc.cfg.TenantID)
+	}
+	u, err = create.Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "创建账号失败: %v", err)
+	}
+	log.Printf("👤 Provisioned user %q from %s", u.Username, idToken.Issuer)
+	s.audit(ctx, auditOIDCProvisioned, u.Username, s.clientIP(ctx), "", fmt.Sprintf("%s sub=%s role=%s", idToken.Issuer, idToken.Subject, role))
+	return u, nil
+}
+
+// oidcUsername 优先使用 preferred_username 或邮箱前缀
+// 不符合用户名规则或已被占用时，使用由 issuer + sub 生成的固定用户名
+func (s *AuthServer) oidcUsername(ctx context.Context, idToken *oidc.IDToken, claims oidcClaims) string {
+	candidate := claims.PreferredUsername
+	if candidate == "" && claims.Email != "" {
+		candidate, _, _ = strings.Cut(claims.Email, "@")
+	}
+	if usernamePattern.MatchString(candidate) {
+		taken, err := s.client.User.Query().Where(user.Username(candidate)).Exist(ctx)
+		if err == nil && !taken {
+			return candidate
+		}
+	}
+	return "sso-" + hashToken(idToken.Issuer + "|" + idToken.Subject)[:12]
+}
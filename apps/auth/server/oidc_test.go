@@ -0,0 +1,269 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"google.golang.org/grpc/codes"
+)
+
+const testOIDCClientID = "cheya-web"
+
+// oidcGrant IdP 为一个授权码记录的信息
+type oidcGrant struct {
+	challenge string // 授权请求中的 code_challenge
+	nonce     string // 写入 id_token 的 nonce
+	subject   string
+	groups    any
+}
+
+// fakeIdP 最小的 OIDC 提供方: discovery、JWKS 和校验 PKCE 的 token 端点
+type fakeIdP struct {
+	srv *httptest.Server
+	key *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]oidcGrant
+}
+
+func newFakeIdP(t *testing.T) *fakeIdP {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idp := &fakeIdP{key: key, grants: map[string]oidcGrant{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                idp.srv.URL,
+			"authorization_endpoint":                idp.srv.URL + "/authorize",
+			"token_endpoint":                        idp.srv.URL + "/token",
+			"jwks_uri":                              idp.srv.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "idp",
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", idp.token)
+	idp.srv = httptest.NewServer(mux)
+	t.Cleanup(idp.srv.Close)
+	return idp
+}
+
+// token 授权码只能用一次，code_verifier 必须与授权请求中的 code_challenge 匹配
+func (idp *fakeIdP) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	idp.mu.Lock()
+	grant, ok := idp.grants[r.Form.Get("code")]
+	delete(idp.grants, r.Form.Get("code"))
+	idp.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.Form.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":                idp.srv.URL,
+		"sub":                grant.subject,
+		"aud":                testOIDCClientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(time.Hour).Unix(),
+		"nonce":              grant.nonce,
+		"preferred_username": "carol",
+		"email":              "carol@example.com",
+		"email_verified":     true,
+		"groups":             grant.groups,
+	})
+	idToken.Header["kid"] = "idp"
+	raw, err := idToken.SignedString(idp.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "idp-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     raw,
+	})
+}
+
+// authorize 模拟用户在 IdP 登录并同意授权，返回授权码
+// nonce 为空时使用授权请求中的 nonce
+func (idp *fakeIdP) authorize(t *testing.T, authURL, nonce string, groups any) string {
+	t.Helper()
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		t.Fatalf("authorization url without PKCE: %s", authURL)
+	}
+	if q.Get("nonce") == "" || q.Get("state") == "" {
+		t.Fatalf("authorization url without nonce or state: %s", authURL)
+	}
+	if nonce == "" {
+		nonce = q.Get("nonce")
+	}
+	code := "code-" + q.Get("state")
+	idp.mu.Lock()
+	idp.grants[code] = oidcGrant{challenge: q.Get("code_challenge"), nonce: nonce, subject: "idp-user-1", groups: groups}
+	idp.mu.Unlock()
+	return code
+}
+
+func newOIDCTestServer(t *testing.T) (*AuthServer, *miniredis.Miniredis, *fakeIdP) {
+	t.Helper()
+	idp := newFakeIdP(t)
+	s, mr := newTestServer(t, Config{OIDC: OIDCConfig{
+		Issuer:      idp.srv.URL,
+		ClientID:    testOIDCClientID,
+		RedirectURL: "https://cheya.example.com/sso/callback",
+		RoleMapping: map[string]string{"fleet-ops": "operator", "fleet-admins": "admin", "typo": "superuser"},
+	}})
+	return s, mr, idp
+}
+
+func TestOIDCLogin(t *testing.T) {
+	s, _, idp := newOIDCTestServer(t)
+	ctx := context.Background()
+
+	//1.首次登录创建账号，角色取命中组中最高的
+	start, err := s.StartOidcLogin(ctx, &authv1.StartOidcLoginRequest{})
+	if err != nil {
+		t.Fatalf("StartOidcLogin: %v", err)
+	}
+	code := idp.authorize(t, start.AuthorizationUrl, "", []string{"staff", "fleet-ops"})
+	resp, err := s.CompleteOidcLogin(ctx, &authv1.CompleteOidcLoginRequest{Code: code, State: start.State})
+	if err != nil {
+		t.Fatalf("CompleteOidcLogin: %v", err)
+	}
+	if resp.AccessToken == "" || resp.Userme != "carol" {
+		t.Fatalf("unexpected login response: %+v", resp)
+	}
+	u := s.client.User.Query().Where(user.Username("carol")).OnlyX(ctx)
+	if u.Role != user.RoleOperator || u.OidcIssuer == nil || *u.OidcIssuer != idp.srv.URL {
+		t.Fatalf("provisioned user = %+v", u)
+	}
+
+	//2.再次登录时同步 IdP 的角色，组声明为单个字符串也可以识别
+	start, err = s.StartOidcLogin(ctx, &authv1.StartOidcLoginRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code = idp.authorize(t, start.AuthorizationUrl, "", "fleet-admins")
+	if _, err := s.CompleteOidcLogin(ctx, &authv1.CompleteOidcLoginRequest{Code: code, State: start.State}); err != nil {
+		t.Fatalf("second CompleteOidcLogin: %v", err)
+	}
+	if n := s.client.User.Query().CountX(ctx); n != 1 {
+		t.Errorf("users = %d, want 1", n)
+	}
+	if role := s.client.User.GetX(ctx, u.ID).Role; role != user.RoleAdmin {
+		t.Errorf("role after second login = %s, want admin", role)
+	}
+}
+
+func TestOIDCLoginRejected(t *testing.T) {
+	tests := []struct {
+		name string
+		// login 返回传给 CompleteOidcLogin 的 code 和 state
+		login func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string)
+		want  codes.Code
+	}{
+		{"unknown state", func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string) {
+			return idp.authorize(t, start.AuthorizationUrl, "", []string{"fleet-ops"}), "forged-state"
+		}, codes.Unauthenticated},
+		{"state reused", func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string) {
+			code := idp.authorize(t, start.AuthorizationUrl, "", []string{"fleet-ops"})
+			if _, err := s.CompleteOidcLogin(context.Background(), &authv1.CompleteOidcLoginRequest{Code: code, State: start.State}); err != nil {
+				t.Fatalf("first CompleteOidcLogin: %v", err)
+			}
+			return idp.authorize(t, start.AuthorizationUrl, "", []string{"fleet-ops"}), start.State
+		}, codes.Unauthenticated},
+		{"nonce mismatch", func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string) {
+			return idp.authorize(t, start.AuthorizationUrl, "replayed-nonce", []string{"fleet-ops"}), start.State
+		}, codes.Unauthenticated},
+		{"pkce verifier mismatch", func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string) {
+			mr.HSet(oidcStatePrefix+start.State, "verifier", "intercepted-code-without-the-real-verifier")
+			return idp.authorize(t, start.AuthorizationUrl, "", []string{"fleet-ops"}), start.State
+		}, codes.Unauthenticated},
+		{"no matching group", func(t *testing.T, s *AuthServer, mr *miniredis.Miniredis, idp *fakeIdP, start *authv1.StartOidcLoginResponse) (string, string) {
+			return idp.authorize(t, start.AuthorizationUrl, "", []string{"staff", "typo"}), start.State
+		}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, mr, idp := newOIDCTestServer(t)
+			start, err := s.StartOidcLogin(context.Background(), &authv1.StartOidcLoginRequest{})
+			if err != nil {
+				t.Fatalf("StartOidcLogin: %v", err)
+			}
+			code, state := tt.login(t, s, mr, idp, start)
+			_, err = s.CompleteOidcLogin(context.Background(), &authv1.CompleteOidcLoginRequest{Code: code, State: state})
+			wantCode(t, err, tt.want)
+		})
+	}
+}
+
+func TestOIDCMapRole(t *testing.T) {
+	o := newOIDCLogin(OIDCConfig{
+		Issuer:      "https://idp.example.com",
+		RoleMapping: map[string]string{"fleet-ops": "operator", "fleet-admins": "admin", "typo": "superuser"},
+		DefaultRole: "viewer",
+	})
+	tests := []struct {
+		name   string
+		groups []string
+		want   string
+	}{
+		{"no groups", nil, "viewer"},
+		{"unmapped groups", []string{"staff"}, "viewer"},
+		{"single", []string{"fleet-ops"}, "operator"},
+		{"highest wins", []string{"fleet-admins", "fleet-ops"}, "admin"},
+		{"unknown role ignored", []string{"typo"}, "viewer"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := o.mapRole(tt.groups); got != tt.want {
+				t.Errorf("mapRole(%v) = %q, want %q", tt.groups, got, tt.want)
+			}
+		})
+	}
+
+	//没有默认角色时，未命中任何组返回空，登录被拒绝
+	o = newOIDCLogin(OIDCConfig{Issuer: "https://idp.example.com", DefaultRole: "superuser"})
+	if got := o.mapRole([]string{"staff"}); got != "" {
+		t.Errorf("mapRole without default = %q, want empty", got)
+	}
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/organization"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// formatTenantID 租户 ID 在 token 和 API 中以字符串传递，0 表示没有租户
+func formatTenantID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// parseTenantID 解析并确认租户存在
+func parseTenantID(ctx context.Context, client *ent.Client, tenantID string) (int, error) {
+	id, err := strconv.Atoi(tenantID)
+	if err != nil || id <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid tenant_id: %s", tenantID)
+	}
+	exists, err := client.Organization.Query().Where(organization.ID(id)).Exist(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if !exists {
+		return 0, status.Errorf(codes.NotFound, "organization not found: %s", tenantID)
+	}
+	return id, nil
+}
+
+func toProtoOrganization(o *ent.Organization) *authv1.Organization {
+	return &authv1.Organization{
+		Id:        fmt.Sprintf("%d", o.ID),
+		Name:      o.Name,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
+
+// CreateOrganization 创建租户
+func (s *AuthServer) CreateOrganization(ctx context.Context, req *authv1.CreateOrganizationRequest) (*authv1.CreateOrganizationResponse, error) {
+	admin, err := s.requirePlatformAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > 64 {
+		var errs grpcerr.FieldErrors
+		errs.Add("name", "租户名称不能为空且不能超过 64 个字符")
+		return nil, errs.Err()
+	}
+	o, err := s.client.Organization.Create().SetName(name).Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "租户名称已存在")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create organization: %v", err)
+	}
+	log.Printf("🏢 Organization %q (id=%d) created by %s", o.Name, o.ID, admin.Username)
+	return &authv1.CreateOrganizationResponse{Organization: toProtoOrganization(o)}, nil
+}
+
+// ListOrganizations 列出全部租户
+func (s *AuthServer) ListOrganizations(ctx context.Context, req *authv1.ListOrganizationsRequest) (*authv1.ListOrganizationsResponse, error) {
+	if _, err := s.requirePlatformAdmin(ctx); err != nil {
+		return nil, err
+	}
+	orgs, err := s.client.Organization.Query().
+		Order(ent.Asc(organization.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	resp := &authv1.ListOrganizationsResponse{Organizations: make([]*authv1.Organization, len(orgs))}
+	for i, o := range orgs {
+		resp.Organizations[i] = toProtoOrganization(o)
+	}
+	return resp, nil
+}
+
+// SetUserOrganization 把用户分配到租户
+// 已签发的 access token 仍携带旧租户，直到刷新或过期 (最长 accessTokenTTL)
+func (s *AuthServer) SetUserOrganization(ctx context.Context, req *authv1.SetUserOrganizationRequest) (*authv1.SetUserOrganizationResponse, error) {
+	admin, err := s.requirePlatformAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := strconv.Atoi(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %s", req.UserId)
+	}
+	update := s.client.User.UpdateOneID(userID)
+	if req.TenantId == "" {
+		update.ClearTenantID()
+	} else {
+		tenantID, err := parseTenantID(ctx, s.client, req.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		update.SetTenantID(tenantID)
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "user not found: %s", req.UserId)
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	log.Printf("🏢 User %s moved to organization %q by %s", req.UserId, req.TenantId, admin.Username)
+	return &authv1.SetUserOrganizationResponse{UserId: req.UserId, TenantId: req.TenantId}, nil
+}
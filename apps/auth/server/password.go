@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/passwordresettoken"
+	"github.com/xuewentao/cheya/apps/auth/ent/refreshtoken"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/apps/auth/notify"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Redis key 约定
+// auth:reset:throttle:<uid>  同一账号申请找回密码的间隔
+const passwordResetThrottlePrefix = "auth:reset:throttle:"
+
+const (
+	passwordResetTTL      = 30 * time.Minute // 重置链接有效期
+	passwordResetInterval = time.Minute      // 同一账号两次申请的最小间隔
+)
+
+// 审计事件
+const (
+	auditPasswordChanged        = "password_changed"
+	auditPasswordResetRequested = "password_reset_requested"
+	auditPasswordReset          = "password_reset"
+)
+
+// revokeUserSessions 吊销用户的全部会话，修改或重置密码后调用
+func (s *AuthServer) revokeUserSessions(ctx context.Context, userID int) error {
+	//refresh token 已过期的会话不会再有有效的 access token，无需处理
+	families, err := s.client.RefreshToken.Query().
+		Where(
+			refreshtoken.UserID(userID),
+			refreshtoken.Revoked(false),
+			refreshtoken.ExpiresAtGT(time.Now()),
+		).
+		Unique(true).
+		Select(refreshtoken.FieldFamilyID).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+	for _, f := range families {
+		if err := s.revokeFamily(ctx, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangePassword 修改密码
+// 当前密码错误同样计入登录失败，防止泄露的 access token 被用来猜密码
+func (s *AuthServer) ChangePassword(ctx context.Context, req *authv1.ChangePasswordRequest) (*authv1.ChangePasswordResponse, error) {
+	//1.校验身份
+	u, err := s.callerUser(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	ip := s.clientIP(ctx)
+	if err := s.checkLoginAllowed(ctx, u.Username, ip); err != nil {
+		return nil, err
+	}
+
+	//2.校验当前密码和新密码
+	var errs grpcerr.FieldErrors
+	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(req.CurrentPassword)); err != nil {
+		s.recordLoginFailure(ctx, u.Username, ip)
+		errs.Add("current_password", "当前密码错误")
+		return nil, errs.Err()
+	}
+	if req.NewPassword == req.CurrentPassword {
+		errs.Add("new_password", "新密码不能与当前密码相同")
+	} else {
+		validatePassword(&errs, "new_password", req.NewPassword, u.Username)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	//3.更新密码并吊销全部会话
+	if err := s.setPassword(ctx, u, req.NewPassword); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, auditPasswordChanged, u.Username, ip, u.Username, "")
+	return &authv1.ChangePasswordResponse{}, nil
+}
+
+// setPassword 写入新密码哈希，然后吊销全部会话
+func (s *AuthServer) setPassword(ctx context.Context, u *ent.User, password string) error {
+	hash, err := HashPassword(password)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to hash password: %v", err)
+	}
+	if err := u.Update().SetPasswordHash(hash).Exec(ctx); err != nil {
+		return status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if err := s.revokeUserSessions(ctx, u.ID); err != nil {
+		return status.Errorf(codes.Internal, "revoke sessions: %v", err)
+	}
+	return nil
+}
+
+// RequestPasswordReset 向账号邮箱发送重置链接
+// 账号不存在、没有邮箱或是单点登录账号时同样返回成功，避免枚举账号
+func (s *AuthServer) RequestPasswordReset(ctx context.Context, req *authv1.RequestPasswordResetRequest) (*authv1.RequestPasswordResetResponse, error) {
+	if req.Username == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "username is required")
+	}
+	if s.notifier == nil {
+		return nil, status.Errorf(codes.Unimplemented, "未配置邮件发送，无法找回密码")
+	}
+
+	//1.按用户名或邮箱查找
+	u, err := s.client.User.Query().
+		Where(user.Or(user.Username(req.Username), user.Email(req.Username))).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &authv1.RequestPasswordResetResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if !u.Enabled || u.Email == nil || u.OidcIssuer != nil {
+		log.Printf("⚠️ Password reset for %q skipped: disabled, no email or single sign-on account", u.Username)
+		return &authv1.RequestPasswordResetResponse{}, nil
+	}
+
+	//2.限制申请频率
+	ok, err := s.rdb.SetNX(ctx, passwordResetThrottlePrefix+strconv.Itoa(u.ID), 1, passwordResetInterval).Result()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "redis error: %v", err)
+	}
+	if !ok {
+		return &authv1.RequestPasswordResetResponse{}, nil
+	}
+
+	//3.作废旧令牌并生成新令牌
+	raw, err := randomCode(32)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "生成令牌失败: %v", err)
+	}
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	now := time.Now()
+	if _, err := tx.PasswordResetToken.Update().
+		Where(passwordresettoken.UserID(u.ID), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(now).
+		Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if err := tx.PasswordResetToken.Create().
+		SetTokenHash(hashToken(raw)).
+		SetUserID(u.ID).
+		SetExpiresAt(now.Add(passwordResetTTL)).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	//4.异步发送邮件，响应时间不暴露账号是否存在
+	msg := notify.Message{
+		To:      *u.Email,
+		Subject: "CheYa 找回密码",
+		Body: fmt.Sprintf("%s，你好：\n\n请在 %d 分钟内打开以下链接设置新密码，链接只能使用一次：\n\n%s?token=%s\n\n如果不是你本人操作，请忽略这封邮件。\n",
+			u.Username, int(passwordResetTTL.Minutes()), s.resetURL, url.QueryEscape(raw)),
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := s.notifier.Send(ctx, msg); err != nil {
+			log.Printf("❌ send password reset mail to %s failed: %v", u.Username, err)
+		}
+	}()
+	s.audit(ctx, auditPasswordResetRequested, u.Username, s.clientIP(ctx), "", "")
+	return &authv1.RequestPasswordResetResponse{}, nil
+}
+
+// ConfirmPasswordReset 用重置令牌设置新密码
+func (s *AuthServer) ConfirmPasswordReset(ctx context.Context, req *authv1.ConfirmPasswordResetRequest) (*authv1.ConfirmPasswordResetResponse, error) {
+	var errs grpcerr.FieldErrors
+	invalid := func() error {
+		errs.Add("token", "重置链接无效或已过期，请重新申请")
+		return errs.Err()
+	}
+	if req.Token == "" {
+		return nil, invalid()
+	}
+
+	//1.校验令牌
+	t, err := s.client.PasswordResetToken.Query().
+		Where(passwordresettoken.TokenHash(hashToken(req.Token))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, invalid()
+		}
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if t.UsedAt != nil || time.Now().After(t.ExpiresAt) {
+		return nil, invalid()
+	}
+	u, err := s.client.User.Get(ctx, t.UserID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+
+	//2.校验新密码
+	validatePassword(&errs, "new_password", req.NewPassword, u.Username)
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	//3.条件更新保证并发提交时令牌只生效一次
+	n, err := s.client.PasswordResetToken.Update().
+		Where(passwordresettoken.ID(t.ID), passwordresettoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error: %v", err)
+	}
+	if n == 0 {
+		return nil, invalid()
+	}
+
+	//4.更新密码，吊销全部会话，并解除登录锁定
+	if err := s.setPassword(ctx, u, req.NewPassword); err != nil {
+		return nil, err
+	}
+	s.resetLoginFailures(ctx, u.Username, "")
+	s.audit(ctx, auditPasswordReset, u.Username, s.clientIP(ctx), u.Username, "")
+	return &authv1.ConfirmPasswordResetResponse{}, nil
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/invitecode"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// 注册模式
+const (
+	RegistrationOpen     = "open"     // 开放注册，注册后即可登录
+	RegistrationApproval = "approval" // 无邀请码注册需要管理员审核
+	RegistrationInvite   = "invite"   // 必须持有邀请码才能注册
+)
+
+// Register 注册新账号
+func (s *AuthServer) Register(ctx context.Context, req *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
+	//1.参数校验
+	var errs grpcerr.FieldErrors
+	validateUsername(&errs, req.Username)
+	validatePassword(&errs, "password", req.Password, req.Username)
+	validateEmail(&errs, req.Email)
+	if s.registrationMode == RegistrationInvite && req.InviteCode == "" {
+		errs.Add("invite_code", "需要邀请码才能注册")
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	hash, err := HashPassword(req.Password)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "hash password: %v", err)
+	}
+
+	//2.在事务中消费邀请码并创建用户
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	u, err := s.register(ctx, tx, req, hash)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	log.Printf("👤 User registered: %s (role=%s approved=%v)", u.Username, u.Role, u.Approved)
+	return &authv1.RegisterResponse{
+		UserId:          fmt.Sprintf("%d", u.ID),
+		Username:        u.Username,
+		PendingApproval: !u.Approved,
+		CreatedAt:       timestamppb.New(u.CreatedAt),
+	}, nil
+}
+
+func (s *AuthServer) register(ctx context.Context, tx *ent.Tx, req *authv1.RegisterRequest, hash string) (*ent.User, error) {
+	exists, err := tx.User.Query().Where(user.Username(req.Username)).Exist(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "用户名已存在")
+	}
+	if req.Email != "" {
+		exists, err := tx.User.Query().Where(user.Email(req.Email)).Exist(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+		if exists {
+			return nil, status.Errorf(codes.AlreadyExists, "邮箱已被使用")
+		}
+	}
+
+	role := user.RoleViewer
+	approved := s.registrationMode != RegistrationApproval
+	tenantID := 0
+	if req.InviteCode != "" {
+		invite, err := useInviteCode(ctx, tx, req.InviteCode)
+		if err != nil {
+			return nil, err
+		}
+		role = user.Role(invite.Role)
+		approved = true
+		tenantID = invite.TenantID
+	}
+
+	//没有邀请码的账号不属于任何租户，需要平台管理员分配后才能看到车辆
+	create := tx.User.Create().
+		SetUsername(req.Username).
+		SetPasswordHash(hash).
+		SetRole(role).
+		SetApproved(approved)
+	if tenantID != 0 {
+		create.SetTenantID(tenantID)
+	}
+	if req.Email != "" {
+		create.SetEmail(req.Email)
+	}
+	u, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, "用户名或邮箱已存在")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
+	}
+	return u, nil
+}
+
+// useInviteCode 原子地消费一次邀请码
+// 通过带条件的 UPDATE 保证并发注册时不会超出 max_uses
+func useInviteCode(ctx context.Context, tx *ent.Tx, code string) (*ent.InviteCode, error) {
+	n, err := tx.InviteCode.Update().
+		Where(
+			invitecode.Code(code),
+			invitecode.Or(invitecode.ExpiresAtIsNil(), invitecode.ExpiresAtGT(time.Now())),
+			func(s *sql.Selector) {
+				s.Where(sql.ColumnsLT(s.C(invitecode.FieldUsedCount), s.C(invitecode.FieldMaxUses)))
+			},
+		).
+		AddUsedCount(1).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		var errs grpcerr.FieldErrors
+		errs.Add("invite_code", "邀请码无效或已过期")
+		return nil, errs.Err()
+	}
+	invite, err := tx.InviteCode.Query().Where(invitecode.Code(code)).Only(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	return invite, nil
+}
+
+// ApproveUser 审核通过待审核账号
+// 租户管理员只能审核本租户的账号
+func (s *AuthServer) ApproveUser(ctx context.Context, req *authv1.ApproveUserRequest) (*authv1.ApproveUserResponse, error) {
+	admin, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(req.UserId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %s", req.UserId)
+	}
+	update := s.client.User.Update().Where(user.ID(id))
+	if admin.Role != user.RolePlatformAdmin.String() {
+		tenantID, _ := strconv.Atoi(admin.TenantID)
+		update.Where(user.TenantID(tenantID))
+	}
+	n, err := update.SetApproved(true).Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		return nil, status.Errorf(codes.NotFound, "user not found: %s", req.UserId)
+	}
+	log.Printf("✅ User %s approved by %s", req.UserId, admin.Username)
+	return &authv1.ApproveUserResponse{UserId: req.UserId}, nil
+}
+
+// CreateInviteCode 生成注册邀请码
+func (s *AuthServer) CreateInviteCode(ctx context.Context, req *authv1.CreateInviteCodeRequest) (*authv1.CreateInviteCodeResponse, error) {
+	admin, err := s.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	role := invitecode.RoleViewer
+	if req.Role != "" {
+		role = invitecode.Role(req.Role)
+		if err := invitecode.RoleValidator(role); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role: %s", req.Role)
+		}
+	}
+	maxUses := 1
+	if req.MaxUses > 0 {
+		maxUses = int(req.MaxUses)
+	}
+
+	//租户管理员只能邀请用户加入自己的租户，平台管理员需要指定租户
+	var tenantID int
+	if admin.Role == user.RolePlatformAdmin.String() {
+		if req.TenantId == "" {
+			var errs grpcerr.FieldErrors
+			errs.Add("tenant_id", "请指定邀请加入的租户")
+			return nil, errs.Err()
+		}
+		if tenantID, err = parseTenantID(ctx, s.client, req.TenantId); err != nil {
+			return nil, err
+		}
+	} else {
+		if tenantID, err = strconv.Atoi(admin.TenantID); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "admin does not belong to an organization")
+		}
+	}
+
+	code, err := randomCode(10)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate code: %v", err)
+	}
+	create := s.client.InviteCode.Create().
+		SetCode(code).
+		SetRole(role).
+		SetMaxUses(maxUses).
+		SetTenantID(tenantID)
+	if adminID, err := strconv.Atoi(admin.UserID); err == nil {
+		create.SetCreatedBy(adminID)
+	}
+	resp := &authv1.CreateInviteCodeResponse{Code: code}
+	if req.TtlSeconds > 0 {
+		expiresAt := time.Now().Add(time.Duration(req.TtlSeconds) * time.Second)
+		create.SetExpiresAt(expiresAt)
+		resp.ExpiresAt = timestamppb.New(expiresAt)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invite code: %v", err)
+	}
+	return resp, nil
+}
+
+// randomCode 生成 n 字节随机数的 base32 字符串
+func randomCode(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
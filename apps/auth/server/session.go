@@ -0,0 +1,177 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/refreshtoken"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// refreshTokenTTL refresh token 有效期
+const refreshTokenTTL = 7 * 24 * time.Hour
+
+// tokenPair 一次签发的 access token 与 refresh token
+type tokenPair struct {
+	accessToken  string
+	refreshToken string
+}
+
+// issueTokens 在 familyID 下签发一对新 token，familyID 为空时开启新会话
+func (s *AuthServer) issueTokens(ctx context.Context, client *ent.Client, u *ent.User, familyID string) (*tokenPair, error) {
+	if familyID == "" {
+		familyID = uuid.NewString()
+	}
+	refresh, err := randomCode(32)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.RefreshToken.Create().
+		SetTokenHash(hashToken(refresh)).
+		SetFamilyID(familyID).
+		SetUserID(u.ID).
+		SetExpiresAt(time.Now().Add(refreshTokenTTL)).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	access, err := s.signAccessToken(u, familyID)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenPair{accessToken: access, refreshToken: refresh}, nil
+}
+
+// Refresh 轮换 refresh token
+// 已使用或已吊销的 refresh token 再次出现时，视为泄露并吊销整个会话
+func (s *AuthServer) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.RefreshResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	//1.查询 refresh token
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hashToken(req.RefreshToken))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	//2.复用检测
+	if rt.Revoked || rt.UsedAt != nil {
+		s.revokeReused(ctx, rt)
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token reuse detected, session revoked")
+	}
+	if time.Now().After(rt.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token expired")
+	}
+
+	//3.标记旧 token 已使用并签发新 token
+	// 带 used_at IS NULL 条件的更新保证并发刷新时只有一个请求能成功
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	n, err := tx.RefreshToken.Update().
+		Where(refreshtoken.ID(rt.ID), refreshtoken.UsedAtIsNil()).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		tx.Rollback()
+		s.revokeReused(ctx, rt)
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token reuse detected, session revoked")
+	}
+
+	u, err := tx.User.Get(ctx, rt.UserID)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if !u.Enabled || !u.Approved {
+		tx.Rollback()
+		return nil, status.Errorf(codes.PermissionDenied, "账号不可用")
+	}
+	pair, err := s.issueTokens(ctx, tx.Client(), u, rt.FamilyID)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "生成token失败: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	return &authv1.RefreshResponse{
+		AccessToken:      pair.accessToken,
+		ExpiresIn:        int32(accessTokenTTL.Seconds()),
+		RefreshToken:     pair.refreshToken,
+		RefreshExpiresIn: int32(refreshTokenTTL.Seconds()),
+	}, nil
+}
+
+// Logout 吊销当前会话
+// refresh token 和 access token 至少提供一个
+func (s *AuthServer) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	var familyID string
+	if req.RefreshToken != "" {
+		rt, err := s.client.RefreshToken.Query().
+			Where(refreshtoken.TokenHash(hashToken(req.RefreshToken))).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+		if rt != nil {
+			familyID = rt.FamilyID
+		}
+	}
+
+	claims, err := s.callerClaims(ctx)
+	if err == nil {
+		if err := token.Revoke(ctx, s.rdb, claims); err != nil {
+			return nil, status.Errorf(codes.Internal, "revoke token: %v", err)
+		}
+		if familyID == "" {
+			familyID = claims.SessionID
+		}
+	} else if familyID == "" {
+		return nil, err
+	}
+
+	if err := s.revokeFamily(ctx, familyID); err != nil {
+		return nil, status.Errorf(codes.Internal, "revoke session: %v", err)
+	}
+	return &authv1.LogoutResponse{}, nil
+}
+
+// revokeReused 处理 refresh token 复用
+func (s *AuthServer) revokeReused(ctx context.Context, rt *ent.RefreshToken) {
+	log.Printf("🚨 Refresh token reuse detected: user=%d family=%s", rt.UserID, rt.FamilyID)
+	if err := s.revokeFamily(ctx, rt.FamilyID); err != nil {
+		log.Printf("❌ revoke family %s failed: %v", rt.FamilyID, err)
+	}
+}
+
+// revokeFamily 吊销整个会话: 作废所有 refresh token，并把 sid 写入 Redis 吊销列表
+func (s *AuthServer) revokeFamily(ctx context.Context, familyID string) error {
+	if familyID == "" {
+		return nil
+	}
+	if _, err := s.client.RefreshToken.Update().
+		Where(refreshtoken.FamilyID(familyID), refreshtoken.Revoked(false)).
+		SetRevoked(true).
+		Save(ctx); err != nil {
+		return err
+	}
+	return token.RevokeSession(ctx, s.rdb, familyID, accessTokenTTL)
+}
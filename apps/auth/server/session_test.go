@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent/refreshtoken"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc/codes"
+)
+
+func login(t *testing.T, s *AuthServer, username string) *authv1.LoginResponse {
+	t.Helper()
+	resp, err := s.Login(fromIP("198.51.100.7"), &authv1.LoginRequest{Username: username, Password: testPassword})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	return resp
+}
+
+func TestRefreshRotation(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := context.Background()
+	createUser(t, s, "alice", user.RoleOperator)
+	first := login(t, s, "alice")
+
+	//1.每次刷新都换发新的 refresh token，会话 (sid) 保持不变
+	second, err := s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: first.RefreshToken})
+	if err != nil {
+		t.Fatalf("Refresh: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token was not rotated")
+	}
+	before, err := s.verifier.Verify(ctx, first.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after, err := s.verifier.Verify(ctx, second.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.SessionID != after.SessionID {
+		t.Errorf("session changed on refresh: %s -> %s", before.SessionID, after.SessionID)
+	}
+
+	//2.新 token 可以继续刷新
+	if _, err := s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: second.RefreshToken}); err != nil {
+		t.Fatalf("Refresh with rotated token: %v", err)
+	}
+}
+
+func TestRefreshReuseRevokesSession(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := context.Background()
+	createUser(t, s, "alice", user.RoleOperator)
+	first := login(t, s, "alice")
+	second, err := s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: first.RefreshToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//旧 token 再次出现视为泄露，整个会话被吊销
+	_, err = s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: first.RefreshToken})
+	wantCode(t, err, codes.Unauthenticated)
+
+	_, err = s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: second.RefreshToken})
+	wantCode(t, err, codes.Unauthenticated)
+
+	if n := s.client.RefreshToken.Query().Where(refreshtoken.Revoked(false)).CountX(ctx); n != 0 {
+		t.Errorf("%d refresh tokens still active", n)
+	}
+	if _, err := s.verifier.Verify(ctx, second.AccessToken); err != token.ErrRevoked {
+		t.Errorf("access token of the revoked session: got %v, want %v", err, token.ErrRevoked)
+	}
+}
+
+func TestRefreshRejected(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := context.Background()
+	u := createUser(t, s, "alice", user.RoleOperator)
+	resp := login(t, s, "alice")
+
+	tests := []struct {
+		name    string
+		prepare func()
+		token   string
+		code    codes.Code
+	}{
+		{"empty", func() {}, "", codes.InvalidArgument},
+		{"unknown", func() {}, "not-a-refresh-token", codes.Unauthenticated},
+		{"expired", func() {
+			s.client.RefreshToken.Update().
+				Where(refreshtoken.TokenHash(hashToken(resp.RefreshToken))).
+				SetExpiresAt(time.Now().Add(-time.Minute)).
+				ExecX(ctx)
+		}, resp.RefreshToken, codes.Unauthenticated},
+		{"disabled user", func() {
+			s.client.RefreshToken.Update().
+				Where(refreshtoken.TokenHash(hashToken(resp.RefreshToken))).
+				SetExpiresAt(time.Now().Add(time.Hour)).
+				ExecX(ctx)
+			s.client.User.UpdateOne(u).SetEnabled(false).ExecX(ctx)
+		}, resp.RefreshToken, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			_, err := s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: tt.token})
+			wantCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestLogoutRevokesSession(t *testing.T) {
+	s, _ := newTestServer(t, Config{})
+	ctx := context.Background()
+	createUser(t, s, "alice", user.RoleOperator)
+	resp := login(t, s, "alice")
+
+	if _, err := s.Logout(withBearer(ctx, resp.AccessToken), &authv1.LogoutRequest{RefreshToken: resp.RefreshToken}); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := s.verifier.Verify(ctx, resp.AccessToken); err != token.ErrRevoked {
+		t.Errorf("access token after logout: got %v, want %v", err, token.ErrRevoked)
+	}
+	_, err := s.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: resp.RefreshToken})
+	wantCode(t, err, codes.Unauthenticated)
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/apps/auth/ent"
+	"github.com/xuewentao/cheya/apps/auth/ent/user"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// signAccessToken 为用户签发 access token
+// sessionID 是 refresh token 的家族 ID，吊销会话时据此让 access token 一并失效
+func (s *AuthServer) signAccessToken(u *ent.User, sessionID string) (string, error) {
+	now := time.Now()
+	return s.keys.Sign(token.Claims{
+		UserID:    fmt.Sprintf("%d", u.ID),
+		Username:  u.Username,
+		Role:      u.Role.String(),
+		SessionID: sessionID,
+		TenantID:  formatTenantID(u.TenantID),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    token.Issuer,
+			Subject:   fmt.Sprintf("%d", u.ID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenTTL)),
+		},
+	})
+}
+
+// GetJWKS 返回全部验签公钥，包括轮换中尚未下线的旧密钥
+func (s *AuthServer) GetJWKS(ctx context.Context, req *authv1.GetJWKSRequest) (*authv1.GetJWKSResponse, error) {
+	set := s.keys.JWKS()
+	resp := &authv1.GetJWKSResponse{Keys: make([]*authv1.JsonWebKey, len(set.Keys))}
+	for i, k := range set.Keys {
+		resp.Keys[i] = &authv1.JsonWebKey{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Use: k.Use,
+			Alg: k.Alg,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		}
+	}
+	return resp, nil
+}
+
+// hashToken refresh token 只以 sha256 形式入库
+func hashToken(t string) string {
+	sum := sha256.Sum256([]byte(t))
+	return hex.EncodeToString(sum[:])
+}
+
+// bearerToken 从 gRPC metadata 的 authorization 头中取出 token
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", token.ErrMissingToken
+	}
+	return token.FromAuthorization(values[0])
+}
+
+// callerClaims 解析并校验调用者的 access token 或 API key
+func (s *AuthServer) callerClaims(ctx context.Context) (*token.Claims, error) {
+	tokenString, err := bearerToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	claims, err := s.verify(ctx, tokenString)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	return claims, nil
+}
+
+// verify 校验 access token 或 API key
+func (s *AuthServer) verify(ctx context.Context, tokenString string) (*token.Claims, error) {
+	if token.IsAPIKey(tokenString) {
+		k, err := s.authenticateAPIKey(ctx, tokenString)
+		if err != nil {
+			return nil, err
+		}
+		return apiKeyClaims(k), nil
+	}
+	return s.verifier.Verify(ctx, tokenString)
+}
+
+// requireAdmin 要求调用者是租户管理员或平台管理员，API key 还需要 users:write
+func (s *AuthServer) requireAdmin(ctx context.Context) (*token.Claims, error) {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != user.RoleAdmin.String() && claims.Role != user.RolePlatformAdmin.String() {
+		return nil, status.Errorf(codes.PermissionDenied, "admin role required")
+	}
+	if !claims.HasScope(token.ScopeUsersWrite) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key scope %s required", token.ScopeUsersWrite)
+	}
+	return claims, nil
+}
+
+// requirePlatformAdmin 要求调用者是平台管理员，API key 还需要 users:write
+func (s *AuthServer) requirePlatformAdmin(ctx context.Context) (*token.Claims, error) {
+	claims, err := s.callerClaims(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Role != user.RolePlatformAdmin.String() {
+		return nil, status.Errorf(codes.PermissionDenied, "platform_admin role required")
+	}
+	if !claims.HasScope(token.ScopeUsersWrite) {
+		return nil, status.Errorf(codes.PermissionDenied, "api key scope %s required", token.ScopeUsersWrite)
+	}
+	return claims, nil
+}
+
+// Introspect 校验 token 并返回身份信息，供无法本地验签的服务以及 API key 使用
+func (s *AuthServer) Introspect(ctx context.Context, req *authv1.IntrospectRequest) (*authv1.IntrospectResponse, error) {
+	claims, err := s.verify(ctx, req.Token)
+	if err != nil {
+		return &authv1.IntrospectResponse{Active: false}, nil
+	}
+	tokenType := token.TypeAccessToken
+	if token.IsAPIKey(req.Token) {
+		tokenType = token.TypeAPIKey
+	}
+	resp := &authv1.IntrospectResponse{
+		Active:    true,
+		UserId:    claims.UserID,
+		Username:  claims.Username,
+		Role:      claims.Role,
+		SessionId: claims.SessionID,
+		TokenId:   claims.ID,
+		TenantId:  claims.TenantID,
+		TokenType: tokenType,
+		Scopes:    claims.Scopes,
+	}
+	if claims.IssuedAt != nil {
+		resp.IssuedAt = timestamppb.New(claims.IssuedAt.Time)
+	}
+	if claims.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	}
+	return resp, nil
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"net/mail"
+	"regexp"
+	"unicode"
+
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+)
+
+// 用户名: 3-32 位字母、数字、下划线、点或短横线
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{3,32}$`)
+
+const (
+	minPasswordLen = 8
+	maxPasswordLen = 72 // bcrypt 只使用前 72 字节
+)
+
+func validateUsername(errs *grpcerr.FieldErrors, username string) {
+	if !usernamePattern.MatchString(username) {
+		errs.Add("username", "用户名需为3-32位字母、数字、下划线、点或短横线")
+	}
+}
+
+// validatePassword 密码策略: 8-72 位，同时包含字母和数字，且不能与用户名相同
+func validatePassword(errs *grpcerr.FieldErrors, field, password, username string) {
+	if len(password) < minPasswordLen {
+		errs.Add(field, "密码长度至少为8位")
+		return
+	}
+	if len(password) > maxPasswordLen {
+		errs.Add(field, "密码长度不能超过72字节")
+		return
+	}
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		errs.Add(field, "密码需同时包含字母和数字")
+		return
+	}
+	if password == username {
+		errs.Add(field, "密码不能与用户名相同")
+	}
+}
+
+func validateEmail(errs *grpcerr.FieldErrors, email string) {
+	if email == "" {
+		return
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		errs.Add("email", "邮箱格式不正确")
+	}
+}
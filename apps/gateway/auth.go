@@ -0,0 +1,318 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+)
+
+// trustedProxiesFromEnv 网关前面的负载均衡地址，TRUSTED_PROXIES 为逗号分隔的 IP 或 CIDR
+// 未配置时不信任任何 X-Forwarded-For，客户端 IP 取连接的对端地址
+func trustedProxiesFromEnv() []string {
+	var proxies []string
+	for _, v := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			proxies = append(proxies, v)
+		}
+	}
+	return proxies
+}
+
+// forwardAuth 把 HTTP 请求的 Authorization 头透传到 gRPC metadata
+// 使用 X-API-Key 头的请求转换为 Authorization: Bearer <API key>；
+// 客户端 IP 放在 x-forwarded-for 中，auth service 据此做登录限流 (只采信网关转发的该字段，见 AUTH_TRUSTED_PROXIES)
+func forwardAuth(c *gin.Context) (context.Context, context.CancelFunc) {
+	return forwardAuthTimeout(c, 5*time.Second)
+}
+
+// forwardAuthTimeout 同 forwardAuth，用于导入导出等耗时较长的调用
+func forwardAuthTimeout(c *gin.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", c.ClientIP())
+	if auth := c.GetHeader("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	} else if key := c.GetHeader("X-API-Key"); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+key)
+	}
+	return ctx, cancel
+}
+
+// registerAuthRoutes 注册账号相关路由
+func registerAuthRoutes(r gin.IRoutes, authClient authv1.AuthServiceClient) {
+	//Register
+	r.POST("/api/v1/auth/register", func(c *gin.Context) {
+		var body struct {
+			Username        string `json:"username"`
+			Password        string `json:"password"`
+			ConfirmPassword string `json:"confirm_password"`
+			Email           string `json:"email"`
+			InviteCode      string `json:"invite_code"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "message": "Invalid body", "error": "Invalid body"})
+			return
+		}
+		if body.ConfirmPassword != "" && body.ConfirmPassword != body.Password {
+			c.JSON(400, gin.H{
+				"code":    400,
+				"message": "两次输入的密码不一致",
+				"errors":  []gin.H{{"field": "confirm_password", "message": "两次输入的密码不一致"}},
+			})
+			return
+		}
+
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.Register(ctx, &authv1.RegisterRequest{
+			Username:   body.Username,
+			Password:   body.Password,
+			Email:      body.Email,
+			InviteCode: body.InviteCode,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+
+		message := "注册成功"
+		if resp.PendingApproval {
+			message = "注册成功，请等待管理员审核"
+		}
+		c.JSON(201, gin.H{
+			"code":    201,
+			"message": message,
+			"data": gin.H{
+				"userId":          resp.UserId,
+				"username":        resp.Username,
+				"createdAt":       resp.CreatedAt.AsTime(),
+				"pendingApproval": resp.PendingApproval,
+			},
+		})
+	})
+
+	//Refresh
+	r.POST("/api/v1/auth/refresh", func(c *gin.Context) {
+		var req authv1.RefreshRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.Refresh(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{
+			"access_token":       resp.AccessToken,
+			"expires_in":         resp.ExpiresIn,
+			"refresh_token":      resp.RefreshToken,
+			"refresh_expires_in": resp.RefreshExpiresIn,
+		})
+	})
+
+	//Logout
+	r.POST("/api/v1/auth/logout", func(c *gin.Context) {
+		var req authv1.LogoutRequest
+		//请求体可以为空，此时只吊销 Authorization 中的 access token 所属会话
+		_ = c.ShouldBindJSON(&req)
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.Logout(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success"})
+	})
+
+	//修改密码，成功后所有会话失效，客户端需要重新登录
+	r.PUT("/api/v1/auth/password", func(c *gin.Context) {
+		var req authv1.ChangePasswordRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.ChangePassword(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "密码已修改，请重新登录"})
+	})
+
+	//申请找回密码，无论账号是否存在都返回相同的响应
+	r.POST("/api/v1/auth/password/reset", func(c *gin.Context) {
+		var req authv1.RequestPasswordResetRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.RequestPasswordReset(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "如果账号存在并绑定了邮箱，重置链接已发送"})
+	})
+
+	//用邮件中的令牌设置新密码
+	r.POST("/api/v1/auth/password/reset/confirm", func(c *gin.Context) {
+		var req authv1.ConfirmPasswordResetRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.ConfirmPasswordReset(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "密码已重置，请使用新密码登录"})
+	})
+
+	//管理员解除登录锁定
+	r.POST("/api/v1/auth/unlock", func(c *gin.Context) {
+		var req authv1.UnlockAccountRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.UnlockAccount(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success"})
+	})
+
+	//管理员审核账号
+	r.POST("/api/v1/auth/users/:id/approve", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.ApproveUser(ctx, &authv1.ApproveUserRequest{UserId: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"userId": resp.UserId}})
+	})
+
+	//管理员创建邀请码
+	r.POST("/api/v1/auth/invite-codes", func(c *gin.Context) {
+		var req authv1.CreateInviteCodeRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.CreateInviteCode(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(201, gin.H{"code": 201, "message": "success", "data": resp})
+	})
+	//平台管理员管理租户
+	r.POST("/api/v1/auth/organizations", func(c *gin.Context) {
+		var req authv1.CreateOrganizationRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.CreateOrganization(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(201, gin.H{"code": 201, "message": "success", "data": resp.Organization})
+	})
+
+	r.GET("/api/v1/auth/organizations", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.ListOrganizations(ctx, &authv1.ListOrganizationsRequest{})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"items": resp.Organizations, "total": len(resp.Organizations)}})
+	})
+
+	//把用户分配到租户，tenant_id 为空表示移出租户
+	r.PUT("/api/v1/auth/users/:id/organization", func(c *gin.Context) {
+		var body struct {
+			TenantID string `json:"tenant_id"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.SetUserOrganization(ctx, &authv1.SetUserOrganizationRequest{
+			UserId:   c.Param("id"),
+			TenantId: body.TenantID,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"userId": resp.UserId, "tenantId": resp.TenantId}})
+	})
+	//API key 管理
+	r.POST("/api/v1/auth/api-keys", func(c *gin.Context) {
+		var req authv1.CreateApiKeyRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.CreateApiKey(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(201, gin.H{
+			"code":    201,
+			"message": "请妥善保存 key，之后无法再次查看",
+			"data":    gin.H{"key": resp.Key, "apiKey": resp.ApiKey},
+		})
+	})
+
+	r.GET("/api/v1/auth/api-keys", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.ListApiKeys(ctx, &authv1.ListApiKeysRequest{})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"items": resp.ApiKeys, "total": len(resp.ApiKeys)}})
+	})
+
+	r.DELETE("/api/v1/auth/api-keys/:id", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.RevokeApiKey(ctx, &authv1.RevokeApiKeyRequest{Id: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"id": resp.Id}})
+	})
+}
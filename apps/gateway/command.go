@@ -0,0 +1,570 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/status"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"github.com/xuewentao/cheya/pkg/token"
+	vinpkg "github.com/xuewentao/cheya/pkg/vin"
+)
+
+// Redis key 约定
+// command:<id>              单条指令 (hash)
+// command:bulk:<id>         批量指令汇总 (hash)
+// command:bulk:<id>:items   批量指令拆分出的单条指令 id (list)
+// command:bulk:schedule     定时批量指令 (zset, score = 执行时间戳)
+const (
+	commandChannel     = "vehicle:commands"
+	commandKeyPrefix   = "command:"
+	bulkKeyPrefix      = "command:bulk:"
+	bulkScheduleKey    = "command:bulk:schedule"
+	commandTTL         = 7 * 24 * time.Hour
+	schedulerInterval  = time.Second
+	selectorPageSize   = 500
+	maxBulkCommandSize = 10000
+)
+
+// 指令 / 批量指令状态
+const (
+	CommandStatusPending   = "pending"
+	CommandStatusSent      = "sent"
+	CommandStatusFailed    = "failed"
+	CommandStatusCancelled = "cancelled"
+
+	BulkStateScheduled = "scheduled"
+	BulkStateRunning   = "running"
+	BulkStateCompleted = "completed"
+	BulkStateCancelled = "cancelled"
+)
+
+// 支持的指令，值表示是否需要 value 参数
+// 车载终端的处理方式见 README 的「车辆指令」
+var commandActions = map[string]bool{
+	"STOP":            false,
+	"START":           false,
+	"LOCK":            false,
+	"UNLOCK":          false,
+	"SET_SPEED_LIMIT": true,
+}
+
+// maxSpeedLimit SET_SPEED_LIMIT 允许的最大值 (km/h)
+const maxSpeedLimit = 200
+
+// commandValue 校验指令参数并转换为下发给车辆的格式
+func commandValue(action, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if !commandActions[action] {
+		if value != "" {
+			return "", fmt.Errorf("%s does not take a value", action)
+		}
+		return "", nil
+	}
+	switch action {
+	case "SET_SPEED_LIMIT":
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 || limit > maxSpeedLimit {
+			return "", fmt.Errorf("SET_SPEED_LIMIT value must be an integer between 1 and %d (km/h)", maxSpeedLimit)
+		}
+		return strconv.Itoa(limit), nil
+	}
+	if value == "" {
+		return "", fmt.Errorf("%s requires value", action)
+	}
+	return value, nil
+}
+
+// CommandSelector 批量指令的目标选择器，三者只能选其一
+type CommandSelector struct {
+	Vins   []string `json:"vins"`
+	Status string   `json:"status"` // online / offline
+	Group  string   `json:"group"`  // 分组 ID，包括下级分组
+}
+
+// BulkCommandRequest POST /api/v1/commands/bulk 请求体
+type BulkCommandRequest struct {
+	Action     string          `json:"action"`
+	Value      string          `json:"value"` // SET_SPEED_LIMIT 的限速值 (km/h)
+	Selector   CommandSelector `json:"selector"`
+	ScheduleAt *time.Time      `json:"schedule_at"` // 为空则立即执行
+}
+
+// BulkProgress 批量指令的汇总进度
+type BulkProgress struct {
+	ID          string `json:"id"`
+	Action      string `json:"action"`
+	Value       string `json:"value,omitempty"`
+	State       string `json:"state"`
+	Total       int64  `json:"total"`
+	Pending     int64  `json:"pending"`
+	Sent        int64  `json:"sent"`
+	Failed      int64  `json:"failed"`
+	ScheduledAt int64  `json:"scheduled_at,omitempty"`
+	CreatedBy   string `json:"created_by,omitempty"`
+	TenantID    string `json:"tenant_id,omitempty"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+// CommandManager 负责把批量指令拆分为单条指令、定时下发并记录进度
+// 指令记录属于创建者的租户，其他租户的用户查询时返回 404
+type CommandManager struct {
+	rdb           *redis.Client
+	vehicleClient vehiclev1.VehicleServiceClient
+	tenants       *tenant.Index
+}
+
+func NewCommandManager(rdb *redis.Client, vehicleClient vehiclev1.VehicleServiceClient, tenants *tenant.Index) *CommandManager {
+	return &CommandManager{
+		rdb:           rdb,
+		vehicleClient: vehicleClient,
+		tenants:       tenants,
+	}
+}
+
+// RegisterRoutes 注册批量指令相关路由
+func (m *CommandManager) RegisterRoutes(r gin.IRoutes) {
+	r.POST("/api/v1/commands/bulk", m.handleCreateBulk)
+	r.GET("/api/v1/commands/bulk/:id", m.handleGetBulk)
+	r.GET("/api/v1/commands/bulk/:id/items", m.handleListBulkItems)
+	r.DELETE("/api/v1/commands/bulk/:id", m.handleCancelBulk)
+	r.GET("/api/v1/commands/:id", m.handleGetCommand)
+}
+
+func (m *CommandManager) handleCreateBulk(c *gin.Context) {
+	var body BulkCommandRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(400, gin.H{"error": "Invalid request body"})
+		return
+	}
+	body.Action = strings.ToUpper(body.Action)
+	if _, ok := commandActions[body.Action]; !ok {
+		c.JSON(400, gin.H{"error": "Invalid action: " + body.Action})
+		return
+	}
+	value, err := commandValue(body.Action, body.Value)
+	if err != nil {
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	body.Value = value
+
+	ctx, cancel := forwardAuth(c)
+	defer cancel()
+
+	//1.解析选择器，得到目标 VIN 列表
+	//分组不存在或属于其他租户时 vehicle service 返回 NotFound，原样转换为 404
+	vins, err := m.resolveSelector(ctx, body.Selector)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(400, gin.H{"error": err.Error()})
+		return
+	}
+	if len(vins) == 0 {
+		c.JSON(400, gin.H{"error": "selector matched no vehicles"})
+		return
+	}
+	if len(vins) > maxBulkCommandSize {
+		c.JSON(400, gin.H{"error": fmt.Sprintf("selector matched %d vehicles, limit is %d", len(vins), maxBulkCommandSize)})
+		return
+	}
+
+	//2.显式指定的 VIN 必须属于调用者的租户 (按状态或分组选择时 ListVehicles 已按租户过滤)
+	claims := currentClaims(c)
+	if len(body.Selector.Vins) > 0 {
+		denied, err := inaccessibleVins(ctx, m.tenants, claims, vins)
+		if err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		if len(denied) > 0 {
+			c.JSON(404, gin.H{"error": "vehicles not found", "vins": denied})
+			return
+		}
+	}
+
+	//3.拆分为单条指令并持久化
+	progress, err := m.createBulk(ctx, body, vins, claims)
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+
+	//4.立即执行的指令直接下发
+	if progress.State == BulkStateRunning {
+		go m.dispatchBulk(context.Background(), progress.ID)
+	}
+
+	log.Printf("📢 Bulk command %s created: %s x %d (%s)", progress.ID, progress.Action, progress.Total, progress.State)
+	c.JSON(202, gin.H{
+		"code":    202,
+		"message": "Bulk command accepted",
+		"data":    progress,
+	})
+}
+
+func (m *CommandManager) handleGetBulk(c *gin.Context) {
+	progress, ok := m.accessibleBulk(c)
+	if !ok {
+		return
+	}
+	c.JSON(200, gin.H{
+		"code":    200,
+		"message": "success",
+		"data":    progress,
+	})
+}
+
+func (m *CommandManager) handleListBulkItems(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if _, ok := m.accessibleBulk(c); !ok {
+		return
+	}
+	ids, err := m.rdb.LRange(ctx, bulkKeyPrefix+id+":items", 0, -1).Result()
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	if len(ids) == 0 {
+		c.JSON(404, gin.H{"error": "bulk command not found"})
+		return
+	}
+
+	pipe := m.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(ids))
+	for i, cmdID := range ids {
+		cmds[i] = pipe.HGetAll(ctx, commandKeyPrefix+cmdID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	items := make([]map[string]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		items = append(items, cmd.Val())
+	}
+	c.JSON(200, gin.H{
+		"code": 200,
+		"data": gin.H{
+			"items": items,
+			"total": len(items),
+		},
+	})
+}
+
+func (m *CommandManager) handleCancelBulk(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+	if _, ok := m.accessibleBulk(c); !ok {
+		return
+	}
+	//只有还未开始执行的定时指令才能取消，ZRem 保证与调度器之间只有一方能拿到
+	removed, err := m.rdb.ZRem(ctx, bulkScheduleKey, id).Result()
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	if removed == 0 {
+		c.JSON(409, gin.H{"error": "bulk command is not scheduled or already started"})
+		return
+	}
+
+	ids, err := m.rdb.LRange(ctx, bulkKeyPrefix+id+":items", 0, -1).Result()
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	pipe := m.rdb.TxPipeline()
+	for _, cmdID := range ids {
+		pipe.HSet(ctx, commandKeyPrefix+cmdID, "status", CommandStatusCancelled, "updated_at", time.Now().Unix())
+	}
+	pipe.HSet(ctx, bulkKeyPrefix+id, "state", BulkStateCancelled, "pending", 0)
+	if _, err := pipe.Exec(ctx); err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	log.Printf("🚫 Bulk command %s cancelled", id)
+	c.JSON(200, gin.H{"code": 200, "message": "Bulk command cancelled"})
+}
+
+func (m *CommandManager) handleGetCommand(c *gin.Context) {
+	fields, err := m.rdb.HGetAll(c.Request.Context(), commandKeyPrefix+c.Param("id")).Result()
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return
+	}
+	if len(fields) == 0 || !canAccessTenant(currentClaims(c), fields["tenant_id"]) {
+		c.JSON(404, gin.H{"error": "command not found"})
+		return
+	}
+	c.JSON(200, gin.H{
+		"code":    200,
+		"message": "success",
+		"data":    fields,
+	})
+}
+
+// accessibleBulk 读取 :id 对应的批量指令，不存在或属于其他租户时直接写 404
+func (m *CommandManager) accessibleBulk(c *gin.Context) (*BulkProgress, bool) {
+	progress, err := m.getBulk(c.Request.Context(), c.Param("id"))
+	if err == redis.Nil || (err == nil && !canAccessTenant(currentClaims(c), progress.TenantID)) {
+		c.JSON(404, gin.H{"error": "bulk command not found"})
+		return nil, false
+	}
+	if err != nil {
+		c.JSON(500, gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return progress, true
+}
+
+// resolveSelector 把选择器解析为 VIN 列表
+func (m *CommandManager) resolveSelector(ctx context.Context, sel CommandSelector) ([]string, error) {
+	set := 0
+	if len(sel.Vins) > 0 {
+		set++
+	}
+	if sel.Status != "" {
+		set++
+	}
+	if sel.Group != "" {
+		set++
+	}
+	if set != 1 {
+		return nil, fmt.Errorf("selector must specify exactly one of vins, status or group")
+	}
+
+	switch {
+	case len(sel.Vins) > 0:
+		//规范化并去重，保持原有顺序
+		seen := make(map[string]bool, len(sel.Vins))
+		vins := make([]string, 0, len(sel.Vins))
+		for _, vin := range sel.Vins {
+			vin = vinpkg.Normalize(vin)
+			if vin == "" || seen[vin] {
+				continue
+			}
+			seen[vin] = true
+			vins = append(vins, vin)
+		}
+		return vins, nil
+	case sel.Status != "":
+		want, ok := map[string]vehiclev1.VehicleStatus{
+			"online":  vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE,
+			"offline": vehiclev1.VehicleStatus_VEHICLE_STATUS_OFFLINE,
+		}[strings.ToLower(sel.Status)]
+		if !ok {
+			return nil, fmt.Errorf("invalid status: %s", sel.Status)
+		}
+		return m.listVins(ctx, &vehiclev1.ListVehiclesRequest{Status: want})
+	default:
+		//包括下级分组的车辆
+		return m.listVins(ctx, &vehiclev1.ListVehiclesRequest{GroupId: sel.Group, IncludeSubgroups: true})
+	}
+}
+
+// listVins 按游标分页遍历满足过滤条件的车辆，返回 VIN 列表
+func (m *CommandManager) listVins(ctx context.Context, filter *vehiclev1.ListVehiclesRequest) ([]string, error) {
+	var vins []string
+	filter.PageSize = selectorPageSize
+	for {
+		resp, err := m.vehicleClient.ListVehicles(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range resp.Vehicles {
+			vins = append(vins, v.Vin)
+		}
+		if resp.NextPageToken == "" {
+			return vins, nil
+		}
+		filter.PageToken = resp.NextPageToken
+	}
+}
+
+// createBulk 写入批量指令及拆分后的单条指令
+func (m *CommandManager) createBulk(ctx context.Context, body BulkCommandRequest, vins []string, claims *token.Claims) (*BulkProgress, error) {
+	now := time.Now()
+	progress := &BulkProgress{
+		ID:        uuid.NewString(),
+		Action:    body.Action,
+		Value:     body.Value,
+		State:     BulkStateRunning,
+		Total:     int64(len(vins)),
+		Pending:   int64(len(vins)),
+		CreatedAt: now.Unix(),
+	}
+	if claims != nil {
+		progress.CreatedBy = claims.Username
+		progress.TenantID = claims.TenantID
+	}
+	if body.ScheduleAt != nil && body.ScheduleAt.After(now) {
+		progress.State = BulkStateScheduled
+		progress.ScheduledAt = body.ScheduleAt.Unix()
+	}
+
+	bulkKey := bulkKeyPrefix + progress.ID
+	itemsKey := bulkKey + ":items"
+	pipe := m.rdb.TxPipeline()
+	ids := make([]interface{}, len(vins))
+	for i, vin := range vins {
+		cmdID := uuid.NewString()
+		ids[i] = cmdID
+		pipe.HSet(ctx, commandKeyPrefix+cmdID,
+			"id", cmdID,
+			"bulk_id", progress.ID,
+			"vin", vin,
+			"action", body.Action,
+			"value", body.Value,
+			"status", CommandStatusPending,
+			"tenant_id", progress.TenantID,
+			"created_at", now.Unix(),
+			"updated_at", now.Unix(),
+		)
+		pipe.Expire(ctx, commandKeyPrefix+cmdID, commandTTL)
+	}
+	pipe.RPush(ctx, itemsKey, ids...)
+	pipe.Expire(ctx, itemsKey, commandTTL)
+	pipe.HSet(ctx, bulkKey,
+		"id", progress.ID,
+		"action", progress.Action,
+		"value", progress.Value,
+		"state", progress.State,
+		"total", progress.Total,
+		"pending", progress.Pending,
+		"sent", 0,
+		"failed", 0,
+		"scheduled_at", progress.ScheduledAt,
+		"created_by", progress.CreatedBy,
+		"tenant_id", progress.TenantID,
+		"created_at", progress.CreatedAt,
+	)
+	pipe.Expire(ctx, bulkKey, commandTTL)
+	if progress.State == BulkStateScheduled {
+		pipe.ZAdd(ctx, bulkScheduleKey, redis.Z{Score: float64(progress.ScheduledAt), Member: progress.ID})
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("save bulk command: %w", err)
+	}
+	return progress, nil
+}
+
+func (m *CommandManager) getBulk(ctx context.Context, id string) (*BulkProgress, error) {
+	fields, err := m.rdb.HGetAll(ctx, bulkKeyPrefix+id).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, redis.Nil
+	}
+	atoi := func(k string) int64 {
+		n, _ := strconv.ParseInt(fields[k], 10, 64)
+		return n
+	}
+	return &BulkProgress{
+		ID:          fields["id"],
+		Action:      fields["action"],
+		Value:       fields["value"],
+		State:       fields["state"],
+		Total:       atoi("total"),
+		Pending:     atoi("pending"),
+		Sent:        atoi("sent"),
+		Failed:      atoi("failed"),
+		ScheduledAt: atoi("scheduled_at"),
+		CreatedBy:   fields["created_by"],
+		TenantID:    fields["tenant_id"],
+		CreatedAt:   atoi("created_at"),
+	}, nil
+}
+
+// dispatchBulk 逐条下发批量指令，并更新每条指令与汇总的状态
+func (m *CommandManager) dispatchBulk(ctx context.Context, id string) {
+	bulkKey := bulkKeyPrefix + id
+	m.rdb.HSet(ctx, bulkKey, "state", BulkStateRunning)
+
+	ids, err := m.rdb.LRange(ctx, bulkKey+":items", 0, -1).Result()
+	if err != nil {
+		log.Printf("❌ Bulk command %s: load items failed: %v", id, err)
+		return
+	}
+	for _, cmdID := range ids {
+		cmdKey := commandKeyPrefix + cmdID
+		fields, err := m.rdb.HMGet(ctx, cmdKey, "vin", "action", "value", "status").Result()
+		if err != nil || fields[0] == nil {
+			log.Printf("⚠️ Bulk command %s: command %s missing", id, cmdID)
+			continue
+		}
+		if fields[3] != CommandStatusPending {
+			continue
+		}
+		vin, action, value := fields[0].(string), fields[1].(string), fields[2].(string)
+
+		status, errMsg := CommandStatusSent, ""
+		if err := m.rdb.Publish(ctx, commandChannel, formatCommand(action, vin, value)).Err(); err != nil {
+			status, errMsg = CommandStatusFailed, err.Error()
+		}
+
+		pipe := m.rdb.TxPipeline()
+		pipe.HSet(ctx, cmdKey, "status", status, "error", errMsg, "updated_at", time.Now().Unix())
+		pipe.HIncrBy(ctx, bulkKey, "pending", -1)
+		pipe.HIncrBy(ctx, bulkKey, status, 1)
+		if _, err := pipe.Exec(ctx); err != nil {
+			log.Printf("⚠️ Bulk command %s: update progress failed: %v", id, err)
+		}
+	}
+	m.rdb.HSet(ctx, bulkKey, "state", BulkStateCompleted)
+	log.Printf("✅ Bulk command %s dispatched (%d commands)", id, len(ids))
+}
+
+// RunScheduler 定时扫描到期的批量指令
+// 多个网关实例同时运行时，ZRem 成功的实例才会执行，避免重复下发
+func (m *CommandManager) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		due, err := m.rdb.ZRangeByScore(ctx, bulkScheduleKey, &redis.ZRangeBy{
+			Min: "-inf",
+			Max: strconv.FormatInt(time.Now().Unix(), 10),
+		}).Result()
+		if err != nil {
+			log.Printf("⚠️ Command scheduler error: %v", err)
+			continue
+		}
+		for _, id := range due {
+			claimed, err := m.rdb.ZRem(ctx, bulkScheduleKey, id).Result()
+			if err != nil || claimed == 0 {
+				continue
+			}
+			log.Printf("⏰ Scheduled bulk command %s is due", id)
+			go m.dispatchBulk(ctx, id)
+		}
+	}
+}
+
+// formatCommand 生成下发到 vehicle:commands 的指令
+// 格式与单车控制接口保持一致: ACTION:VIN，带参数时为 ACTION:VIN:VALUE
+func formatCommand(action, vin, value string) string {
+	if value == "" {
+		return action + ":" + vin
+	}
+	return action + ":" + vin + ":" + value
+}
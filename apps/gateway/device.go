@@ -0,0 +1,56 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+// registerDeviceRoutes 注册车载终端管理路由
+// gin 同一方法下同一位置的通配符必须同名: POST 沿用 /vehicles/:vin/control 的 :vin，GET 沿用 /vehicles/:id
+func registerDeviceRoutes(r gin.IRoutes, vehicleClient vehiclev1.VehicleServiceClient) {
+	//开通车载终端，响应中的 credential 只返回这一次
+	r.POST("/api/v1/vehicles/:vin/devices", func(c *gin.Context) {
+		var body struct {
+			Name string `json:"name"`
+		}
+		_ = c.ShouldBindJSON(&body)
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.ProvisionDevice(ctx, &vehiclev1.ProvisionDeviceRequest{
+			Vin:  c.Param("vin"),
+			Name: body.Name,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(201, gin.H{
+			"code":    201,
+			"message": "请妥善保存设备凭证，之后无法再次查看",
+			"data":    gin.H{"credential": resp.Credential, "device": resp.Device},
+		})
+	})
+
+	r.GET("/api/v1/vehicles/:id/devices", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.ListDevices(ctx, &vehiclev1.ListDevicesRequest{Vin: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"items": resp.Devices, "total": len(resp.Devices)}})
+	})
+
+	r.DELETE("/api/v1/devices/:id", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.RevokeDevice(ctx, &vehiclev1.RevokeDeviceRequest{Id: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"id": resp.Id}})
+	})
+}
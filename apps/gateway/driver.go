@@ -0,0 +1,256 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+// parseTimestamp RFC 3339 时间，空字符串返回 nil
+func parseTimestamp(name, v string) (*timestamppb.Timestamp, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 time", name)
+	}
+	return timestamppb.New(t), nil
+}
+
+// writeVehicleData 返回车辆服务的单个消息
+func writeVehicleData(c *gin.Context, m proto.Message) {
+	data, err := vehicleJSON(m)
+	if err != nil {
+		c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+		return
+	}
+	c.JSON(200, gin.H{"code": 200, "message": "success", "data": data})
+}
+
+// registerDriverRoutes 注册司机管理和驾驶记录路由
+// 车辆下的路由沿用 registerVehicleRoutes 的通配符: POST 用 :vin，其它方法用 :id
+func registerDriverRoutes(r gin.IRoutes, vehicleClient vehiclev1.VehicleServiceClient) {
+	//司机列表: ?q=<姓名或驾驶证号>&pageSize=50&pageToken=
+	r.GET("/api/v1/drivers", func(c *gin.Context) {
+		pageSize, _ := strconv.Atoi(c.Query("pageSize"))
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.ListDrivers(ctx, &vehiclev1.ListDriversRequest{
+			Query:     c.Query("q"),
+			PageSize:  int32(pageSize),
+			PageToken: c.Query("pageToken"),
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		items, err := vehiclesJSON(resp.Drivers)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"items": items, "nextPageToken": resp.NextPageToken}})
+	})
+
+	//登记司机: {"name": "张三", "license_number": "310101199001011234", "license_expiry": "2030-01-01T00:00:00+08:00", "phone": "13800000000"}
+	r.POST("/api/v1/drivers", func(c *gin.Context) {
+		var body struct {
+			Name          string `json:"name" binding:"required"`
+			LicenseNumber string `json:"license_number" binding:"required"`
+			LicenseExpiry string `json:"license_expiry"`
+			Phone         string `json:"phone"`
+			TenantID      string `json:"tenant_id"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "name and license_number are required"})
+			return
+		}
+		expiry, err := parseTimestamp("license_expiry", body.LicenseExpiry)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.CreateDriver(ctx, &vehiclev1.CreateDriverRequest{
+			Name:          body.Name,
+			LicenseNumber: body.LicenseNumber,
+			LicenseExpiry: expiry,
+			Phone:         body.Phone,
+			TenantId:      body.TenantID,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicleData(c, resp.Driver)
+	})
+
+	r.GET("/api/v1/drivers/:id", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.GetDriver(ctx, &vehiclev1.GetDriverRequest{Id: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicleData(c, resp.Driver)
+	})
+
+	//修改司机，只更新请求体中出现的字段；"license_expiry": "" 表示清除有效期
+	r.PATCH("/api/v1/drivers/:id", func(c *gin.Context) {
+		var body map[string]json.RawMessage
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "invalid request body"})
+			return
+		}
+		req := &vehiclev1.UpdateDriverRequest{Id: c.Param("id"), UpdateMask: &fieldmaskpb.FieldMask{}}
+		for field, raw := range body {
+			var v string
+			if err := json.Unmarshal(raw, &v); err != nil {
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("invalid %s", field)})
+				return
+			}
+			switch field {
+			case "name":
+				req.Name = v
+			case "license_number":
+				req.LicenseNumber = v
+			case "phone":
+				req.Phone = v
+			case "license_expiry":
+				ts, err := parseTimestamp(field, v)
+				if err != nil {
+					c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+					return
+				}
+				req.LicenseExpiry = ts
+			default:
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("field %q cannot be updated", field)})
+				return
+			}
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, field)
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.UpdateDriver(ctx, req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicleData(c, resp.Driver)
+	})
+
+	//司机在时间段内驾驶过的车辆: ?from=<RFC 3339>&to=<RFC 3339>，不传表示不限
+	r.GET("/api/v1/drivers/:id/assignments", func(c *gin.Context) {
+		listAssignments(c, vehicleClient, &vehiclev1.ListDriverAssignmentsRequest{DriverId: c.Param("id")})
+	})
+
+	//车辆在时间段内的司机
+	r.GET("/api/v1/vehicles/:id/assignments", func(c *gin.Context) {
+		listAssignments(c, vehicleClient, &vehiclev1.ListDriverAssignmentsRequest{Vin: c.Param("id")})
+	})
+
+	//车辆当前的司机，没有司机时 data.driver 为 null
+	r.GET("/api/v1/vehicles/:id/driver", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.GetCurrentDriver(ctx, &vehiclev1.GetCurrentDriverRequest{Vin: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		driver, err := vehicleJSON(resp.Driver)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		assignment, err := vehicleJSON(resp.Assignment)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"driver": driver, "assignment": assignment}})
+	})
+
+	//分配司机: {"driver_id": "1", "start_time": "<RFC 3339，默认当前时间>"}
+	r.POST("/api/v1/vehicles/:vin/driver", func(c *gin.Context) {
+		var body struct {
+			DriverID  string `json:"driver_id" binding:"required"`
+			StartTime string `json:"start_time"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "driver_id is required"})
+			return
+		}
+		start, err := parseTimestamp("start_time", body.StartTime)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.AssignDriver(ctx, &vehiclev1.AssignDriverRequest{
+			Vin:       c.Param("vin"),
+			DriverId:  body.DriverID,
+			StartTime: start,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicleData(c, resp.Assignment)
+	})
+
+	//结束当前驾驶记录: ?endTime=<RFC 3339，默认当前时间>
+	r.DELETE("/api/v1/vehicles/:id/driver", func(c *gin.Context) {
+		end, err := parseTimestamp("endTime", c.Query("endTime"))
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.UnassignDriver(ctx, &vehiclev1.UnassignDriverRequest{Vin: c.Param("id"), EndTime: end})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicleData(c, resp.Assignment)
+	})
+}
+
+// listAssignments 按 from / to 查询驾驶记录
+func listAssignments(c *gin.Context, vehicleClient vehiclev1.VehicleServiceClient, req *vehiclev1.ListDriverAssignmentsRequest) {
+	var err error
+	if req.StartTime, err = parseTimestamp("from", c.Query("from")); err != nil {
+		c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+		return
+	}
+	if req.EndTime, err = parseTimestamp("to", c.Query("to")); err != nil {
+		c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+		return
+	}
+	ctx, cancel := forwardAuth(c)
+	defer cancel()
+	resp, err := vehicleClient.ListDriverAssignments(ctx, req)
+	if err != nil {
+		writeGRPCError(c, err)
+		return
+	}
+	items, err := vehiclesJSON(resp.Assignments)
+	if err != nil {
+		c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+		return
+	}
+	c.JSON(200, gin.H{"code": 200, "data": items})
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatusFromCode 把 gRPC 错误码映射为 HTTP 状态码
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		//FailedPrecondition 表示资源当前的状态不允许该操作，If-Match 不一致的 412 见 writeGRPCError
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeGRPCError 把 gRPC 错误转换为统一的 JSON 错误响应
+// InvalidArgument 附带的字段级错误放在 errors 中，前端可以逐字段展示；
+// 附带 RetryInfo 时 (例如登录被限流) 写入 Retry-After 头和 retryAfter 字段；
+// 附带 PreconditionFailure 时 (If-Match 的版本号不一致) 返回 412
+func writeGRPCError(c *gin.Context, err error) {
+	st := status.Convert(err)
+	code := httpStatusFromCode(st.Code())
+
+	body := gin.H{
+		"code":    code,
+		"message": st.Message(),
+		"error":   st.Message(),
+	}
+	var fieldErrors []gin.H
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				fieldErrors = append(fieldErrors, gin.H{
+					"field":   v.Field,
+					"message": v.Description,
+				})
+			}
+		case *errdetails.RetryInfo:
+			seconds := int64(d.RetryDelay.AsDuration().Seconds())
+			c.Header("Retry-After", strconv.FormatInt(seconds, 10))
+			body["retryAfter"] = seconds
+		case *errdetails.PreconditionFailure:
+			code = http.StatusPreconditionFailed
+			body["code"] = code
+		}
+	}
+	if len(fieldErrors) > 0 {
+		body["errors"] = fieldErrors
+	}
+	c.JSON(code, body)
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+// groupVehiclesBody 分组成员的请求体: {"vins": ["LSV...", ...]}
+type groupVehiclesBody struct {
+	Vins []string `json:"vins" binding:"required"`
+}
+
+// registerGroupRoutes 注册车辆分组的增删改查和成员管理路由
+// 分组下的车辆通过 GET /api/v1/vehicles?group=<id>&includeSubgroups=true 查询
+func registerGroupRoutes(r gin.IRoutes, vehicleClient vehiclev1.VehicleServiceClient) {
+	writeGroup := func(c *gin.Context, g *vehiclev1.Group) {
+		data, err := vehicleJSON(g)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": data})
+	}
+
+	//分组列表: ?parent=<id> 只列出直属下级分组，否则列出全部分组，前端按 parent_id 组装成树
+	r.GET("/api/v1/groups", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.ListGroups(ctx, &vehiclev1.ListGroupsRequest{ParentId: c.Query("parent")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		items, err := vehiclesJSON(resp.Groups)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": items})
+	})
+
+	//新建分组: {"name": "华东区", "description": "", "parent_id": "1"}
+	r.POST("/api/v1/groups", func(c *gin.Context) {
+		var body struct {
+			Name        string `json:"name" binding:"required"`
+			Description string `json:"description"`
+			ParentID    string `json:"parent_id"`
+			TenantID    string `json:"tenant_id"`
+		}
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "name is required"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.CreateGroup(ctx, &vehiclev1.CreateGroupRequest{
+			Name:        body.Name,
+			Description: body.Description,
+			ParentId:    body.ParentID,
+			TenantId:    body.TenantID,
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeGroup(c, resp.Group)
+	})
+
+	r.GET("/api/v1/groups/:id", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.GetGroup(ctx, &vehiclev1.GetGroupRequest{Id: c.Param("id")})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeGroup(c, resp.Group)
+	})
+
+	//修改分组，只更新请求体中出现的字段；"parent_id": "" 表示移动为顶级分组
+	r.PATCH("/api/v1/groups/:id", func(c *gin.Context) {
+		var body map[string]json.RawMessage
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "invalid request body"})
+			return
+		}
+		req := &vehiclev1.UpdateGroupRequest{Id: c.Param("id"), UpdateMask: &fieldmaskpb.FieldMask{}}
+		for field, raw := range body {
+			var dst *string
+			switch field {
+			case "name":
+				dst = &req.Name
+			case "description":
+				dst = &req.Description
+			case "parent_id":
+				dst = &req.ParentId
+			default:
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("field %q cannot be updated", field)})
+				return
+			}
+			if err := json.Unmarshal(raw, dst); err != nil {
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("invalid %s", field)})
+				return
+			}
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, field)
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.UpdateGroup(ctx, req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeGroup(c, resp.Group)
+	})
+
+	//删除分组，有下级分组时返回 409
+	r.DELETE("/api/v1/groups/:id", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := vehicleClient.DeleteGroup(ctx, &vehiclev1.DeleteGroupRequest{Id: c.Param("id")}); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success"})
+	})
+
+	//把车辆加入分组，已经在分组中的车辆忽略
+	r.POST("/api/v1/groups/:id/vehicles", func(c *gin.Context) {
+		var body groupVehiclesBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "vins is required"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.AddGroupVehicles(ctx, &vehiclev1.AddGroupVehiclesRequest{GroupId: c.Param("id"), Vins: body.Vins})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"added": resp.Added}})
+	})
+
+	//把车辆移出分组
+	r.DELETE("/api/v1/groups/:id/vehicles", func(c *gin.Context) {
+		var body groupVehiclesBody
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "vins is required"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.RemoveGroupVehicles(ctx, &vehiclev1.RemoveGroupVehiclesRequest{GroupId: c.Param("id"), Vins: body.Vins})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success", "data": gin.H{"removed": resp.Removed}})
+	})
+}
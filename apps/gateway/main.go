@@ -2,9 +2,9 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"net/http"
-	"strconv"
 	"sync"
 	"time"
 
@@ -16,13 +16,17 @@ import (
 
 	authv1 "github.com/xuewentao/cheya/api/auth/v1"
 	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"github.com/xuewentao/cheya/pkg/token"
+	vinpkg "github.com/xuewentao/cheya/pkg/vin"
 )
 
 // 简易连接池
 var (
-	clients   = make(map[*websocket.Conn]bool) // WebSocket 客户端连接池
-	broadcast = make(chan string)              // 广播消息通道
-	mutex     sync.Mutex                       // 保护 clients map 的互斥锁
+	clients   = make(map[*websocket.Conn]*token.Claims) // WebSocket 客户端连接池，value 为连接者身份
+	broadcast = make(chan string)                       // 广播消息通道
+	mutex     sync.Mutex                                // 保护 clients map 的互斥锁
 )
 
 // WebSocket upgrader 配置
@@ -46,10 +50,20 @@ func main() {
 	vehicleClient := vehiclev1.NewVehicleServiceClient(conn)
 	log.Println("✅ Connected to Vehicle Service(gRPC)")
 
+	//连接 auth service
+	authConn, _ := grpc.NewClient("localhost:50054", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	authClient := authv1.NewAuthServiceClient(authConn)
+
+	//验签公钥从 auth service 拉取并缓存，auth 轮换密钥后自动更新
+	jwks := token.NewJWKSCache(grpcauth.JWKSFetcher(authClient), 5*time.Minute)
+
 	// 创建 Redis 客户端
 	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
 	defer rdb.Close()
 
+	//车辆所属租户索引，遥测推送和车辆指令按租户过滤
+	tenants := tenant.NewIndex(rdb, 30*time.Second)
+
 	//1.Redis 订阅
 	go func() {
 		log.Println("👂 Gateway subscribing to Redis channel: vehicle:update")
@@ -63,13 +77,29 @@ func main() {
 		}
 	}()
 	//2.WebSocket 广播协程
+	//每条遥测只推送给车辆所属租户的连接和平台管理员
 	go func() {
 		for {
 			msg := <-broadcast
-			log.Printf("📡 Broadcasting to %d clients", len(clients))
+			var data struct {
+				VehicleID string `json:"vehicle_id"`
+			}
+			if err := json.Unmarshal([]byte(msg), &data); err != nil {
+				log.Printf("⚠️ Invalid telemetry payload: %v", err)
+				continue
+			}
+			tenantID, err := tenants.Lookup(context.Background(), data.VehicleID)
+			if err != nil {
+				log.Printf("❌ Tenant lookup failed for %s: %v", data.VehicleID, err)
+				continue
+			}
 
 			mutex.Lock()
-			for client := range clients {
+			log.Printf("📡 Broadcasting %s to tenant %q (%d clients connected)", data.VehicleID, tenantID, len(clients))
+			for client, claims := range clients {
+				if !canAccessTenant(claims, tenantID) {
+					continue
+				}
 				err := client.WriteMessage(websocket.TextMessage, []byte(msg))
 				if err != nil {
 					log.Printf("❌ WS Error: %v", err)
@@ -82,13 +112,19 @@ func main() {
 	}()
 	//3.初始化 Gin
 	r := gin.Default()
+	//只采信可信代理设置的 X-Forwarded-For，否则客户端可以伪造来源 IP 绕过登录限流
+	if err := r.SetTrustedProxies(trustedProxiesFromEnv()); err != nil {
+		log.Fatalf("❌ invalid TRUSTED_PROXIES: %v", err)
+	}
 
 	// CORS 中间件 - 允许前端跨域访问
 	r.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, X-API-Key, accept, origin, Cache-Control, X-Requested-With, If-Match, If-None-Match")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
+		//车辆的 ETag 用于条件更新
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -98,13 +134,17 @@ func main() {
 		c.Next()
 	})
 
+	//需要登录的路由
+	verifier := token.NewVerifier(jwks, rdb)
+	protected := r.Group("", authRequired(verifier, authClient), scopeRequired())
+
 	//定义路由 GET /api/vi/vehicles/:id
-	r.GET("/api/v1/vehicles/:id", func(c *gin.Context) {
+	protected.GET("/api/v1/vehicles/:id", func(c *gin.Context) {
 		//获取 URL 参数
 		vehicleID := c.Param("id")
 
-		//设置超时上下文
-		ctx, concel := context.WithTimeout(context.Background(), 2*time.Second)
+		//设置超时上下文，并把调用者的 token 透传给 vehicle service
+		ctx, concel := forwardAuth(c)
 		defer concel()
 
 		//发起 gRPC 调用
@@ -114,67 +154,71 @@ func main() {
 		//错误处理
 		if err != nil {
 			log.Printf("❌ gRPC called failed: %v", err)
-			//返回 500
-			c.JSON(500, gin.H{
-				"error": err.Error(),
-			})
+			writeGRPCError(c, err)
+			return
+		}
+		//版本号没有变化时返回 304，仪表盘轮询时不用重新传输
+		etag := vehicleETag(resp.Vehicle)
+		if etag != "" && etagMatches(c.GetHeader("If-None-Match"), etag) {
+			c.Header("ETag", etag)
+			c.Status(http.StatusNotModified)
+			return
+		}
+		data, err := vehicleJSON(resp.Vehicle)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
 			return
 		}
 		//成功响应
+		if etag != "" {
+			c.Header("ETag", etag)
+		}
 		c.JSON(http.StatusOK, gin.H{
 			"code":    200,
 			"message": "success",
-			"data":    resp.Vehicle,
+			"data":    data,
 		})
 	})
 
 	//GET /api/v1/vehicles
-	r.GET("/api/v1/vehicles", func(c *gin.Context) {
-		// 从查询参数获取分页信息，设置默认值
-		page := int32(1)
-		pageSize := int32(100)
-
-		// 解析 page 参数
-		if pageParam := c.Query("page"); pageParam != "" {
-			if p, err := strconv.ParseInt(pageParam, 10, 32); err == nil && p > 0 {
-				page = int32(p)
-			}
-		}
-
-		// 解析 pageSize 参数
-		if pageSizeParam := c.Query("pageSize"); pageSizeParam != "" {
-			if ps, err := strconv.ParseInt(pageSizeParam, 10, 32); err == nil && ps > 0 {
-				pageSize = int32(ps)
-			}
-		}
-
-		//构造 grpc 请求
-		req := &vehiclev1.ListVehiclesRequest{
-			Page:     page,
-			PageSize: pageSize,
+	protected.GET("/api/v1/vehicles", func(c *gin.Context) {
+		//查询参数转换为 grpc 请求
+		req, err := listVehiclesRequest(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		ctx, cancel := forwardAuth(c)
 		defer cancel()
 
 		//2.调用 grpc
 		resp, err := vehicleClient.ListVehicles(ctx, req)
 		if err != nil {
-			c.JSON(500, gin.H{"error": err.Error()})
+			writeGRPCError(c, err)
+			return
+		}
+		items, err := vehiclesJSON(resp.Vehicles)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
 			return
 		}
-		//返回 json
+		//返回 json，只有计算了总数时才返回 total
+		data := gin.H{
+			"items":         items,
+			"nextPageToken": resp.NextPageToken,
+		}
+		if req.IncludeTotal {
+			data["total"] = resp.TotalCount
+		}
 		c.JSON(200, gin.H{
 			"code": 200,
-			"data": gin.H{
-				"items": resp.Vehicles,
-				"total": resp.TotalCount,
-			},
+			"data": data,
 		})
 	})
 
 	// 车辆控制接口
-	r.POST("/api/v1/vehicles/:vin/control", func(c *gin.Context) {
-		vin := c.Param("vin")
+	protected.POST("/api/v1/vehicles/:vin/control", func(c *gin.Context) {
+		vin := vinpkg.Normalize(c.Param("vin"))
 
 		var body struct {
 			Action string `json:"action"`
@@ -191,9 +235,20 @@ func main() {
 			return
 		}
 
+		// 只能控制本租户的车辆
+		denied, err := inaccessibleVins(c.Request.Context(), tenants, currentClaims(c), []string{vin})
+		if err != nil {
+			c.JSON(500, gin.H{"error": err.Error()})
+			return
+		}
+		if len(denied) > 0 {
+			c.JSON(404, gin.H{"error": "vehicle not found"})
+			return
+		}
+
 		// 构造命令并发布到 Redis
 		cmd := body.Action + ":" + vin
-		err := rdb.Publish(context.Background(), "vehicle:commands", cmd).Err()
+		err = rdb.Publish(context.Background(), "vehicle:commands", cmd).Err()
 		if err != nil {
 			c.JSON(500, gin.H{"error": err.Error()})
 			return
@@ -210,9 +265,18 @@ func main() {
 		})
 	})
 
-	//连接 auth service
-	authConn, _ := grpc.NewClient("localhost:50054", grpc.WithTransportCredentials(insecure.NewCredentials()))
-	authClient := authv1.NewAuthServiceClient(authConn)
+	//车载终端
+	registerDeviceRoutes(protected, vehicleClient)
+	registerVehicleRoutes(protected, vehicleClient)
+	registerVehicleCSVRoutes(protected, vehicleClient)
+	registerGroupRoutes(protected, vehicleClient)
+	registerDriverRoutes(protected, vehicleClient)
+
+	//批量 / 定时指令
+	commandManager := NewCommandManager(rdb, vehicleClient, tenants)
+	go commandManager.RunScheduler(context.Background())
+	commandManager.RegisterRoutes(protected)
+
 	//Login
 	r.POST("/api/v1/auth/login", func(c *gin.Context) {
 		var req authv1.LoginRequest
@@ -221,31 +285,60 @@ func main() {
 			return
 		}
 
-		resp, err := authClient.Login(context.Background(), &req)
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.Login(ctx, &req)
 		if err != nil {
-			c.JSON(401, gin.H{"error": err.Error()})
+			writeGRPCError(c, err)
 			return
 		}
 
-		c.JSON(200, gin.H{
-			"access_token": resp.AccessToken,
-			"expires_in":   resp.ExpiresIn,
-			"username":     resp.Userme,
-		})
+		c.JSON(200, loginJSON(resp))
+	})
+	registerAuthRoutes(r, authClient)
+	registerMFARoutes(r, authClient)
+	registerOIDCRoutes(r, authClient)
+
+	//对外发布验签公钥，其他服务和合作方可以自行校验 token
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), 2*time.Second)
+		defer cancel()
+		set, err := jwks.JWKS(ctx)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(200, set)
 	})
 
 	//WebSocket 结构
 	//ws 指的是 WebSocket 连接对象
 	r.GET("/ws", func(c *gin.Context) {
+		//浏览器的 WebSocket API 无法设置请求头，token 也可以通过 ?access_token= 传递
+		tokenString, err := requestToken(c)
+		if err != nil {
+			tokenString = c.Query("access_token")
+		}
+		claims, err := authenticate(c.Request.Context(), verifier, authClient, tokenString)
+		if err != nil {
+			c.JSON(401, gin.H{"code": 401, "error": "invalid or revoked token"})
+			return
+		}
+		if !claims.HasScope(token.ScopeTelemetryRead) {
+			c.JSON(403, gin.H{"code": 403, "error": "api key scope " + token.ScopeTelemetryRead + " required"})
+			return
+		}
+
 		ws, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 		if err != nil {
 			log.Fatalf("❌ WS Upgrade failed: %v", err)
 			return
 		}
 		mutex.Lock()
-		clients[ws] = true
+		clients[ws] = claims
 		mutex.Unlock()
-		log.Println("🔌 New Browser Connected!")
+		log.Printf("🔌 New Browser Connected! user=%s tenant=%q", claims.Username, claims.TenantID)
 
 		for {
 			if _, _, err := ws.ReadMessage(); err != nil {
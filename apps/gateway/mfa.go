@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+)
+
+// loginJSON 登录响应
+// 需要两步验证时只返回 mfa_token，客户端再调用 /api/v1/auth/login/mfa
+func loginJSON(resp *authv1.LoginResponse) gin.H {
+	if resp.MfaRequired {
+		return gin.H{
+			"username":                resp.Userme,
+			"mfa_required":            true,
+			"mfa_token":               resp.MfaToken,
+			"mfa_enrollment_required": resp.MfaEnrollmentRequired,
+		}
+	}
+	body := gin.H{
+		"access_token":       resp.AccessToken,
+		"expires_in":         resp.ExpiresIn,
+		"username":           resp.Userme,
+		"refresh_token":      resp.RefreshToken,
+		"refresh_expires_in": resp.RefreshExpiresIn,
+	}
+	if len(resp.RecoveryCodes) > 0 {
+		body["recovery_codes"] = resp.RecoveryCodes
+	}
+	return body
+}
+
+// registerMFARoutes 注册两步验证相关路由
+// 鉴权由 auth service 完成: 登录第二步和强制绑定使用 mfa_token，其余使用 access token
+func registerMFARoutes(r gin.IRoutes, authClient authv1.AuthServiceClient) {
+	//登录第二步
+	r.POST("/api/v1/auth/login/mfa", func(c *gin.Context) {
+		var req authv1.VerifyMfaRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.VerifyMfa(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, loginJSON(resp))
+	})
+
+	//开始绑定验证器，请求体可以为空 (已登录用户)
+	r.POST("/api/v1/auth/totp/enroll", func(c *gin.Context) {
+		var req authv1.EnrollTotpRequest
+		_ = c.ShouldBindJSON(&req)
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.EnrollTotp(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{
+			"secret":      resp.Secret,
+			"otpauth_uri": resp.OtpauthUri,
+		})
+	})
+
+	//确认绑定
+	r.POST("/api/v1/auth/totp/confirm", func(c *gin.Context) {
+		var req authv1.ConfirmTotpRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.ConfirmTotp(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"recovery_codes": resp.RecoveryCodes})
+	})
+
+	//关闭两步验证
+	r.POST("/api/v1/auth/totp/disable", func(c *gin.Context) {
+		var req authv1.DisableTotpRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := authClient.DisableTotp(ctx, &req); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success"})
+	})
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+)
+
+// oidcStateCookie 保存发起单点登录的 state
+// 回调时要求与请求中的 state 一致，防止攻击者诱导用户完成攻击者自己的登录 (login CSRF)
+const oidcStateCookie = "cheya_oidc_state"
+
+// registerOIDCRoutes 注册单点登录路由
+// 浏览器访问 /api/v1/auth/oidc/login 跳转到 IdP，IdP 回跳到前端回调页，
+// 前端再把 code 和 state 提交到 /api/v1/auth/oidc/callback 换取 token
+func registerOIDCRoutes(r gin.IRoutes, authClient authv1.AuthServiceClient) {
+	r.GET("/api/v1/auth/oidc/login", func(c *gin.Context) {
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.StartOidcLogin(ctx, &authv1.StartOidcLoginRequest{})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.SetSameSite(http.SameSiteLaxMode)
+		c.SetCookie(oidcStateCookie, resp.State, 600, "/api/v1/auth/oidc", "", c.Request.TLS != nil, true)
+		c.Redirect(http.StatusFound, resp.AuthorizationUrl)
+	})
+
+	r.POST("/api/v1/auth/oidc/callback", func(c *gin.Context) {
+		var req authv1.CompleteOidcLoginRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": "Invalid body"})
+			return
+		}
+		if state, err := c.Cookie(oidcStateCookie); err != nil || state != req.State {
+			c.JSON(400, gin.H{"code": 400, "error": "state 不匹配，请重新登录"})
+			return
+		}
+		c.SetCookie(oidcStateCookie, "", -1, "/api/v1/auth/oidc", "", c.Request.TLS != nil, true)
+
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := authClient.CompleteOidcLogin(ctx, &req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, loginJSON(resp))
+	})
+}
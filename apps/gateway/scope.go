@@ -0,0 +1,76 @@
+package main
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/xuewentao/cheya/pkg/token"
+)
+
+// routeScopes 需要登录的路由对应的 API key scope，key 为 "<method> <路由模板>"
+// 按状态或分组下发批量指令时网关会查询车辆列表，API key 还需要 vehicles:read
+var routeScopes = map[string]string{
+	//车辆
+	"GET /api/v1/vehicles":               token.ScopeVehiclesRead,
+	"GET /api/v1/vehicles/:id":           token.ScopeVehiclesRead,
+	"GET /api/v1/vehicles/:id/history":   token.ScopeVehiclesRead,
+	"GET /api/v1/vehicles/export":        token.ScopeVehiclesRead,
+	"PATCH /api/v1/vehicles/:id":         token.ScopeVehiclesWrite,
+	"DELETE /api/v1/vehicles/:id":        token.ScopeVehiclesWrite,
+	"POST /api/v1/vehicles/:vin/restore": token.ScopeVehiclesWrite,
+	"POST /api/v1/vehicles/import":       token.ScopeVehiclesWrite,
+
+	//分组
+	"GET /api/v1/groups":                 token.ScopeVehiclesRead,
+	"GET /api/v1/groups/:id":             token.ScopeVehiclesRead,
+	"POST /api/v1/groups":                token.ScopeVehiclesWrite,
+	"PATCH /api/v1/groups/:id":           token.ScopeVehiclesWrite,
+	"DELETE /api/v1/groups/:id":          token.ScopeVehiclesWrite,
+	"POST /api/v1/groups/:id/vehicles":   token.ScopeVehiclesWrite,
+	"DELETE /api/v1/groups/:id/vehicles": token.ScopeVehiclesWrite,
+
+	//司机和驾驶记录
+	"GET /api/v1/drivers":                  token.ScopeVehiclesRead,
+	"GET /api/v1/drivers/:id":              token.ScopeVehiclesRead,
+	"GET /api/v1/drivers/:id/assignments":  token.ScopeVehiclesRead,
+	"GET /api/v1/vehicles/:id/assignments": token.ScopeVehiclesRead,
+	"GET /api/v1/vehicles/:id/driver":      token.ScopeVehiclesRead,
+	"POST /api/v1/drivers":                 token.ScopeVehiclesWrite,
+	"PATCH /api/v1/drivers/:id":            token.ScopeVehiclesWrite,
+	"POST /api/v1/vehicles/:vin/driver":    token.ScopeVehiclesWrite,
+	"DELETE /api/v1/vehicles/:id/driver":   token.ScopeVehiclesWrite,
+
+	//车载终端
+	"GET /api/v1/vehicles/:id/devices":   token.ScopeDevicesRead,
+	"POST /api/v1/vehicles/:vin/devices": token.ScopeDevicesWrite,
+	"DELETE /api/v1/devices/:id":         token.ScopeDevicesWrite,
+
+	//指令
+	"POST /api/v1/vehicles/:vin/control":  token.ScopeCommandsWrite,
+	"POST /api/v1/commands/bulk":          token.ScopeCommandsWrite,
+	"DELETE /api/v1/commands/bulk/:id":    token.ScopeCommandsWrite,
+	"GET /api/v1/commands/bulk/:id":       token.ScopeCommandsRead,
+	"GET /api/v1/commands/bulk/:id/items": token.ScopeCommandsRead,
+	"GET /api/v1/commands/:id":            token.ScopeCommandsRead,
+}
+
+// scopeRequired 检查 API key 是否拥有路由需要的 scope，放在 authRequired 之后
+// 不在 routeScopes 中的路由不允许 API key 访问；用户的 access token 只按角色鉴权
+func scopeRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims := currentClaims(c)
+		if claims == nil || !token.IsAPIKeySubject(claims.Subject) {
+			c.Next()
+			return
+		}
+		scope, ok := routeScopes[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.AbortWithStatusJSON(403, gin.H{"code": 403, "error": "this endpoint is not available to api keys"})
+			return
+		}
+		if !claims.HasScope(scope) {
+			c.AbortWithStatusJSON(403, gin.H{"code": 403, "error": "api key scope " + scope + " required"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"github.com/xuewentao/cheya/pkg/token"
+)
+
+// canAccessTenant 调用者能否访问属于 tenantID 的资源，平台管理员可以跨租户访问
+func canAccessTenant(claims *token.Claims, tenantID string) bool {
+	if claims == nil {
+		return false
+	}
+	if claims.Role == grpcauth.RolePlatformAdmin {
+		return true
+	}
+	return claims.TenantID != "" && claims.TenantID == tenantID
+}
+
+// inaccessibleVins 返回调用者无权操作的车辆 (属于其他租户或不存在)
+func inaccessibleVins(ctx context.Context, index *tenant.Index, claims *token.Claims, vins []string) ([]string, error) {
+	tenants, err := index.LookupMany(ctx, vins)
+	if err != nil {
+		return nil, err
+	}
+	var denied []string
+	for _, vin := range vins {
+		if !canAccessTenant(claims, tenants[vin]) {
+			denied = append(denied, vin)
+		}
+	}
+	return denied, nil
+}
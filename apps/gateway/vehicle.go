@@ -0,0 +1,349 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+// vehicleMarshaler 车辆的 JSON 格式
+// encoding/json 会把 Timestamp 输出成 {seconds, nanos}，Struct 输出成内部结构，所以用 protojson
+// 字段名保持 snake_case，状态保持数字，和之前的响应兼容
+var vehicleMarshaler = protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true}
+
+// vehicleJSON 单个车辆 (或车辆服务的其它消息) 转换为 JSON，时间为 RFC 3339 字符串
+func vehicleJSON(m proto.Message) (json.RawMessage, error) {
+	if !m.ProtoReflect().IsValid() {
+		return json.RawMessage("null"), nil
+	}
+	return vehicleMarshaler.Marshal(m)
+}
+
+// vehiclesJSON 列表转换为 JSON，空列表返回 null 与之前一致
+func vehiclesJSON[T proto.Message](ms []T) ([]json.RawMessage, error) {
+	if ms == nil {
+		return nil, nil
+	}
+	items := make([]json.RawMessage, len(ms))
+	for i, m := range ms {
+		b, err := vehicleJSON(m)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = b
+	}
+	return items, nil
+}
+
+// vehicleETag 车辆版本号作为强 ETag，版本号为 0 (旧版本的车辆服务) 时不返回
+func vehicleETag(v *vehiclev1.Vehicle) string {
+	if v.GetVersion() <= 0 {
+		return ""
+	}
+	return strconv.Quote(strconv.FormatInt(v.Version, 10))
+}
+
+// etagMatches If-Match / If-None-Match 中是否包含 etag，支持逗号分隔的多个值和 *
+func etagMatches(header, etag string) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// ifMatchVersion 解析 If-Match 头中的版本号，没有或为 * 时返回 0，表示不检查版本
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	h := strings.TrimSpace(c.GetHeader("If-Match"))
+	if h == "" || h == "*" {
+		return 0, nil
+	}
+	s, err := strconv.Unquote(strings.TrimPrefix(h, "W/"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid If-Match header")
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header")
+	}
+	return v, nil
+}
+
+// writeVehicle 返回单个车辆并带上 ETag
+func writeVehicle(c *gin.Context, v *vehiclev1.Vehicle) {
+	data, err := vehicleJSON(v)
+	if err != nil {
+		c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+		return
+	}
+	if etag := vehicleETag(v); etag != "" {
+		c.Header("ETag", etag)
+	}
+	c.JSON(200, gin.H{"code": 200, "message": "success", "data": data})
+}
+
+// fuelTypes 请求中的燃料类型，响应中与 status 一样输出为数字
+var fuelTypes = map[string]vehiclev1.FuelType{
+	"ice":    vehiclev1.FuelType_FUEL_TYPE_ICE,
+	"ev":     vehiclev1.FuelType_FUEL_TYPE_EV,
+	"hybrid": vehiclev1.FuelType_FUEL_TYPE_HYBRID,
+}
+
+// parseFuelType 空字符串表示不限 / 清除
+func parseFuelType(v string) (vehiclev1.FuelType, error) {
+	if v == "" {
+		return vehiclev1.FuelType_FUEL_TYPE_UNSPECIFIED, nil
+	}
+	ft, ok := fuelTypes[strings.ToLower(v)]
+	if !ok {
+		return 0, fmt.Errorf("fuel_type must be ice, ev or hybrid")
+	}
+	return ft, nil
+}
+
+func fuelTypeName(ft vehiclev1.FuelType) string {
+	for name, v := range fuelTypes {
+		if v == ft {
+			return name
+		}
+	}
+	return ""
+}
+
+// listVehiclesRequest 解析 GET /api/v1/vehicles 的查询参数
+//
+//	pageSize              每页数量，默认 100
+//	pageToken             游标分页，传上一页返回的 nextPageToken
+//	page                  旧的 offset 分页，传了 pageToken 时忽略；不传时返回第一页
+//	includeTotal=true     返回 total，需要额外执行一次 COUNT，不需要时不要传
+//	status                online / offline
+//	platePrefix, vinPrefix 车牌 / VIN 前缀
+//	heartbeatAfter, heartbeatBefore  最后心跳时间范围 (RFC 3339)
+//	tags                  逗号分隔，或重复传多个 tags，需同时带有全部标签
+//	group                 分组 ID，includeSubgroups=true 时包括下级分组的车辆
+//	make, model           品牌 / 车型，忽略大小写
+//	yearMin, yearMax      年款范围，包含两端
+//	capacityKgMin, capacityKgMax  额定载质量范围 (kg)，包含两端
+//	fuelType              ice / ev / hybrid
+//	attr.<属性名>=<值>     自定义属性，可以传多个，需全部匹配
+//	orderBy               例如 "last_heartbeat desc"，默认 "created_at desc"
+func listVehiclesRequest(c *gin.Context) (*vehiclev1.ListVehiclesRequest, error) {
+	req := &vehiclev1.ListVehiclesRequest{
+		PageSize:           100,
+		LicensePlatePrefix: c.Query("platePrefix"),
+		VinPrefix:          c.Query("vinPrefix"),
+		OrderBy:            c.Query("orderBy"),
+		PageToken:          c.Query("pageToken"),
+		IncludeTotal:       c.Query("includeTotal") == "true",
+		GroupId:            c.Query("group"),
+		IncludeSubgroups:   c.Query("includeSubgroups") == "true",
+	}
+	if v := c.Query("page"); v != "" && req.PageToken == "" {
+		if p, err := strconv.ParseInt(v, 10, 32); err == nil && p > 0 {
+			req.Page = int32(p)
+		}
+	}
+	if v := c.Query("pageSize"); v != "" {
+		if ps, err := strconv.ParseInt(v, 10, 32); err == nil && ps > 0 {
+			req.PageSize = int32(ps)
+		}
+	}
+
+	switch strings.ToLower(c.Query("status")) {
+	case "":
+	case "online":
+		req.Status = vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE
+	case "offline":
+		req.Status = vehiclev1.VehicleStatus_VEHICLE_STATUS_OFFLINE
+	default:
+		return nil, fmt.Errorf("status must be online or offline")
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  **timestamppb.Timestamp
+	}{
+		{"heartbeatAfter", &req.HeartbeatAfter},
+		{"heartbeatBefore", &req.HeartbeatBefore},
+	} {
+		v := c.Query(p.name)
+		if v == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time", p.name)
+		}
+		*p.dst = timestamppb.New(t)
+	}
+
+	req.Make, req.Model = c.Query("make"), c.Query("model")
+	for _, p := range []struct {
+		name string
+		dst  *int32
+	}{
+		{"yearMin", &req.YearMin},
+		{"yearMax", &req.YearMax},
+		{"capacityKgMin", &req.CapacityKgMin},
+		{"capacityKgMax", &req.CapacityKgMax},
+	} {
+		v := c.Query(p.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer", p.name)
+		}
+		*p.dst = int32(n)
+	}
+	var err error
+	if req.FuelType, err = parseFuelType(c.Query("fuelType")); err != nil {
+		return nil, err
+	}
+	for key, values := range c.Request.URL.Query() {
+		if name, ok := strings.CutPrefix(key, "attr."); ok && name != "" && len(values) > 0 {
+			if req.Attributes == nil {
+				req.Attributes = map[string]string{}
+			}
+			req.Attributes[name] = values[0]
+		}
+	}
+
+	for _, v := range c.QueryArray("tags") {
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				req.Tags = append(req.Tags, tag)
+			}
+		}
+	}
+	return req, nil
+}
+
+// registerVehicleRoutes 注册车辆修改、删除、恢复和变更记录路由
+// gin 同一方法下同一位置的通配符必须同名: POST 沿用 :vin，其它方法沿用 :id，参数值都是 VIN
+// 修改、删除和恢复支持 If-Match: "<GET 返回的 ETag>"，车辆已被其他人修改时返回 412
+func registerVehicleRoutes(r gin.IRoutes, vehicleClient vehiclev1.VehicleServiceClient) {
+	//修改车辆，只更新请求体中出现的字段: {"license_plate": "沪A12345", "tags": ["冷链"], "make": "比亚迪", "year": 2024, "fuel_type": "ev", "capacity_kg": 18000, "attributes": {"成本中心": "A1"}}
+	//make、model、year、fuel_type、capacity_kg、attributes 传空值 ("" / 0 / {}) 表示清除
+	r.PATCH("/api/v1/vehicles/:id", func(c *gin.Context) {
+		var body map[string]json.RawMessage
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": "invalid request body"})
+			return
+		}
+		expected, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		req := &vehiclev1.UpdateVehicleRequest{Vin: c.Param("id"), UpdateMask: &fieldmaskpb.FieldMask{}, ExpectedVersion: expected}
+		for field, raw := range body {
+			var err error
+			switch field {
+			case "license_plate":
+				err = json.Unmarshal(raw, &req.LicensePlate)
+			case "tags":
+				err = json.Unmarshal(raw, &req.Tags)
+			case "make":
+				err = json.Unmarshal(raw, &req.Make)
+			case "model":
+				err = json.Unmarshal(raw, &req.Model)
+			case "year":
+				err = json.Unmarshal(raw, &req.Year)
+			case "capacity_kg":
+				err = json.Unmarshal(raw, &req.CapacityKg)
+			case "attributes":
+				err = json.Unmarshal(raw, &req.Attributes)
+			case "fuel_type":
+				var v string
+				if err = json.Unmarshal(raw, &v); err == nil {
+					req.FuelType, err = parseFuelType(v)
+				}
+			default:
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("field %q cannot be updated", field)})
+				return
+			}
+			if err != nil {
+				c.JSON(400, gin.H{"code": 400, "error": fmt.Sprintf("invalid %s", field)})
+				return
+			}
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, field)
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.UpdateVehicle(ctx, req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicle(c, resp.Vehicle)
+	})
+
+	//删除车辆 (软删除，可以恢复)
+	r.DELETE("/api/v1/vehicles/:id", func(c *gin.Context) {
+		expected, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		if _, err := vehicleClient.DeleteVehicle(ctx, &vehiclev1.DeleteVehicleRequest{Vin: c.Param("id"), ExpectedVersion: expected}); err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "message": "success"})
+	})
+
+	//恢复已删除的车辆
+	r.POST("/api/v1/vehicles/:vin/restore", func(c *gin.Context) {
+		expected, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.RestoreVehicle(ctx, &vehiclev1.RestoreVehicleRequest{Vin: c.Param("vin"), ExpectedVersion: expected})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		writeVehicle(c, resp.Vehicle)
+	})
+
+	//变更记录，按时间倒序: ?pageSize=20&pageToken=<上一页的 nextPageToken>
+	r.GET("/api/v1/vehicles/:id/history", func(c *gin.Context) {
+		pageSize, _ := strconv.Atoi(c.Query("pageSize"))
+		ctx, cancel := forwardAuth(c)
+		defer cancel()
+		resp, err := vehicleClient.GetVehicleHistory(ctx, &vehiclev1.GetVehicleHistoryRequest{
+			Vin:       c.Param("id"),
+			PageSize:  int32(pageSize),
+			PageToken: c.Query("pageToken"),
+		})
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		items, err := vehiclesJSON(resp.Entries)
+		if err != nil {
+			c.JSON(500, gin.H{"code": 500, "error": err.Error()})
+			return
+		}
+		c.JSON(200, gin.H{"code": 200, "data": gin.H{"items": items, "nextPageToken": resp.NextPageToken}})
+	})
+}
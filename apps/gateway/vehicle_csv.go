@@ -0,0 +1,218 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+const (
+	importChunkSize = 32 << 10        // 每条 gRPC 消息携带的文件内容
+	maxImportUpload = 10<<20 + 1<<20  // 与 vehicle service 的 10MB 上限一致，留出 multipart 的开销
+	csvTimeout      = 2 * time.Minute // 导入导出的超时时间
+	exportPageSize  = 500
+)
+
+// exportHeader 导出的列，vin、license_plate、tags、make、model、year、fuel_type、capacity_kg 可以直接修改后重新导入
+var exportHeader = []string{
+	"vin", "license_plate", "tags", "status", "tenant_id", "make", "model", "year", "fuel_type", "capacity_kg",
+	"latitude", "longitude", "address", "last_heartbeat", "created_at", "updated_at",
+}
+
+// csvCell 以 = + - @ 开头的内容在 Excel 中会被当作公式执行，加上单引号前缀
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func csvTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Format(time.RFC3339)
+}
+
+// exportRecord 车辆转换为 CSV 的一行
+func exportRecord(v *vehiclev1.Vehicle) []string {
+	status := ""
+	switch v.Status {
+	case vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE:
+		status = "online"
+	case vehiclev1.VehicleStatus_VEHICLE_STATUS_OFFLINE:
+		status = "offline"
+	}
+	var lat, lng, address string
+	if loc := v.Location; loc != nil {
+		lat = strconv.FormatFloat(loc.Latitude, 'f', -1, 64)
+		lng = strconv.FormatFloat(loc.Longitude, 'f', -1, 64)
+		address = loc.Address
+	}
+	year, capacity := "", ""
+	if v.Year != 0 {
+		year = strconv.Itoa(int(v.Year))
+	}
+	if v.CapacityKg != 0 {
+		capacity = strconv.Itoa(int(v.CapacityKg))
+	}
+	return []string{
+		v.Vin, v.LicensePlate, csvCell(strings.Join(v.Tags, ";")), status, v.TenantId,
+		csvCell(v.Make), csvCell(v.Model), year, fuelTypeName(v.FuelType), capacity,
+		lat, lng, csvCell(address), csvTime(v.LastHeartbeat), csvTime(v.CreatedAt), csvTime(v.UpdatedAt),
+	}
+}
+
+// importFile 上传的文件: multipart 的 file 字段，或者直接以 text/csv 作为请求体
+func importFile(c *gin.Context) (io.ReadCloser, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportUpload)
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return nil, errors.New("file is required")
+		}
+		return fh.Open()
+	}
+	return c.Request.Body, nil
+}
+
+// registerVehicleCSVRoutes 注册车辆批量导入和导出路由
+// 静态路径 import / export 优先于 /vehicles/:vin 和 /vehicles/:id 匹配
+func registerVehicleCSVRoutes(r gin.IRoutes, vehicleClient vehiclev1.VehicleServiceClient) {
+	//批量导入: POST /api/v1/vehicles/import?dryRun=true&tenantId=
+	//必须有 vin、license_plate 列，可选 tags 列 (多个标签用分号分隔)；有错误的行在 errors 中返回，此时不会写入任何数据
+	r.POST("/api/v1/vehicles/import", func(c *gin.Context) {
+		file, err := importFile(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		defer file.Close()
+		dryRun, _ := strconv.ParseBool(c.Query("dryRun"))
+
+		ctx, cancel := forwardAuthTimeout(c, csvTimeout)
+		defer cancel()
+		stream, err := vehicleClient.ImportVehicles(ctx)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		//1.先发送选项，再分块发送文件内容
+		if err := stream.Send(&vehiclev1.ImportVehiclesRequest{
+			Payload: &vehiclev1.ImportVehiclesRequest_Options{Options: &vehiclev1.ImportOptions{
+				DryRun:   dryRun,
+				TenantId: c.Query("tenantId"),
+			}},
+		}); err != nil && !errors.Is(err, io.EOF) {
+			writeGRPCError(c, err)
+			return
+		}
+		buf := make([]byte, importChunkSize)
+		for {
+			n, err := file.Read(buf)
+			if n > 0 {
+				//服务端提前结束 (例如鉴权失败) 时 Send 返回 io.EOF，真正的错误由 CloseAndRecv 返回
+				chunk := &vehiclev1.ImportVehiclesRequest{Payload: &vehiclev1.ImportVehiclesRequest_Chunk{Chunk: buf[:n]}}
+				if sendErr := stream.Send(chunk); sendErr != nil {
+					break
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					c.JSON(http.StatusRequestEntityTooLarge, gin.H{"code": 413, "error": "文件不能超过 10MB"})
+					return
+				}
+				c.JSON(400, gin.H{"code": 400, "error": "读取上传文件失败"})
+				return
+			}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+
+		//2.逐行错误报告，有错误时返回 422
+		rowErrors := make([]gin.H, len(resp.Errors))
+		for i, e := range resp.Errors {
+			rowErrors[i] = gin.H{"row": e.Row, "vin": e.Vin, "field": e.Field, "message": e.Message}
+		}
+		code := http.StatusOK
+		if len(resp.Errors) > 0 {
+			code = http.StatusUnprocessableEntity
+		}
+		c.JSON(code, gin.H{
+			"code": code,
+			"data": gin.H{
+				"totalRows": resp.TotalRows,
+				"created":   resp.Created,
+				"updated":   resp.Updated,
+				"unchanged": resp.Unchanged,
+				"dryRun":    resp.DryRun,
+				"committed": resp.Committed,
+				"errors":    rowErrors,
+			},
+		})
+	})
+
+	//导出: GET /api/v1/vehicles/export，过滤和排序参数与车辆列表相同
+	//按游标逐页查询并边查边写，带 UTF-8 BOM 以便 Excel 正确识别中文
+	r.GET("/api/v1/vehicles/export", func(c *gin.Context) {
+		req, err := listVehiclesRequest(c)
+		if err != nil {
+			c.JSON(400, gin.H{"code": 400, "error": err.Error()})
+			return
+		}
+		req.Page, req.PageSize, req.IncludeTotal = 0, exportPageSize, false
+		ctx, cancel := forwardAuthTimeout(c, csvTimeout)
+		defer cancel()
+
+		//第一页出错时还可以返回 JSON 错误
+		resp, err := vehicleClient.ListVehicles(ctx, req)
+		if err != nil {
+			writeGRPCError(c, err)
+			return
+		}
+		filename := fmt.Sprintf("vehicles-%s.csv", time.Now().Format("20060102-150405"))
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+		c.Status(http.StatusOK)
+		c.Writer.WriteString("\xef\xbb\xbf")
+		w := csv.NewWriter(c.Writer)
+		w.Write(exportHeader)
+		rows := 0
+		for {
+			for _, v := range resp.Vehicles {
+				w.Write(exportRecord(v))
+			}
+			rows += len(resp.Vehicles)
+			w.Flush()
+			c.Writer.Flush()
+			if resp.NextPageToken == "" {
+				break
+			}
+			req.PageToken = resp.NextPageToken
+			if resp, err = vehicleClient.ListVehicles(ctx, req); err != nil {
+				//响应头已经发出，只能中断输出
+				log.Printf("❌ export vehicles aborted after %d rows: %v", rows, err)
+				return
+			}
+		}
+		log.Printf("📤 Exported %d vehicles", rows)
+	})
+}
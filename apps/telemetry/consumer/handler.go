@@ -3,24 +3,35 @@ package consumer
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/segmentio/kafka-go"
+
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/token"
 )
 
+// CredentialHeader Kafka 消息头，值为 "Bearer <设备凭证>"
+const CredentialHeader = "authorization"
+
 // 远程测量/远程监控数据
 type TelemetryData struct {
-	VehicleID string  `json:"vehicle_id"`
-	Timestamp int64   `json:"timestamp"`
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Speed     float64 `json:"speed"`
+	VehicleID    string  `json:"vehicle_id"`
+	Timestamp    int64   `json:"timestamp"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Speed        float64 `json:"speed"`
+	BatteryLevel float64 `json:"battery_level,omitempty"`
+	EngineTemp   float64 `json:"engine_temp,omitempty"`
 }
 
 // StartTelemetryConsumer 启动消费者
-func StartTelemetryConsumer(ctx context.Context, brokers []string, topic string, rdb *redis.Client) {
+// 每条消息都必须带有设备凭证，且 vehicle_id 与设备绑定的车辆一致，否则丢弃
+func StartTelemetryConsumer(ctx context.Context, brokers []string, topic string, rdb *redis.Client, devices grpcauth.Authenticator) {
 	//1.配置 Reader （消费者）
 	r := kafka.NewReader(kafka.ReaderConfig{
 		Brokers:  brokers,
@@ -67,6 +78,12 @@ func StartTelemetryConsumer(ctx context.Context, brokers []string, topic string,
 			continue
 		}
 
+		//4.校验设备身份
+		if err := authenticateMessage(ctx, devices, m, data.VehicleID); err != nil {
+			log.Printf("🚫 Rejected telemetry for %s (partition=%d offset=%d): %v", data.VehicleID, m.Partition, m.Offset, err)
+			continue
+		}
+
 		// 发布到 Redis
 		if err := rdb.Publish(ctx, "vehicle:update", m.Value).Err(); err != nil {
 			log.Printf("⚠️ Redis Publish Error: %v", err)
@@ -85,3 +102,29 @@ func StartTelemetryConsumer(ctx context.Context, brokers []string, topic string,
 		// TODO: 将数据存储到数据库或进行其他处理
 	}
 }
+
+// authenticateMessage 校验消息头中的设备凭证，并确认设备绑定的就是上报的车辆
+func authenticateMessage(ctx context.Context, devices grpcauth.Authenticator, m kafka.Message, vehicleID string) error {
+	var header string
+	for _, h := range m.Headers {
+		if h.Key == CredentialHeader {
+			header = string(h.Value)
+			break
+		}
+	}
+	credential, err := token.FromAuthorization(header)
+	if err != nil {
+		return err
+	}
+	if !token.IsDeviceCredential(credential) {
+		return errors.New("not a device credential")
+	}
+	p, err := devices.Authenticate(ctx, credential)
+	if err != nil {
+		return err
+	}
+	if p.VIN != vehicleID {
+		return fmt.Errorf("device is bound to %s", p.VIN)
+	}
+	return nil
+}
@@ -7,17 +7,25 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/redis/go-redis/v9"
+	"github.com/segmentio/kafka-go"
 
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
 	telemetryv1 "github.com/xuewentao/cheya/api/telemetry/v1"
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
 	"github.com/xuewentao/cheya/apps/telemetry/consumer" // 引入我们刚才写的包
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/token"
 )
 
 type TelemetryServer struct {
 	telemetryv1.UnimplementedTelemetryServiceServer
+	writer *kafka.Writer //UploadTelemetry 写入与车载终端相同的 topic
 }
 
 func main() {
@@ -38,18 +46,48 @@ func main() {
 	}
 	log.Println("✅ Connected to Redis")
 
-	//2.后台启动 kafka 消费者
+	//2.鉴权: 从 auth service 拉取 JWKS 本地验签，并检查 Redis 吊销列表；API key 通过 auth service 校验
+	//车载终端使用设备凭证，由 vehicle service 校验
+	authConn, err := grpc.NewClient("localhost:50054", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ failed to connect auth service: %v", err)
+	}
+	defer authConn.Close()
+	vehicleConn, err := grpc.NewClient("localhost:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ failed to connect vehicle service: %v", err)
+	}
+	defer vehicleConn.Close()
+	authClient := authv1.NewAuthServiceClient(authConn)
+	jwks := token.NewJWKSCache(grpcauth.JWKSFetcher(authClient), 5*time.Minute)
+	devices := grpcauth.NewDeviceAuthenticator(vehiclev1.NewVehicleServiceClient(vehicleConn), 30*time.Second)
+	authn := grpcauth.WithDevices(
+		grpcauth.WithAPIKeys(grpcauth.NewLocalAuthenticator(token.NewVerifier(jwks, rdb)), authClient),
+		devices,
+	)
+
+	//3.后台启动 kafka 消费者
 	brokers := []string{"localhost:9092"}
 	topic := "telemetry.raw"
 
-	go consumer.StartTelemetryConsumer(ctx, brokers, topic, rdb)
-	//3.启动 grpc server
+	go consumer.StartTelemetryConsumer(ctx, brokers, topic, rdb, devices)
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(brokers...),
+		Topic:    topic,
+		Balancer: &kafka.LeastBytes{},
+	}
+	defer writer.Close()
+
+	//4.启动 grpc server
 	lis, err := net.Listen("tcp", ":50052")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
-	telemetryv1.RegisterTelemetryServiceServer(s, &TelemetryServer{})
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(authn)),
+		grpc.ChainStreamInterceptor(grpcauth.StreamServerInterceptor(authn)),
+	)
+	telemetryv1.RegisterTelemetryServiceServer(s, &TelemetryServer{writer: writer})
 
 	go func() {
 		log.Println("📡 Telemetry Service is running on :50052")
@@ -66,5 +104,5 @@ func main() {
 	log.Println("Shutting down services...")
 	cancel()
 	s.GracefulStop() //grace 优雅退出 不要暴力 shut down 等所有的 io 操作完成再退出
-	
+
 }
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	telemetryv1 "github.com/xuewentao/cheya/api/telemetry/v1"
+	"github.com/xuewentao/cheya/apps/telemetry/consumer"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+)
+
+// UploadTelemetry 车载终端通过 gRPC 上报遥测
+// 只接受设备凭证，且 vehicle_id 必须是设备绑定的车辆；数据写入 Kafka，与直接写 Kafka 的终端走同一条处理链路
+func (s *TelemetryServer) UploadTelemetry(ctx context.Context, req *telemetryv1.UploadTelemetryRequest) (*telemetryv1.UploadTelemetryResponse, error) {
+	p, err := grpcauth.RequireDevice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.VehicleId != p.VIN {
+		return nil, status.Errorf(codes.PermissionDenied, "device is not bound to vehicle %s", req.VehicleId)
+	}
+
+	data := consumer.TelemetryData{
+		VehicleID:    req.VehicleId,
+		Timestamp:    parseTimestamp(req.Tinestamp),
+		Speed:        req.Speed,
+		BatteryLevel: req.BatteryLevel,
+		EngineTemp:   req.EngineTemp,
+	}
+	if req.Location != nil {
+		data.Latitude = req.Location.Latitude
+		data.Longitude = req.Location.Longitude
+	}
+	value, err := json.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal telemetry: %v", err)
+	}
+
+	//消费者会再次校验设备凭证，这里原样带上
+	md, _ := metadata.FromIncomingContext(ctx)
+	msg := kafka.Message{
+		Key:   []byte(req.VehicleId), //保证同一辆车有序
+		Value: value,
+	}
+	if values := md.Get("authorization"); len(values) > 0 {
+		msg.Headers = []kafka.Header{{Key: consumer.CredentialHeader, Value: []byte(values[0])}}
+	}
+	if err := s.writer.WriteMessages(ctx, msg); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "write telemetry: %v", err)
+	}
+	return &telemetryv1.UploadTelemetryResponse{Success: true, Message: "ok"}, nil
+}
+
+// parseTimestamp 上报时间支持 RFC 3339 和 unix 秒，缺省或无法解析时使用服务端时间
+func parseTimestamp(s string) int64 {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Unix()
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil && n > 0 {
+		return n
+	}
+	return time.Now().Unix()
+}
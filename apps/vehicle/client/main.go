@@ -5,9 +5,12 @@ import (
 	"log"
 	"time"
 
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
 	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/vin"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
 func main() {
@@ -30,16 +33,38 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
+	//vehicle service 需要鉴权，先到 auth service 登录拿 token
+	authConn, err := grpc.NewClient("localhost:50054",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Fatalf("❌failed to create auth client: %v", err)
+	}
+	defer authConn.Close()
+	authClient := authv1.NewAuthServiceClient(authConn)
+	loginResp, err := authClient.Login(ctx, &authv1.LoginRequest{
+		Username: "admin",
+		Password: "123456",
+	})
+	if err != nil {
+		log.Fatalf("❌ Login failed: %v", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+loginResp.AccessToken)
+
+	//默认 admin 是平台管理员，不属于任何租户，车辆录入到 demo 租户下
+	tenantID := demoTenant(ctx, authClient)
+
 	//mock 一辆新车
 	timestamp := time.Now().Format("150405")
-	vin := "VIN-TEST-" + timestamp
-	plate := "沪A-" + timestamp
+	vin := testVIN(timestamp)
+	plate := "沪AD" + timestamp[1:]
 
 	log.Printf("🛠️  Creating Vehicle with VIN: %s ...", vin)
 
 	createResp, err := c.CreateVehicle(ctx, &vehiclev1.CreateVehicleRequest{
 		Vin:          vin,
 		LicensePlate: plate,
+		TenantId:     tenantID,
 	})
 
 	if err != nil {
@@ -65,3 +90,31 @@ func main() {
 	log.Printf("   - Status: %v", v.Status)
 
 }
+
+// demoTenant 返回 demo 租户的 ID，不存在时创建
+func demoTenant(ctx context.Context, authClient authv1.AuthServiceClient) string {
+	const name = "demo"
+	listResp, err := authClient.ListOrganizations(ctx, &authv1.ListOrganizationsRequest{})
+	if err != nil {
+		log.Fatalf("❌ List organizations failed: %v", err)
+	}
+	for _, o := range listResp.Organizations {
+		if o.Name == name {
+			return o.Id
+		}
+	}
+	createResp, err := authClient.CreateOrganization(ctx, &authv1.CreateOrganizationRequest{Name: name})
+	if err != nil {
+		log.Fatalf("❌ Create organization failed: %v", err)
+	}
+	return createResp.Organization.Id
+}
+
+// testVIN 生成测试用 VIN，第 9 位填入正确的校验位
+func testVIN(serial string) string {
+	v := []byte("LCHEYA0T0TS" + serial)
+	if c, err := vin.CheckDigit(string(v)); err == nil {
+		v[8] = c
+	}
+	return string(v)
+}
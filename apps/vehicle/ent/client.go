@@ -14,7 +14,14 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicleevent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
 )
 
 // Client is the client that holds all ent builders.
@@ -22,8 +29,20 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// DriverAssignment is the client for interacting with the DriverAssignment builders.
+	DriverAssignment *DriverAssignmentClient
+	// DriverProfile is the client for interacting with the DriverProfile builders.
+	DriverProfile *DriverProfileClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// Vehicle is the client for interacting with the Vehicle builders.
 	Vehicle *VehicleClient
+	// VehicleEvent is the client for interacting with the VehicleEvent builders.
+	VehicleEvent *VehicleEventClient
+	// VehicleHistory is the client for interacting with the VehicleHistory builders.
+	VehicleHistory *VehicleHistoryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -35,7 +54,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Device = NewDeviceClient(c.config)
+	c.DriverAssignment = NewDriverAssignmentClient(c.config)
+	c.DriverProfile = NewDriverProfileClient(c.config)
+	c.Group = NewGroupClient(c.config)
 	c.Vehicle = NewVehicleClient(c.config)
+	c.VehicleEvent = NewVehicleEventClient(c.config)
+	c.VehicleHistory = NewVehicleHistoryClient(c.config)
 }
 
 type (
@@ -126,9 +151,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Vehicle: NewVehicleClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Device:           NewDeviceClient(cfg),
+		DriverAssignment: NewDriverAssignmentClient(cfg),
+		DriverProfile:    NewDriverProfileClient(cfg),
+		Group:            NewGroupClient(cfg),
+		Vehicle:          NewVehicleClient(cfg),
+		VehicleEvent:     NewVehicleEventClient(cfg),
+		VehicleHistory:   NewVehicleHistoryClient(cfg),
 	}, nil
 }
 
@@ -146,16 +177,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Vehicle: NewVehicleClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Device:           NewDeviceClient(cfg),
+		DriverAssignment: NewDriverAssignmentClient(cfg),
+		DriverProfile:    NewDriverProfileClient(cfg),
+		Group:            NewGroupClient(cfg),
+		Vehicle:          NewVehicleClient(cfg),
+		VehicleEvent:     NewVehicleEventClient(cfg),
+		VehicleHistory:   NewVehicleHistoryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Vehicle.
+//		Device.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -177,25 +214,691 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Vehicle.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Device, c.DriverAssignment, c.DriverProfile, c.Group, c.Vehicle,
+		c.VehicleEvent, c.VehicleHistory,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Vehicle.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Device, c.DriverAssignment, c.DriverProfile, c.Group, c.Vehicle,
+		c.VehicleEvent, c.VehicleHistory,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *DeviceMutation:
+		return c.Device.mutate(ctx, m)
+	case *DriverAssignmentMutation:
+		return c.DriverAssignment.mutate(ctx, m)
+	case *DriverProfileMutation:
+		return c.DriverProfile.mutate(ctx, m)
+	case *GroupMutation:
+		return c.Group.mutate(ctx, m)
 	case *VehicleMutation:
 		return c.Vehicle.mutate(ctx, m)
+	case *VehicleEventMutation:
+		return c.VehicleEvent.mutate(ctx, m)
+	case *VehicleHistoryMutation:
+		return c.VehicleHistory.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// DeviceClient is a client for the Device schema.
+type DeviceClient struct {
+	config
+}
+
+// NewDeviceClient returns a client for the Device from the given config.
+func NewDeviceClient(c config) *DeviceClient {
+	return &DeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `device.Hooks(f(g(h())))`.
+func (c *DeviceClient) Use(hooks ...Hook) {
+	c.hooks.Device = append(c.hooks.Device, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `device.Intercept(f(g(h())))`.
+func (c *DeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Device = append(c.inters.Device, interceptors...)
+}
+
+// Create returns a builder for creating a Device entity.
+func (c *DeviceClient) Create() *DeviceCreate {
+	mutation := newDeviceMutation(c.config, OpCreate)
+	return &DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Device entities.
+func (c *DeviceClient) CreateBulk(builders ...*DeviceCreate) *DeviceCreateBulk {
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceClient) MapCreateBulk(slice any, setFunc func(*DeviceCreate, int)) *DeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceCreateBulk{err: fmt.Errorf("calling to DeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Device.
+func (c *DeviceClient) Update() *DeviceUpdate {
+	mutation := newDeviceMutation(c.config, OpUpdate)
+	return &DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceClient) UpdateOne(_m *Device) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDevice(_m))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceClient) UpdateOneID(id int) *DeviceUpdateOne {
+	mutation := newDeviceMutation(c.config, OpUpdateOne, withDeviceID(id))
+	return &DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Device.
+func (c *DeviceClient) Delete() *DeviceDelete {
+	mutation := newDeviceMutation(c.config, OpDelete)
+	return &DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceClient) DeleteOne(_m *Device) *DeviceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceClient) DeleteOneID(id int) *DeviceDeleteOne {
+	builder := c.Delete().Where(device.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for Device.
+func (c *DeviceClient) Query() *DeviceQuery {
+	return &DeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Device entity by its id.
+func (c *DeviceClient) Get(ctx context.Context, id int) (*Device, error) {
+	return c.Query().Where(device.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceClient) GetX(ctx context.Context, id int) *Device {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVehicle queries the vehicle edge of a Device.
+func (c *DeviceClient) QueryVehicle(_m *Device) *VehicleQuery {
+	query := (&VehicleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(vehicle.Table, vehicle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.VehicleTable, device.VehicleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceClient) Hooks() []Hook {
+	return c.hooks.Device
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceClient) Interceptors() []Interceptor {
+	return c.inters.Device
+}
+
+func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Device mutation op: %q", m.Op())
+	}
+}
+
+// DriverAssignmentClient is a client for the DriverAssignment schema.
+type DriverAssignmentClient struct {
+	config
+}
+
+// NewDriverAssignmentClient returns a client for the DriverAssignment from the given config.
+func NewDriverAssignmentClient(c config) *DriverAssignmentClient {
+	return &DriverAssignmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `driverassignment.Hooks(f(g(h())))`.
+func (c *DriverAssignmentClient) Use(hooks ...Hook) {
+	c.hooks.DriverAssignment = append(c.hooks.DriverAssignment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `driverassignment.Intercept(f(g(h())))`.
+func (c *DriverAssignmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DriverAssignment = append(c.inters.DriverAssignment, interceptors...)
+}
+
+// Create returns a builder for creating a DriverAssignment entity.
+func (c *DriverAssignmentClient) Create() *DriverAssignmentCreate {
+	mutation := newDriverAssignmentMutation(c.config, OpCreate)
+	return &DriverAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DriverAssignment entities.
+func (c *DriverAssignmentClient) CreateBulk(builders ...*DriverAssignmentCreate) *DriverAssignmentCreateBulk {
+	return &DriverAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DriverAssignmentClient) MapCreateBulk(slice any, setFunc func(*DriverAssignmentCreate, int)) *DriverAssignmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DriverAssignmentCreateBulk{err: fmt.Errorf("calling to DriverAssignmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DriverAssignmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DriverAssignmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DriverAssignment.
+func (c *DriverAssignmentClient) Update() *DriverAssignmentUpdate {
+	mutation := newDriverAssignmentMutation(c.config, OpUpdate)
+	return &DriverAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DriverAssignmentClient) UpdateOne(_m *DriverAssignment) *DriverAssignmentUpdateOne {
+	mutation := newDriverAssignmentMutation(c.config, OpUpdateOne, withDriverAssignment(_m))
+	return &DriverAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DriverAssignmentClient) UpdateOneID(id int) *DriverAssignmentUpdateOne {
+	mutation := newDriverAssignmentMutation(c.config, OpUpdateOne, withDriverAssignmentID(id))
+	return &DriverAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DriverAssignment.
+func (c *DriverAssignmentClient) Delete() *DriverAssignmentDelete {
+	mutation := newDriverAssignmentMutation(c.config, OpDelete)
+	return &DriverAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DriverAssignmentClient) DeleteOne(_m *DriverAssignment) *DriverAssignmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DriverAssignmentClient) DeleteOneID(id int) *DriverAssignmentDeleteOne {
+	builder := c.Delete().Where(driverassignment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DriverAssignmentDeleteOne{builder}
+}
+
+// Query returns a query builder for DriverAssignment.
+func (c *DriverAssignmentClient) Query() *DriverAssignmentQuery {
+	return &DriverAssignmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDriverAssignment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DriverAssignment entity by its id.
+func (c *DriverAssignmentClient) Get(ctx context.Context, id int) (*DriverAssignment, error) {
+	return c.Query().Where(driverassignment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DriverAssignmentClient) GetX(ctx context.Context, id int) *DriverAssignment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDriver queries the driver edge of a DriverAssignment.
+func (c *DriverAssignmentClient) QueryDriver(_m *DriverAssignment) *DriverProfileQuery {
+	query := (&DriverProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driverassignment.Table, driverassignment.FieldID, id),
+			sqlgraph.To(driverprofile.Table, driverprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, driverassignment.DriverTable, driverassignment.DriverColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVehicle queries the vehicle edge of a DriverAssignment.
+func (c *DriverAssignmentClient) QueryVehicle(_m *DriverAssignment) *VehicleQuery {
+	query := (&VehicleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driverassignment.Table, driverassignment.FieldID, id),
+			sqlgraph.To(vehicle.Table, vehicle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, driverassignment.VehicleTable, driverassignment.VehicleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DriverAssignmentClient) Hooks() []Hook {
+	return c.hooks.DriverAssignment
+}
+
+// Interceptors returns the client interceptors.
+func (c *DriverAssignmentClient) Interceptors() []Interceptor {
+	return c.inters.DriverAssignment
+}
+
+func (c *DriverAssignmentClient) mutate(ctx context.Context, m *DriverAssignmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DriverAssignmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DriverAssignmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DriverAssignmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DriverAssignmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DriverAssignment mutation op: %q", m.Op())
+	}
+}
+
+// DriverProfileClient is a client for the DriverProfile schema.
+type DriverProfileClient struct {
+	config
+}
+
+// NewDriverProfileClient returns a client for the DriverProfile from the given config.
+func NewDriverProfileClient(c config) *DriverProfileClient {
+	return &DriverProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `driverprofile.Hooks(f(g(h())))`.
+func (c *DriverProfileClient) Use(hooks ...Hook) {
+	c.hooks.DriverProfile = append(c.hooks.DriverProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `driverprofile.Intercept(f(g(h())))`.
+func (c *DriverProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DriverProfile = append(c.inters.DriverProfile, interceptors...)
+}
+
+// Create returns a builder for creating a DriverProfile entity.
+func (c *DriverProfileClient) Create() *DriverProfileCreate {
+	mutation := newDriverProfileMutation(c.config, OpCreate)
+	return &DriverProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DriverProfile entities.
+func (c *DriverProfileClient) CreateBulk(builders ...*DriverProfileCreate) *DriverProfileCreateBulk {
+	return &DriverProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DriverProfileClient) MapCreateBulk(slice any, setFunc func(*DriverProfileCreate, int)) *DriverProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DriverProfileCreateBulk{err: fmt.Errorf("calling to DriverProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DriverProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DriverProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DriverProfile.
+func (c *DriverProfileClient) Update() *DriverProfileUpdate {
+	mutation := newDriverProfileMutation(c.config, OpUpdate)
+	return &DriverProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DriverProfileClient) UpdateOne(_m *DriverProfile) *DriverProfileUpdateOne {
+	mutation := newDriverProfileMutation(c.config, OpUpdateOne, withDriverProfile(_m))
+	return &DriverProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DriverProfileClient) UpdateOneID(id int) *DriverProfileUpdateOne {
+	mutation := newDriverProfileMutation(c.config, OpUpdateOne, withDriverProfileID(id))
+	return &DriverProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DriverProfile.
+func (c *DriverProfileClient) Delete() *DriverProfileDelete {
+	mutation := newDriverProfileMutation(c.config, OpDelete)
+	return &DriverProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DriverProfileClient) DeleteOne(_m *DriverProfile) *DriverProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DriverProfileClient) DeleteOneID(id int) *DriverProfileDeleteOne {
+	builder := c.Delete().Where(driverprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DriverProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for DriverProfile.
+func (c *DriverProfileClient) Query() *DriverProfileQuery {
+	return &DriverProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDriverProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DriverProfile entity by its id.
+func (c *DriverProfileClient) Get(ctx context.Context, id int) (*DriverProfile, error) {
+	return c.Query().Where(driverprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DriverProfileClient) GetX(ctx context.Context, id int) *DriverProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAssignments queries the assignments edge of a DriverProfile.
+func (c *DriverProfileClient) QueryAssignments(_m *DriverProfile) *DriverAssignmentQuery {
+	query := (&DriverAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driverprofile.Table, driverprofile.FieldID, id),
+			sqlgraph.To(driverassignment.Table, driverassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, driverprofile.AssignmentsTable, driverprofile.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DriverProfileClient) Hooks() []Hook {
+	return c.hooks.DriverProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *DriverProfileClient) Interceptors() []Interceptor {
+	return c.inters.DriverProfile
+}
+
+func (c *DriverProfileClient) mutate(ctx context.Context, m *DriverProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DriverProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DriverProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DriverProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DriverProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DriverProfile mutation op: %q", m.Op())
+	}
+}
+
+// GroupClient is a client for the Group schema.
+type GroupClient struct {
+	config
+}
+
+// NewGroupClient returns a client for the Group from the given config.
+func NewGroupClient(c config) *GroupClient {
+	return &GroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `group.Hooks(f(g(h())))`.
+func (c *GroupClient) Use(hooks ...Hook) {
+	c.hooks.Group = append(c.hooks.Group, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `group.Intercept(f(g(h())))`.
+func (c *GroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Group = append(c.inters.Group, interceptors...)
+}
+
+// Create returns a builder for creating a Group entity.
+func (c *GroupClient) Create() *GroupCreate {
+	mutation := newGroupMutation(c.config, OpCreate)
+	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GroupClient) MapCreateBulk(slice any, setFunc func(*GroupCreate, int)) *GroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GroupCreateBulk{err: fmt.Errorf("calling to GroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Group.
+func (c *GroupClient) Update() *GroupUpdate {
+	mutation := newGroupMutation(c.config, OpUpdate)
+	return &GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GroupClient) UpdateOne(_m *Group) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroup(_m))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
+	mutation := newGroupMutation(c.config, OpUpdateOne, withGroupID(id))
+	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Group.
+func (c *GroupClient) Delete() *GroupDelete {
+	mutation := newGroupMutation(c.config, OpDelete)
+	return &GroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GroupClient) DeleteOne(_m *Group) *GroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GroupClient) DeleteOneID(id int) *GroupDeleteOne {
+	builder := c.Delete().Where(group.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GroupDeleteOne{builder}
+}
+
+// Query returns a query builder for Group.
+func (c *GroupClient) Query() *GroupQuery {
+	return &GroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Group entity by its id.
+func (c *GroupClient) Get(ctx context.Context, id int) (*Group, error) {
+	return c.Query().Where(group.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVehicles queries the vehicles edge of a Group.
+func (c *GroupClient) QueryVehicles(_m *Group) *VehicleQuery {
+	query := (&VehicleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(vehicle.Table, vehicle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, group.VehiclesTable, group.VehiclesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Group.
+func (c *GroupClient) QueryParent(_m *Group) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, group.ParentTable, group.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Group.
+func (c *GroupClient) QueryChildren(_m *Group) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(group.Table, group.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, group.ChildrenTable, group.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GroupClient) Hooks() []Hook {
+	return c.hooks.Group
+}
+
+// Interceptors returns the client interceptors.
+func (c *GroupClient) Interceptors() []Interceptor {
+	return c.inters.Group
+}
+
+func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Group mutation op: %q", m.Op())
+	}
+}
+
 // VehicleClient is a client for the Vehicle schema.
 type VehicleClient struct {
 	config
@@ -304,14 +1007,64 @@ func (c *VehicleClient) GetX(ctx context.Context, id int) *Vehicle {
 	return obj
 }
 
+// QueryDevices queries the devices edge of a Vehicle.
+func (c *VehicleClient) QueryDevices(_m *Vehicle) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehicle.DevicesTable, vehicle.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Vehicle.
+func (c *VehicleClient) QueryGroups(_m *Vehicle) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, vehicle.GroupsTable, vehicle.GroupsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDriverAssignments queries the driver_assignments edge of a Vehicle.
+func (c *VehicleClient) QueryDriverAssignments(_m *Vehicle) *DriverAssignmentQuery {
+	query := (&DriverAssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, id),
+			sqlgraph.To(driverassignment.Table, driverassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehicle.DriverAssignmentsTable, vehicle.DriverAssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *VehicleClient) Hooks() []Hook {
-	return c.hooks.Vehicle
+	hooks := c.hooks.Vehicle
+	return append(hooks[:len(hooks):len(hooks)], vehicle.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *VehicleClient) Interceptors() []Interceptor {
-	return c.inters.Vehicle
+	inters := c.inters.Vehicle
+	return append(inters[:len(inters):len(inters)], vehicle.Interceptors[:]...)
 }
 
 func (c *VehicleClient) mutate(ctx context.Context, m *VehicleMutation) (Value, error) {
@@ -329,12 +1082,280 @@ func (c *VehicleClient) mutate(ctx context.Context, m *VehicleMutation) (Value,
 	}
 }
 
+// VehicleEventClient is a client for the VehicleEvent schema.
+type VehicleEventClient struct {
+	config
+}
+
+// NewVehicleEventClient returns a client for the VehicleEvent from the given config.
+func NewVehicleEventClient(c config) *VehicleEventClient {
+	return &VehicleEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehicleevent.Hooks(f(g(h())))`.
+func (c *VehicleEventClient) Use(hooks ...Hook) {
+	c.hooks.VehicleEvent = append(c.hooks.VehicleEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehicleevent.Intercept(f(g(h())))`.
+func (c *VehicleEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleEvent = append(c.inters.VehicleEvent, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleEvent entity.
+func (c *VehicleEventClient) Create() *VehicleEventCreate {
+	mutation := newVehicleEventMutation(c.config, OpCreate)
+	return &VehicleEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleEvent entities.
+func (c *VehicleEventClient) CreateBulk(builders ...*VehicleEventCreate) *VehicleEventCreateBulk {
+	return &VehicleEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleEventClient) MapCreateBulk(slice any, setFunc func(*VehicleEventCreate, int)) *VehicleEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleEventCreateBulk{err: fmt.Errorf("calling to VehicleEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleEvent.
+func (c *VehicleEventClient) Update() *VehicleEventUpdate {
+	mutation := newVehicleEventMutation(c.config, OpUpdate)
+	return &VehicleEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleEventClient) UpdateOne(_m *VehicleEvent) *VehicleEventUpdateOne {
+	mutation := newVehicleEventMutation(c.config, OpUpdateOne, withVehicleEvent(_m))
+	return &VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleEventClient) UpdateOneID(id int) *VehicleEventUpdateOne {
+	mutation := newVehicleEventMutation(c.config, OpUpdateOne, withVehicleEventID(id))
+	return &VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleEvent.
+func (c *VehicleEventClient) Delete() *VehicleEventDelete {
+	mutation := newVehicleEventMutation(c.config, OpDelete)
+	return &VehicleEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleEventClient) DeleteOne(_m *VehicleEvent) *VehicleEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleEventClient) DeleteOneID(id int) *VehicleEventDeleteOne {
+	builder := c.Delete().Where(vehicleevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleEventDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleEvent.
+func (c *VehicleEventClient) Query() *VehicleEventQuery {
+	return &VehicleEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleEvent entity by its id.
+func (c *VehicleEventClient) Get(ctx context.Context, id int) (*VehicleEvent, error) {
+	return c.Query().Where(vehicleevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleEventClient) GetX(ctx context.Context, id int) *VehicleEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleEventClient) Hooks() []Hook {
+	return c.hooks.VehicleEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleEventClient) Interceptors() []Interceptor {
+	return c.inters.VehicleEvent
+}
+
+func (c *VehicleEventClient) mutate(ctx context.Context, m *VehicleEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VehicleEvent mutation op: %q", m.Op())
+	}
+}
+
+// VehicleHistoryClient is a client for the VehicleHistory schema.
+type VehicleHistoryClient struct {
+	config
+}
+
+// NewVehicleHistoryClient returns a client for the VehicleHistory from the given config.
+func NewVehicleHistoryClient(c config) *VehicleHistoryClient {
+	return &VehicleHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `vehiclehistory.Hooks(f(g(h())))`.
+func (c *VehicleHistoryClient) Use(hooks ...Hook) {
+	c.hooks.VehicleHistory = append(c.hooks.VehicleHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `vehiclehistory.Intercept(f(g(h())))`.
+func (c *VehicleHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VehicleHistory = append(c.inters.VehicleHistory, interceptors...)
+}
+
+// Create returns a builder for creating a VehicleHistory entity.
+func (c *VehicleHistoryClient) Create() *VehicleHistoryCreate {
+	mutation := newVehicleHistoryMutation(c.config, OpCreate)
+	return &VehicleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VehicleHistory entities.
+func (c *VehicleHistoryClient) CreateBulk(builders ...*VehicleHistoryCreate) *VehicleHistoryCreateBulk {
+	return &VehicleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VehicleHistoryClient) MapCreateBulk(slice any, setFunc func(*VehicleHistoryCreate, int)) *VehicleHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VehicleHistoryCreateBulk{err: fmt.Errorf("calling to VehicleHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VehicleHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VehicleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VehicleHistory.
+func (c *VehicleHistoryClient) Update() *VehicleHistoryUpdate {
+	mutation := newVehicleHistoryMutation(c.config, OpUpdate)
+	return &VehicleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VehicleHistoryClient) UpdateOne(_m *VehicleHistory) *VehicleHistoryUpdateOne {
+	mutation := newVehicleHistoryMutation(c.config, OpUpdateOne, withVehicleHistory(_m))
+	return &VehicleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VehicleHistoryClient) UpdateOneID(id int) *VehicleHistoryUpdateOne {
+	mutation := newVehicleHistoryMutation(c.config, OpUpdateOne, withVehicleHistoryID(id))
+	return &VehicleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VehicleHistory.
+func (c *VehicleHistoryClient) Delete() *VehicleHistoryDelete {
+	mutation := newVehicleHistoryMutation(c.config, OpDelete)
+	return &VehicleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VehicleHistoryClient) DeleteOne(_m *VehicleHistory) *VehicleHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VehicleHistoryClient) DeleteOneID(id int) *VehicleHistoryDeleteOne {
+	builder := c.Delete().Where(vehiclehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VehicleHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for VehicleHistory.
+func (c *VehicleHistoryClient) Query() *VehicleHistoryQuery {
+	return &VehicleHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVehicleHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VehicleHistory entity by its id.
+func (c *VehicleHistoryClient) Get(ctx context.Context, id int) (*VehicleHistory, error) {
+	return c.Query().Where(vehiclehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VehicleHistoryClient) GetX(ctx context.Context, id int) *VehicleHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *VehicleHistoryClient) Hooks() []Hook {
+	return c.hooks.VehicleHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *VehicleHistoryClient) Interceptors() []Interceptor {
+	return c.inters.VehicleHistory
+}
+
+func (c *VehicleHistoryClient) mutate(ctx context.Context, m *VehicleHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&VehicleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&VehicleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&VehicleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&VehicleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown VehicleHistory mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Vehicle []ent.Hook
+		Device, DriverAssignment, DriverProfile, Group, Vehicle, VehicleEvent,
+		VehicleHistory []ent.Hook
 	}
 	inters struct {
-		Vehicle []ent.Interceptor
+		Device, DriverAssignment, DriverProfile, Group, Vehicle, VehicleEvent,
+		VehicleHistory []ent.Interceptor
 	}
 )
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+)
+
+// DeviceUpdate is the builder for updating Device entities.
+type DeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (_u *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *DeviceUpdate) SetPrefix(v string) *DeviceUpdate {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillablePrefix(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetCredentialHash sets the "credential_hash" field.
+func (_u *DeviceUpdate) SetCredentialHash(v string) *DeviceUpdate {
+	_u.mutation.SetCredentialHash(v)
+	return _u
+}
+
+// SetNillableCredentialHash sets the "credential_hash" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableCredentialHash(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetCredentialHash(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeviceUpdate) SetName(v string) *DeviceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableName(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DeviceUpdate) ClearName() *DeviceUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *DeviceUpdate) SetVehicleID(v int) *DeviceUpdate {
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableVehicleID(v *int) *DeviceUpdate {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *DeviceUpdate) SetLastSeenAt(v time.Time) *DeviceUpdate {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableLastSeenAt(v *time.Time) *DeviceUpdate {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *DeviceUpdate) ClearLastSeenAt() *DeviceUpdate {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *DeviceUpdate) SetRevokedAt(v time.Time) *DeviceUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableRevokedAt(v *time.Time) *DeviceUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *DeviceUpdate) ClearRevokedAt() *DeviceUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetVehicle sets the "vehicle" edge to the Vehicle entity.
+func (_u *DeviceUpdate) SetVehicle(v *Vehicle) *DeviceUpdate {
+	return _u.SetVehicleID(v.ID)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (_u *DeviceUpdate) Mutation() *DeviceMutation {
+	return _u.mutation
+}
+
+// ClearVehicle clears the "vehicle" edge to the Vehicle entity.
+func (_u *DeviceUpdate) ClearVehicle() *DeviceUpdate {
+	_u.mutation.ClearVehicle()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceUpdate) check() error {
+	if v, ok := _u.mutation.Prefix(); ok {
+		if err := device.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "Device.prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialHash(); ok {
+		if err := device.CredentialHashValidator(v); err != nil {
+			return &ValidationError{Name: "credential_hash", err: fmt.Errorf(`ent: validator failed for field "Device.credential_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.VehicleCleared() && len(_u.mutation.VehicleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Device.vehicle"`)
+	}
+	return nil
+}
+
+func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(device.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialHash(); ok {
+		_spec.SetField(device.FieldCredentialHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(device.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(device.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(device.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.VehicleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.VehicleTable,
+			Columns: []string{device.VehicleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VehicleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.VehicleTable,
+			Columns: []string{device.VehicleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceMutation
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *DeviceUpdateOne) SetPrefix(v string) *DeviceUpdateOne {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillablePrefix(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetCredentialHash sets the "credential_hash" field.
+func (_u *DeviceUpdateOne) SetCredentialHash(v string) *DeviceUpdateOne {
+	_u.mutation.SetCredentialHash(v)
+	return _u
+}
+
+// SetNillableCredentialHash sets the "credential_hash" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableCredentialHash(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetCredentialHash(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DeviceUpdateOne) SetName(v string) *DeviceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableName(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *DeviceUpdateOne) ClearName() *DeviceUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_u *DeviceUpdateOne) SetVehicleID(v int) *DeviceUpdateOne {
+	_u.mutation.SetVehicleID(v)
+	return _u
+}
+
+// SetNillableVehicleID sets the "vehicle_id" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableVehicleID(v *int) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetVehicleID(*v)
+	}
+	return _u
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_u *DeviceUpdateOne) SetLastSeenAt(v time.Time) *DeviceUpdateOne {
+	_u.mutation.SetLastSeenAt(v)
+	return _u
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableLastSeenAt(v *time.Time) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetLastSeenAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (_u *DeviceUpdateOne) ClearLastSeenAt() *DeviceUpdateOne {
+	_u.mutation.ClearLastSeenAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *DeviceUpdateOne) SetRevokedAt(v time.Time) *DeviceUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableRevokedAt(v *time.Time) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *DeviceUpdateOne) ClearRevokedAt() *DeviceUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetVehicle sets the "vehicle" edge to the Vehicle entity.
+func (_u *DeviceUpdateOne) SetVehicle(v *Vehicle) *DeviceUpdateOne {
+	return _u.SetVehicleID(v.ID)
+}
+
+// Mutation returns the DeviceMutation object of the builder.
+func (_u *DeviceUpdateOne) Mutation() *DeviceMutation {
+	return _u.mutation
+}
+
+// ClearVehicle clears the "vehicle" edge to the Vehicle entity.
+func (_u *DeviceUpdateOne) ClearVehicle() *DeviceUpdateOne {
+	_u.mutation.ClearVehicle()
+	return _u
+}
+
+// Where appends a list predicates to the DeviceUpdate builder.
+func (_u *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Device entity.
+func (_u *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceUpdateOne) SaveX(ctx context.Context) *Device {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeviceUpdateOne) check() error {
+	if v, ok := _u.mutation.Prefix(); ok {
+		if err := device.PrefixValidator(v); err != nil {
+			return &ValidationError{Name: "prefix", err: fmt.Errorf(`ent: validator failed for field "Device.prefix": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CredentialHash(); ok {
+		if err := device.CredentialHashValidator(v); err != nil {
+			return &ValidationError{Name: "credential_hash", err: fmt.Errorf(`ent: validator failed for field "Device.credential_hash": %w`, err)}
+		}
+	}
+	if _u.mutation.VehicleCleared() && len(_u.mutation.VehicleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Device.vehicle"`)
+	}
+	return nil
+}
+
+func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(device.Table, device.Columns, sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Device.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for _, f := range fields {
+			if !device.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(device.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CredentialHash(); ok {
+		_spec.SetField(device.FieldCredentialHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(device.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(device.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSeenAt(); ok {
+		_spec.SetField(device.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenAtCleared() {
+		_spec.ClearField(device.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(device.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(device.FieldRevokedAt, field.TypeTime)
+	}
+	if _u.mutation.VehicleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.VehicleTable,
+			Columns: []string{device.VehicleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VehicleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.VehicleTable,
+			Columns: []string{device.VehicleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Device{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
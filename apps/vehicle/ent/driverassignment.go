@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+)
+
+// DriverAssignment is the model entity for the DriverAssignment schema.
+type DriverAssignment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DriverID holds the value of the "driver_id" field.
+	DriverID int `json:"driver_id,omitempty"`
+	// VehicleID holds the value of the "vehicle_id" field.
+	VehicleID int `json:"vehicle_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// EndedAt holds the value of the "ended_at" field.
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// AssignedBy holds the value of the "assigned_by" field.
+	AssignedBy string `json:"assigned_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DriverAssignmentQuery when eager-loading is set.
+	Edges        DriverAssignmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DriverAssignmentEdges holds the relations/edges for other nodes in the graph.
+type DriverAssignmentEdges struct {
+	// Driver holds the value of the driver edge.
+	Driver *DriverProfile `json:"driver,omitempty"`
+	// Vehicle holds the value of the vehicle edge.
+	Vehicle *Vehicle `json:"vehicle,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// DriverOrErr returns the Driver value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DriverAssignmentEdges) DriverOrErr() (*DriverProfile, error) {
+	if e.Driver != nil {
+		return e.Driver, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: driverprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "driver"}
+}
+
+// VehicleOrErr returns the Vehicle value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DriverAssignmentEdges) VehicleOrErr() (*Vehicle, error) {
+	if e.Vehicle != nil {
+		return e.Vehicle, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: vehicle.Label}
+	}
+	return nil, &NotLoadedError{edge: "vehicle"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DriverAssignment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case driverassignment.FieldID, driverassignment.FieldDriverID, driverassignment.FieldVehicleID:
+			values[i] = new(sql.NullInt64)
+		case driverassignment.FieldAssignedBy:
+			values[i] = new(sql.NullString)
+		case driverassignment.FieldStartedAt, driverassignment.FieldEndedAt, driverassignment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DriverAssignment fields.
+func (_m *DriverAssignment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case driverassignment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case driverassignment.FieldDriverID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field driver_id", values[i])
+			} else if value.Valid {
+				_m.DriverID = int(value.Int64)
+			}
+		case driverassignment.FieldVehicleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field vehicle_id", values[i])
+			} else if value.Valid {
+				_m.VehicleID = int(value.Int64)
+			}
+		case driverassignment.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case driverassignment.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case driverassignment.FieldAssignedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_by", values[i])
+			} else if value.Valid {
+				_m.AssignedBy = value.String
+			}
+		case driverassignment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DriverAssignment.
+// This includes values selected through modifiers, order, etc.
+func (_m *DriverAssignment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDriver queries the "driver" edge of the DriverAssignment entity.
+func (_m *DriverAssignment) QueryDriver() *DriverProfileQuery {
+	return NewDriverAssignmentClient(_m.config).QueryDriver(_m)
+}
+
+// QueryVehicle queries the "vehicle" edge of the DriverAssignment entity.
+func (_m *DriverAssignment) QueryVehicle() *VehicleQuery {
+	return NewDriverAssignmentClient(_m.config).QueryVehicle(_m)
+}
+
+// Update returns a builder for updating this DriverAssignment.
+// Note that you need to call DriverAssignment.Unwrap() before calling this method if this DriverAssignment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DriverAssignment) Update() *DriverAssignmentUpdateOne {
+	return NewDriverAssignmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DriverAssignment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DriverAssignment) Unwrap() *DriverAssignment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DriverAssignment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DriverAssignment) String() string {
+	var builder strings.Builder
+	builder.WriteString("DriverAssignment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("driver_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DriverID))
+	builder.WriteString(", ")
+	builder.WriteString("vehicle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VehicleID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("assigned_by=")
+	builder.WriteString(_m.AssignedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DriverAssignments is a parsable slice of DriverAssignment.
+type DriverAssignments []*DriverAssignment
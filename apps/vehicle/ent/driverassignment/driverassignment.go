@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package driverassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the driverassignment type in the database.
+	Label = "driver_assignment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDriverID holds the string denoting the driver_id field in the database.
+	FieldDriverID = "driver_id"
+	// FieldVehicleID holds the string denoting the vehicle_id field in the database.
+	FieldVehicleID = "vehicle_id"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// FieldAssignedBy holds the string denoting the assigned_by field in the database.
+	FieldAssignedBy = "assigned_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeDriver holds the string denoting the driver edge name in mutations.
+	EdgeDriver = "driver"
+	// EdgeVehicle holds the string denoting the vehicle edge name in mutations.
+	EdgeVehicle = "vehicle"
+	// Table holds the table name of the driverassignment in the database.
+	Table = "driver_assignments"
+	// DriverTable is the table that holds the driver relation/edge.
+	DriverTable = "driver_assignments"
+	// DriverInverseTable is the table name for the DriverProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "driverprofile" package.
+	DriverInverseTable = "drivers"
+	// DriverColumn is the table column denoting the driver relation/edge.
+	DriverColumn = "driver_id"
+	// VehicleTable is the table that holds the vehicle relation/edge.
+	VehicleTable = "driver_assignments"
+	// VehicleInverseTable is the table name for the Vehicle entity.
+	// It exists in this package in order to avoid circular dependency with the "vehicle" package.
+	VehicleInverseTable = "vehicles"
+	// VehicleColumn is the table column denoting the vehicle relation/edge.
+	VehicleColumn = "vehicle_id"
+)
+
+// Columns holds all SQL columns for driverassignment fields.
+var Columns = []string{
+	FieldID,
+	FieldDriverID,
+	FieldVehicleID,
+	FieldStartedAt,
+	FieldEndedAt,
+	FieldAssignedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DriverAssignment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDriverID orders the results by the driver_id field.
+func ByDriverID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDriverID, opts...).ToFunc()
+}
+
+// ByVehicleID orders the results by the vehicle_id field.
+func ByVehicleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVehicleID, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByAssignedBy orders the results by the assigned_by field.
+func ByAssignedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDriverField orders the results by driver field.
+func ByDriverField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDriverStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByVehicleField orders the results by vehicle field.
+func ByVehicleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVehicleStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDriverStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DriverInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DriverTable, DriverColumn),
+	)
+}
+func newVehicleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VehicleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VehicleTable, VehicleColumn),
+	)
+}
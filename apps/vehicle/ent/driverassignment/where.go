@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package driverassignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLTE(FieldID, id))
+}
+
+// DriverID applies equality check predicate on the "driver_id" field. It's identical to DriverIDEQ.
+func DriverID(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldDriverID, v))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// AssignedBy applies equality check predicate on the "assigned_by" field. It's identical to AssignedByEQ.
+func AssignedBy(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldAssignedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DriverIDEQ applies the EQ predicate on the "driver_id" field.
+func DriverIDEQ(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldDriverID, v))
+}
+
+// DriverIDNEQ applies the NEQ predicate on the "driver_id" field.
+func DriverIDNEQ(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldDriverID, v))
+}
+
+// DriverIDIn applies the In predicate on the "driver_id" field.
+func DriverIDIn(vs ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldDriverID, vs...))
+}
+
+// DriverIDNotIn applies the NotIn predicate on the "driver_id" field.
+func DriverIDNotIn(vs ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldDriverID, vs...))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotNull(FieldEndedAt))
+}
+
+// AssignedByEQ applies the EQ predicate on the "assigned_by" field.
+func AssignedByEQ(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldAssignedBy, v))
+}
+
+// AssignedByNEQ applies the NEQ predicate on the "assigned_by" field.
+func AssignedByNEQ(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldAssignedBy, v))
+}
+
+// AssignedByIn applies the In predicate on the "assigned_by" field.
+func AssignedByIn(vs ...string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldAssignedBy, vs...))
+}
+
+// AssignedByNotIn applies the NotIn predicate on the "assigned_by" field.
+func AssignedByNotIn(vs ...string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldAssignedBy, vs...))
+}
+
+// AssignedByGT applies the GT predicate on the "assigned_by" field.
+func AssignedByGT(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGT(FieldAssignedBy, v))
+}
+
+// AssignedByGTE applies the GTE predicate on the "assigned_by" field.
+func AssignedByGTE(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGTE(FieldAssignedBy, v))
+}
+
+// AssignedByLT applies the LT predicate on the "assigned_by" field.
+func AssignedByLT(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLT(FieldAssignedBy, v))
+}
+
+// AssignedByLTE applies the LTE predicate on the "assigned_by" field.
+func AssignedByLTE(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLTE(FieldAssignedBy, v))
+}
+
+// AssignedByContains applies the Contains predicate on the "assigned_by" field.
+func AssignedByContains(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldContains(FieldAssignedBy, v))
+}
+
+// AssignedByHasPrefix applies the HasPrefix predicate on the "assigned_by" field.
+func AssignedByHasPrefix(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldHasPrefix(FieldAssignedBy, v))
+}
+
+// AssignedByHasSuffix applies the HasSuffix predicate on the "assigned_by" field.
+func AssignedByHasSuffix(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldHasSuffix(FieldAssignedBy, v))
+}
+
+// AssignedByEqualFold applies the EqualFold predicate on the "assigned_by" field.
+func AssignedByEqualFold(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEqualFold(FieldAssignedBy, v))
+}
+
+// AssignedByContainsFold applies the ContainsFold predicate on the "assigned_by" field.
+func AssignedByContainsFold(v string) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldContainsFold(FieldAssignedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasDriver applies the HasEdge predicate on the "driver" edge.
+func HasDriver() predicate.DriverAssignment {
+	return predicate.DriverAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DriverTable, DriverColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDriverWith applies the HasEdge predicate on the "driver" edge with a given conditions (other predicates).
+func HasDriverWith(preds ...predicate.DriverProfile) predicate.DriverAssignment {
+	return predicate.DriverAssignment(func(s *sql.Selector) {
+		step := newDriverStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVehicle applies the HasEdge predicate on the "vehicle" edge.
+func HasVehicle() predicate.DriverAssignment {
+	return predicate.DriverAssignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VehicleTable, VehicleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVehicleWith applies the HasEdge predicate on the "vehicle" edge with a given conditions (other predicates).
+func HasVehicleWith(preds ...predicate.Vehicle) predicate.DriverAssignment {
+	return predicate.DriverAssignment(func(s *sql.Selector) {
+		step := newVehicleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DriverAssignment) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DriverAssignment) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DriverAssignment) predicate.DriverAssignment {
+	return predicate.DriverAssignment(sql.NotPredicates(p))
+}
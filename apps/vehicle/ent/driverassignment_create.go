@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+)
+
+// DriverAssignmentCreate is the builder for creating a DriverAssignment entity.
+type DriverAssignmentCreate struct {
+	config
+	mutation *DriverAssignmentMutation
+	hooks    []Hook
+}
+
+// SetDriverID sets the "driver_id" field.
+func (_c *DriverAssignmentCreate) SetDriverID(v int) *DriverAssignmentCreate {
+	_c.mutation.SetDriverID(v)
+	return _c
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *DriverAssignmentCreate) SetVehicleID(v int) *DriverAssignmentCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *DriverAssignmentCreate) SetStartedAt(v time.Time) *DriverAssignmentCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *DriverAssignmentCreate) SetEndedAt(v time.Time) *DriverAssignmentCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *DriverAssignmentCreate) SetNillableEndedAt(v *time.Time) *DriverAssignmentCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (_c *DriverAssignmentCreate) SetAssignedBy(v string) *DriverAssignmentCreate {
+	_c.mutation.SetAssignedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DriverAssignmentCreate) SetCreatedAt(v time.Time) *DriverAssignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DriverAssignmentCreate) SetNillableCreatedAt(v *time.Time) *DriverAssignmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDriver sets the "driver" edge to the DriverProfile entity.
+func (_c *DriverAssignmentCreate) SetDriver(v *DriverProfile) *DriverAssignmentCreate {
+	return _c.SetDriverID(v.ID)
+}
+
+// SetVehicle sets the "vehicle" edge to the Vehicle entity.
+func (_c *DriverAssignmentCreate) SetVehicle(v *Vehicle) *DriverAssignmentCreate {
+	return _c.SetVehicleID(v.ID)
+}
+
+// Mutation returns the DriverAssignmentMutation object of the builder.
+func (_c *DriverAssignmentCreate) Mutation() *DriverAssignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the DriverAssignment in the database.
+func (_c *DriverAssignmentCreate) Save(ctx context.Context) (*DriverAssignment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DriverAssignmentCreate) SaveX(ctx context.Context) *DriverAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverAssignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverAssignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DriverAssignmentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := driverassignment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DriverAssignmentCreate) check() error {
+	if _, ok := _c.mutation.DriverID(); !ok {
+		return &ValidationError{Name: "driver_id", err: errors.New(`ent: missing required field "DriverAssignment.driver_id"`)}
+	}
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "DriverAssignment.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "DriverAssignment.started_at"`)}
+	}
+	if _, ok := _c.mutation.AssignedBy(); !ok {
+		return &ValidationError{Name: "assigned_by", err: errors.New(`ent: missing required field "DriverAssignment.assigned_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DriverAssignment.created_at"`)}
+	}
+	if len(_c.mutation.DriverIDs()) == 0 {
+		return &ValidationError{Name: "driver", err: errors.New(`ent: missing required edge "DriverAssignment.driver"`)}
+	}
+	if len(_c.mutation.VehicleIDs()) == 0 {
+		return &ValidationError{Name: "vehicle", err: errors.New(`ent: missing required edge "DriverAssignment.vehicle"`)}
+	}
+	return nil
+}
+
+func (_c *DriverAssignmentCreate) sqlSave(ctx context.Context) (*DriverAssignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DriverAssignmentCreate) createSpec() (*DriverAssignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DriverAssignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(driverassignment.Table, sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(driverassignment.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(driverassignment.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if value, ok := _c.mutation.AssignedBy(); ok {
+		_spec.SetField(driverassignment.FieldAssignedBy, field.TypeString, value)
+		_node.AssignedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(driverassignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.DriverIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   driverassignment.DriverTable,
+			Columns: []string{driverassignment.DriverColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DriverID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VehicleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   driverassignment.VehicleTable,
+			Columns: []string{driverassignment.VehicleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VehicleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DriverAssignmentCreateBulk is the builder for creating many DriverAssignment entities in bulk.
+type DriverAssignmentCreateBulk struct {
+	config
+	err      error
+	builders []*DriverAssignmentCreate
+}
+
+// Save creates the DriverAssignment entities in the database.
+func (_c *DriverAssignmentCreateBulk) Save(ctx context.Context) ([]*DriverAssignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DriverAssignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DriverAssignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DriverAssignmentCreateBulk) SaveX(ctx context.Context) []*DriverAssignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverAssignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverAssignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+)
+
+// DriverAssignmentQuery is the builder for querying DriverAssignment entities.
+type DriverAssignmentQuery struct {
+	config
+	ctx         *QueryContext
+	order       []driverassignment.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.DriverAssignment
+	withDriver  *DriverProfileQuery
+	withVehicle *VehicleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DriverAssignmentQuery builder.
+func (_q *DriverAssignmentQuery) Where(ps ...predicate.DriverAssignment) *DriverAssignmentQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DriverAssignmentQuery) Limit(limit int) *DriverAssignmentQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DriverAssignmentQuery) Offset(offset int) *DriverAssignmentQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DriverAssignmentQuery) Unique(unique bool) *DriverAssignmentQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DriverAssignmentQuery) Order(o ...driverassignment.OrderOption) *DriverAssignmentQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryDriver chains the current query on the "driver" edge.
+func (_q *DriverAssignmentQuery) QueryDriver() *DriverProfileQuery {
+	query := (&DriverProfileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driverassignment.Table, driverassignment.FieldID, selector),
+			sqlgraph.To(driverprofile.Table, driverprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, driverassignment.DriverTable, driverassignment.DriverColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVehicle chains the current query on the "vehicle" edge.
+func (_q *DriverAssignmentQuery) QueryVehicle() *VehicleQuery {
+	query := (&VehicleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driverassignment.Table, driverassignment.FieldID, selector),
+			sqlgraph.To(vehicle.Table, vehicle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, driverassignment.VehicleTable, driverassignment.VehicleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DriverAssignment entity from the query.
+// Returns a *NotFoundError when no DriverAssignment was found.
+func (_q *DriverAssignmentQuery) First(ctx context.Context) (*DriverAssignment, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{driverassignment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) FirstX(ctx context.Context) *DriverAssignment {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DriverAssignment ID from the query.
+// Returns a *NotFoundError when no DriverAssignment ID was found.
+func (_q *DriverAssignmentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{driverassignment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DriverAssignment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DriverAssignment entity is found.
+// Returns a *NotFoundError when no DriverAssignment entities are found.
+func (_q *DriverAssignmentQuery) Only(ctx context.Context) (*DriverAssignment, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{driverassignment.Label}
+	default:
+		return nil, &NotSingularError{driverassignment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) OnlyX(ctx context.Context) *DriverAssignment {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DriverAssignment ID in the query.
+// Returns a *NotSingularError when more than one DriverAssignment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DriverAssignmentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{driverassignment.Label}
+	default:
+		err = &NotSingularError{driverassignment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DriverAssignments.
+func (_q *DriverAssignmentQuery) All(ctx context.Context) ([]*DriverAssignment, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DriverAssignment, *DriverAssignmentQuery]()
+	return withInterceptors[[]*DriverAssignment](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) AllX(ctx context.Context) []*DriverAssignment {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DriverAssignment IDs.
+func (_q *DriverAssignmentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(driverassignment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DriverAssignmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DriverAssignmentQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DriverAssignmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DriverAssignmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DriverAssignmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DriverAssignmentQuery) Clone() *DriverAssignmentQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DriverAssignmentQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]driverassignment.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.DriverAssignment{}, _q.predicates...),
+		withDriver:  _q.withDriver.Clone(),
+		withVehicle: _q.withVehicle.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithDriver tells the query-builder to eager-load the nodes that are connected to
+// the "driver" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DriverAssignmentQuery) WithDriver(opts ...func(*DriverProfileQuery)) *DriverAssignmentQuery {
+	query := (&DriverProfileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDriver = query
+	return _q
+}
+
+// WithVehicle tells the query-builder to eager-load the nodes that are connected to
+// the "vehicle" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DriverAssignmentQuery) WithVehicle(opts ...func(*VehicleQuery)) *DriverAssignmentQuery {
+	query := (&VehicleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVehicle = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DriverID int `json:"driver_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DriverAssignment.Query().
+//		GroupBy(driverassignment.FieldDriverID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *DriverAssignmentQuery) GroupBy(field string, fields ...string) *DriverAssignmentGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DriverAssignmentGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = driverassignment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DriverID int `json:"driver_id,omitempty"`
+//	}
+//
+//	client.DriverAssignment.Query().
+//		Select(driverassignment.FieldDriverID).
+//		Scan(ctx, &v)
+func (_q *DriverAssignmentQuery) Select(fields ...string) *DriverAssignmentSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DriverAssignmentSelect{DriverAssignmentQuery: _q}
+	sbuild.label = driverassignment.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DriverAssignmentSelect configured with the given aggregations.
+func (_q *DriverAssignmentQuery) Aggregate(fns ...AggregateFunc) *DriverAssignmentSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DriverAssignmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !driverassignment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *DriverAssignmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DriverAssignment, error) {
+	var (
+		nodes       = []*DriverAssignment{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withDriver != nil,
+			_q.withVehicle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DriverAssignment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DriverAssignment{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withDriver; query != nil {
+		if err := _q.loadDriver(ctx, query, nodes, nil,
+			func(n *DriverAssignment, e *DriverProfile) { n.Edges.Driver = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVehicle; query != nil {
+		if err := _q.loadVehicle(ctx, query, nodes, nil,
+			func(n *DriverAssignment, e *Vehicle) { n.Edges.Vehicle = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *DriverAssignmentQuery) loadDriver(ctx context.Context, query *DriverProfileQuery, nodes []*DriverAssignment, init func(*DriverAssignment), assign func(*DriverAssignment, *DriverProfile)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DriverAssignment)
+	for i := range nodes {
+		fk := nodes[i].DriverID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(driverprofile.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "driver_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *DriverAssignmentQuery) loadVehicle(ctx context.Context, query *VehicleQuery, nodes []*DriverAssignment, init func(*DriverAssignment), assign func(*DriverAssignment, *Vehicle)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DriverAssignment)
+	for i := range nodes {
+		fk := nodes[i].VehicleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(vehicle.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "vehicle_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *DriverAssignmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DriverAssignmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(driverassignment.Table, driverassignment.Columns, sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, driverassignment.FieldID)
+		for i := range fields {
+			if fields[i] != driverassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withDriver != nil {
+			_spec.Node.AddColumnOnce(driverassignment.FieldDriverID)
+		}
+		if _q.withVehicle != nil {
+			_spec.Node.AddColumnOnce(driverassignment.FieldVehicleID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DriverAssignmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(driverassignment.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = driverassignment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DriverAssignmentGroupBy is the group-by builder for DriverAssignment entities.
+type DriverAssignmentGroupBy struct {
+	selector
+	build *DriverAssignmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DriverAssignmentGroupBy) Aggregate(fns ...AggregateFunc) *DriverAssignmentGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DriverAssignmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DriverAssignmentQuery, *DriverAssignmentGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DriverAssignmentGroupBy) sqlScan(ctx context.Context, root *DriverAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DriverAssignmentSelect is the builder for selecting fields of DriverAssignment entities.
+type DriverAssignmentSelect struct {
+	*DriverAssignmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DriverAssignmentSelect) Aggregate(fns ...AggregateFunc) *DriverAssignmentSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DriverAssignmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DriverAssignmentQuery, *DriverAssignmentSelect](ctx, _s.DriverAssignmentQuery, _s, _s.inters, v)
+}
+
+func (_s *DriverAssignmentSelect) sqlScan(ctx context.Context, root *DriverAssignmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
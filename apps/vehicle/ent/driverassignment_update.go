@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// DriverAssignmentUpdate is the builder for updating DriverAssignment entities.
+type DriverAssignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DriverAssignmentMutation
+}
+
+// Where appends a list predicates to the DriverAssignmentUpdate builder.
+func (_u *DriverAssignmentUpdate) Where(ps ...predicate.DriverAssignment) *DriverAssignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *DriverAssignmentUpdate) SetEndedAt(v time.Time) *DriverAssignmentUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *DriverAssignmentUpdate) SetNillableEndedAt(v *time.Time) *DriverAssignmentUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *DriverAssignmentUpdate) ClearEndedAt() *DriverAssignmentUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the DriverAssignmentMutation object of the builder.
+func (_u *DriverAssignmentUpdate) Mutation() *DriverAssignmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DriverAssignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverAssignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DriverAssignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverAssignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverAssignmentUpdate) check() error {
+	if _u.mutation.DriverCleared() && len(_u.mutation.DriverIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriverAssignment.driver"`)
+	}
+	if _u.mutation.VehicleCleared() && len(_u.mutation.VehicleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriverAssignment.vehicle"`)
+	}
+	return nil
+}
+
+func (_u *DriverAssignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driverassignment.Table, driverassignment.Columns, sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(driverassignment.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(driverassignment.FieldEndedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driverassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DriverAssignmentUpdateOne is the builder for updating a single DriverAssignment entity.
+type DriverAssignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DriverAssignmentMutation
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *DriverAssignmentUpdateOne) SetEndedAt(v time.Time) *DriverAssignmentUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *DriverAssignmentUpdateOne) SetNillableEndedAt(v *time.Time) *DriverAssignmentUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *DriverAssignmentUpdateOne) ClearEndedAt() *DriverAssignmentUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// Mutation returns the DriverAssignmentMutation object of the builder.
+func (_u *DriverAssignmentUpdateOne) Mutation() *DriverAssignmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DriverAssignmentUpdate builder.
+func (_u *DriverAssignmentUpdateOne) Where(ps ...predicate.DriverAssignment) *DriverAssignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DriverAssignmentUpdateOne) Select(field string, fields ...string) *DriverAssignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DriverAssignment entity.
+func (_u *DriverAssignmentUpdateOne) Save(ctx context.Context) (*DriverAssignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverAssignmentUpdateOne) SaveX(ctx context.Context) *DriverAssignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DriverAssignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverAssignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverAssignmentUpdateOne) check() error {
+	if _u.mutation.DriverCleared() && len(_u.mutation.DriverIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriverAssignment.driver"`)
+	}
+	if _u.mutation.VehicleCleared() && len(_u.mutation.VehicleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriverAssignment.vehicle"`)
+	}
+	return nil
+}
+
+func (_u *DriverAssignmentUpdateOne) sqlSave(ctx context.Context) (_node *DriverAssignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driverassignment.Table, driverassignment.Columns, sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DriverAssignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, driverassignment.FieldID)
+		for _, f := range fields {
+			if !driverassignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != driverassignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(driverassignment.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(driverassignment.FieldEndedAt, field.TypeTime)
+	}
+	_node = &DriverAssignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driverassignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,504 @@
+// Code generated by ent, DO NOT EDIT.
+
+package driverprofile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldName, v))
+}
+
+// LicenseNumber applies equality check predicate on the "license_number" field. It's identical to LicenseNumberEQ.
+func LicenseNumber(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldLicenseNumber, v))
+}
+
+// LicenseExpiry applies equality check predicate on the "license_expiry" field. It's identical to LicenseExpiryEQ.
+func LicenseExpiry(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldLicenseExpiry, v))
+}
+
+// Phone applies equality check predicate on the "phone" field. It's identical to PhoneEQ.
+func Phone(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldPhone, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContainsFold(FieldName, v))
+}
+
+// LicenseNumberEQ applies the EQ predicate on the "license_number" field.
+func LicenseNumberEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldLicenseNumber, v))
+}
+
+// LicenseNumberNEQ applies the NEQ predicate on the "license_number" field.
+func LicenseNumberNEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldLicenseNumber, v))
+}
+
+// LicenseNumberIn applies the In predicate on the "license_number" field.
+func LicenseNumberIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldLicenseNumber, vs...))
+}
+
+// LicenseNumberNotIn applies the NotIn predicate on the "license_number" field.
+func LicenseNumberNotIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldLicenseNumber, vs...))
+}
+
+// LicenseNumberGT applies the GT predicate on the "license_number" field.
+func LicenseNumberGT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldLicenseNumber, v))
+}
+
+// LicenseNumberGTE applies the GTE predicate on the "license_number" field.
+func LicenseNumberGTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldLicenseNumber, v))
+}
+
+// LicenseNumberLT applies the LT predicate on the "license_number" field.
+func LicenseNumberLT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldLicenseNumber, v))
+}
+
+// LicenseNumberLTE applies the LTE predicate on the "license_number" field.
+func LicenseNumberLTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldLicenseNumber, v))
+}
+
+// LicenseNumberContains applies the Contains predicate on the "license_number" field.
+func LicenseNumberContains(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContains(FieldLicenseNumber, v))
+}
+
+// LicenseNumberHasPrefix applies the HasPrefix predicate on the "license_number" field.
+func LicenseNumberHasPrefix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasPrefix(FieldLicenseNumber, v))
+}
+
+// LicenseNumberHasSuffix applies the HasSuffix predicate on the "license_number" field.
+func LicenseNumberHasSuffix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasSuffix(FieldLicenseNumber, v))
+}
+
+// LicenseNumberEqualFold applies the EqualFold predicate on the "license_number" field.
+func LicenseNumberEqualFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEqualFold(FieldLicenseNumber, v))
+}
+
+// LicenseNumberContainsFold applies the ContainsFold predicate on the "license_number" field.
+func LicenseNumberContainsFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContainsFold(FieldLicenseNumber, v))
+}
+
+// LicenseExpiryEQ applies the EQ predicate on the "license_expiry" field.
+func LicenseExpiryEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryNEQ applies the NEQ predicate on the "license_expiry" field.
+func LicenseExpiryNEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryIn applies the In predicate on the "license_expiry" field.
+func LicenseExpiryIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldLicenseExpiry, vs...))
+}
+
+// LicenseExpiryNotIn applies the NotIn predicate on the "license_expiry" field.
+func LicenseExpiryNotIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldLicenseExpiry, vs...))
+}
+
+// LicenseExpiryGT applies the GT predicate on the "license_expiry" field.
+func LicenseExpiryGT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryGTE applies the GTE predicate on the "license_expiry" field.
+func LicenseExpiryGTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryLT applies the LT predicate on the "license_expiry" field.
+func LicenseExpiryLT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryLTE applies the LTE predicate on the "license_expiry" field.
+func LicenseExpiryLTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldLicenseExpiry, v))
+}
+
+// LicenseExpiryIsNil applies the IsNil predicate on the "license_expiry" field.
+func LicenseExpiryIsNil() predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIsNull(FieldLicenseExpiry))
+}
+
+// LicenseExpiryNotNil applies the NotNil predicate on the "license_expiry" field.
+func LicenseExpiryNotNil() predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotNull(FieldLicenseExpiry))
+}
+
+// PhoneEQ applies the EQ predicate on the "phone" field.
+func PhoneEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldPhone, v))
+}
+
+// PhoneNEQ applies the NEQ predicate on the "phone" field.
+func PhoneNEQ(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldPhone, v))
+}
+
+// PhoneIn applies the In predicate on the "phone" field.
+func PhoneIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldPhone, vs...))
+}
+
+// PhoneNotIn applies the NotIn predicate on the "phone" field.
+func PhoneNotIn(vs ...string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldPhone, vs...))
+}
+
+// PhoneGT applies the GT predicate on the "phone" field.
+func PhoneGT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldPhone, v))
+}
+
+// PhoneGTE applies the GTE predicate on the "phone" field.
+func PhoneGTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldPhone, v))
+}
+
+// PhoneLT applies the LT predicate on the "phone" field.
+func PhoneLT(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldPhone, v))
+}
+
+// PhoneLTE applies the LTE predicate on the "phone" field.
+func PhoneLTE(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldPhone, v))
+}
+
+// PhoneContains applies the Contains predicate on the "phone" field.
+func PhoneContains(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContains(FieldPhone, v))
+}
+
+// PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
+func PhoneHasPrefix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasPrefix(FieldPhone, v))
+}
+
+// PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
+func PhoneHasSuffix(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldHasSuffix(FieldPhone, v))
+}
+
+// PhoneIsNil applies the IsNil predicate on the "phone" field.
+func PhoneIsNil() predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIsNull(FieldPhone))
+}
+
+// PhoneNotNil applies the NotNil predicate on the "phone" field.
+func PhoneNotNil() predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotNull(FieldPhone))
+}
+
+// PhoneEqualFold applies the EqualFold predicate on the "phone" field.
+func PhoneEqualFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEqualFold(FieldPhone, v))
+}
+
+// PhoneContainsFold applies the ContainsFold predicate on the "phone" field.
+func PhoneContainsFold(v string) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldContainsFold(FieldPhone, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldTenantID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasAssignments applies the HasEdge predicate on the "assignments" edge.
+func HasAssignments() predicate.DriverProfile {
+	return predicate.DriverProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AssignmentsTable, AssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssignmentsWith applies the HasEdge predicate on the "assignments" edge with a given conditions (other predicates).
+func HasAssignmentsWith(preds ...predicate.DriverAssignment) predicate.DriverProfile {
+	return predicate.DriverProfile(func(s *sql.Selector) {
+		step := newAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DriverProfile) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DriverProfile) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DriverProfile) predicate.DriverProfile {
+	return predicate.DriverProfile(sql.NotPredicates(p))
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+)
+
+// DriverProfileCreate is the builder for creating a DriverProfile entity.
+type DriverProfileCreate struct {
+	config
+	mutation *DriverProfileMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *DriverProfileCreate) SetName(v string) *DriverProfileCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_c *DriverProfileCreate) SetLicenseNumber(v string) *DriverProfileCreate {
+	_c.mutation.SetLicenseNumber(v)
+	return _c
+}
+
+// SetLicenseExpiry sets the "license_expiry" field.
+func (_c *DriverProfileCreate) SetLicenseExpiry(v time.Time) *DriverProfileCreate {
+	_c.mutation.SetLicenseExpiry(v)
+	return _c
+}
+
+// SetNillableLicenseExpiry sets the "license_expiry" field if the given value is not nil.
+func (_c *DriverProfileCreate) SetNillableLicenseExpiry(v *time.Time) *DriverProfileCreate {
+	if v != nil {
+		_c.SetLicenseExpiry(*v)
+	}
+	return _c
+}
+
+// SetPhone sets the "phone" field.
+func (_c *DriverProfileCreate) SetPhone(v string) *DriverProfileCreate {
+	_c.mutation.SetPhone(v)
+	return _c
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_c *DriverProfileCreate) SetNillablePhone(v *string) *DriverProfileCreate {
+	if v != nil {
+		_c.SetPhone(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *DriverProfileCreate) SetTenantID(v int) *DriverProfileCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DriverProfileCreate) SetCreatedAt(v time.Time) *DriverProfileCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DriverProfileCreate) SetNillableCreatedAt(v *time.Time) *DriverProfileCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DriverProfileCreate) SetUpdatedAt(v time.Time) *DriverProfileCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DriverProfileCreate) SetNillableUpdatedAt(v *time.Time) *DriverProfileCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DriverAssignment entity by IDs.
+func (_c *DriverProfileCreate) AddAssignmentIDs(ids ...int) *DriverProfileCreate {
+	_c.mutation.AddAssignmentIDs(ids...)
+	return _c
+}
+
+// AddAssignments adds the "assignments" edges to the DriverAssignment entity.
+func (_c *DriverProfileCreate) AddAssignments(v ...*DriverAssignment) *DriverProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the DriverProfileMutation object of the builder.
+func (_c *DriverProfileCreate) Mutation() *DriverProfileMutation {
+	return _c.mutation
+}
+
+// Save creates the DriverProfile in the database.
+func (_c *DriverProfileCreate) Save(ctx context.Context) (*DriverProfile, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DriverProfileCreate) SaveX(ctx context.Context) *DriverProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverProfileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverProfileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DriverProfileCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := driverprofile.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := driverprofile.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DriverProfileCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DriverProfile.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := driverprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LicenseNumber(); !ok {
+		return &ValidationError{Name: "license_number", err: errors.New(`ent: missing required field "DriverProfile.license_number"`)}
+	}
+	if v, ok := _c.mutation.LicenseNumber(); ok {
+		if err := driverprofile.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.license_number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "DriverProfile.tenant_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DriverProfile.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DriverProfile.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DriverProfileCreate) sqlSave(ctx context.Context) (*DriverProfile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DriverProfileCreate) createSpec() (*DriverProfile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DriverProfile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(driverprofile.Table, sqlgraph.NewFieldSpec(driverprofile.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(driverprofile.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.LicenseNumber(); ok {
+		_spec.SetField(driverprofile.FieldLicenseNumber, field.TypeString, value)
+		_node.LicenseNumber = value
+	}
+	if value, ok := _c.mutation.LicenseExpiry(); ok {
+		_spec.SetField(driverprofile.FieldLicenseExpiry, field.TypeTime, value)
+		_node.LicenseExpiry = &value
+	}
+	if value, ok := _c.mutation.Phone(); ok {
+		_spec.SetField(driverprofile.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(driverprofile.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(driverprofile.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(driverprofile.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DriverProfileCreateBulk is the builder for creating many DriverProfile entities in bulk.
+type DriverProfileCreateBulk struct {
+	config
+	err      error
+	builders []*DriverProfileCreate
+}
+
+// Save creates the DriverProfile entities in the database.
+func (_c *DriverProfileCreateBulk) Save(ctx context.Context) ([]*DriverProfile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DriverProfile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DriverProfileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DriverProfileCreateBulk) SaveX(ctx context.Context) []*DriverProfile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverProfileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverProfileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// DriverProfileUpdate is the builder for updating DriverProfile entities.
+type DriverProfileUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DriverProfileMutation
+}
+
+// Where appends a list predicates to the DriverProfileUpdate builder.
+func (_u *DriverProfileUpdate) Where(ps ...predicate.DriverProfile) *DriverProfileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DriverProfileUpdate) SetName(v string) *DriverProfileUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DriverProfileUpdate) SetNillableName(v *string) *DriverProfileUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_u *DriverProfileUpdate) SetLicenseNumber(v string) *DriverProfileUpdate {
+	_u.mutation.SetLicenseNumber(v)
+	return _u
+}
+
+// SetNillableLicenseNumber sets the "license_number" field if the given value is not nil.
+func (_u *DriverProfileUpdate) SetNillableLicenseNumber(v *string) *DriverProfileUpdate {
+	if v != nil {
+		_u.SetLicenseNumber(*v)
+	}
+	return _u
+}
+
+// SetLicenseExpiry sets the "license_expiry" field.
+func (_u *DriverProfileUpdate) SetLicenseExpiry(v time.Time) *DriverProfileUpdate {
+	_u.mutation.SetLicenseExpiry(v)
+	return _u
+}
+
+// SetNillableLicenseExpiry sets the "license_expiry" field if the given value is not nil.
+func (_u *DriverProfileUpdate) SetNillableLicenseExpiry(v *time.Time) *DriverProfileUpdate {
+	if v != nil {
+		_u.SetLicenseExpiry(*v)
+	}
+	return _u
+}
+
+// ClearLicenseExpiry clears the value of the "license_expiry" field.
+func (_u *DriverProfileUpdate) ClearLicenseExpiry() *DriverProfileUpdate {
+	_u.mutation.ClearLicenseExpiry()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *DriverProfileUpdate) SetPhone(v string) *DriverProfileUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *DriverProfileUpdate) SetNillablePhone(v *string) *DriverProfileUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *DriverProfileUpdate) ClearPhone() *DriverProfileUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DriverProfileUpdate) SetTenantID(v int) *DriverProfileUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DriverProfileUpdate) SetNillableTenantID(v *int) *DriverProfileUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *DriverProfileUpdate) AddTenantID(v int) *DriverProfileUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DriverProfileUpdate) SetUpdatedAt(v time.Time) *DriverProfileUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DriverAssignment entity by IDs.
+func (_u *DriverProfileUpdate) AddAssignmentIDs(ids ...int) *DriverProfileUpdate {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the DriverAssignment entity.
+func (_u *DriverProfileUpdate) AddAssignments(v ...*DriverAssignment) *DriverProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the DriverProfileMutation object of the builder.
+func (_u *DriverProfileUpdate) Mutation() *DriverProfileMutation {
+	return _u.mutation
+}
+
+// ClearAssignments clears all "assignments" edges to the DriverAssignment entity.
+func (_u *DriverProfileUpdate) ClearAssignments() *DriverProfileUpdate {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to DriverAssignment entities by IDs.
+func (_u *DriverProfileUpdate) RemoveAssignmentIDs(ids ...int) *DriverProfileUpdate {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to DriverAssignment entities.
+func (_u *DriverProfileUpdate) RemoveAssignments(v ...*DriverAssignment) *DriverProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DriverProfileUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverProfileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DriverProfileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverProfileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DriverProfileUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := driverprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverProfileUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := driverprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicenseNumber(); ok {
+		if err := driverprofile.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.license_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DriverProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driverprofile.Table, driverprofile.Columns, sqlgraph.NewFieldSpec(driverprofile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(driverprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseNumber(); ok {
+		_spec.SetField(driverprofile.FieldLicenseNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseExpiry(); ok {
+		_spec.SetField(driverprofile.FieldLicenseExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.LicenseExpiryCleared() {
+		_spec.ClearField(driverprofile.FieldLicenseExpiry, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(driverprofile.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(driverprofile.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(driverprofile.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(driverprofile.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(driverprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driverprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DriverProfileUpdateOne is the builder for updating a single DriverProfile entity.
+type DriverProfileUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DriverProfileMutation
+}
+
+// SetName sets the "name" field.
+func (_u *DriverProfileUpdateOne) SetName(v string) *DriverProfileUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DriverProfileUpdateOne) SetNillableName(v *string) *DriverProfileUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (_u *DriverProfileUpdateOne) SetLicenseNumber(v string) *DriverProfileUpdateOne {
+	_u.mutation.SetLicenseNumber(v)
+	return _u
+}
+
+// SetNillableLicenseNumber sets the "license_number" field if the given value is not nil.
+func (_u *DriverProfileUpdateOne) SetNillableLicenseNumber(v *string) *DriverProfileUpdateOne {
+	if v != nil {
+		_u.SetLicenseNumber(*v)
+	}
+	return _u
+}
+
+// SetLicenseExpiry sets the "license_expiry" field.
+func (_u *DriverProfileUpdateOne) SetLicenseExpiry(v time.Time) *DriverProfileUpdateOne {
+	_u.mutation.SetLicenseExpiry(v)
+	return _u
+}
+
+// SetNillableLicenseExpiry sets the "license_expiry" field if the given value is not nil.
+func (_u *DriverProfileUpdateOne) SetNillableLicenseExpiry(v *time.Time) *DriverProfileUpdateOne {
+	if v != nil {
+		_u.SetLicenseExpiry(*v)
+	}
+	return _u
+}
+
+// ClearLicenseExpiry clears the value of the "license_expiry" field.
+func (_u *DriverProfileUpdateOne) ClearLicenseExpiry() *DriverProfileUpdateOne {
+	_u.mutation.ClearLicenseExpiry()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *DriverProfileUpdateOne) SetPhone(v string) *DriverProfileUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *DriverProfileUpdateOne) SetNillablePhone(v *string) *DriverProfileUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *DriverProfileUpdateOne) ClearPhone() *DriverProfileUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_u *DriverProfileUpdateOne) SetTenantID(v int) *DriverProfileUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *DriverProfileUpdateOne) SetNillableTenantID(v *int) *DriverProfileUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *DriverProfileUpdateOne) AddTenantID(v int) *DriverProfileUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DriverProfileUpdateOne) SetUpdatedAt(v time.Time) *DriverProfileUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DriverAssignment entity by IDs.
+func (_u *DriverProfileUpdateOne) AddAssignmentIDs(ids ...int) *DriverProfileUpdateOne {
+	_u.mutation.AddAssignmentIDs(ids...)
+	return _u
+}
+
+// AddAssignments adds the "assignments" edges to the DriverAssignment entity.
+func (_u *DriverProfileUpdateOne) AddAssignments(v ...*DriverAssignment) *DriverProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAssignmentIDs(ids...)
+}
+
+// Mutation returns the DriverProfileMutation object of the builder.
+func (_u *DriverProfileUpdateOne) Mutation() *DriverProfileMutation {
+	return _u.mutation
+}
+
+// ClearAssignments clears all "assignments" edges to the DriverAssignment entity.
+func (_u *DriverProfileUpdateOne) ClearAssignments() *DriverProfileUpdateOne {
+	_u.mutation.ClearAssignments()
+	return _u
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to DriverAssignment entities by IDs.
+func (_u *DriverProfileUpdateOne) RemoveAssignmentIDs(ids ...int) *DriverProfileUpdateOne {
+	_u.mutation.RemoveAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveAssignments removes "assignments" edges to DriverAssignment entities.
+func (_u *DriverProfileUpdateOne) RemoveAssignments(v ...*DriverAssignment) *DriverProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAssignmentIDs(ids...)
+}
+
+// Where appends a list predicates to the DriverProfileUpdate builder.
+func (_u *DriverProfileUpdateOne) Where(ps ...predicate.DriverProfile) *DriverProfileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DriverProfileUpdateOne) Select(field string, fields ...string) *DriverProfileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DriverProfile entity.
+func (_u *DriverProfileUpdateOne) Save(ctx context.Context) (*DriverProfile, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverProfileUpdateOne) SaveX(ctx context.Context) *DriverProfile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DriverProfileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverProfileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DriverProfileUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := driverprofile.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverProfileUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := driverprofile.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LicenseNumber(); ok {
+		if err := driverprofile.LicenseNumberValidator(v); err != nil {
+			return &ValidationError{Name: "license_number", err: fmt.Errorf(`ent: validator failed for field "DriverProfile.license_number": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DriverProfileUpdateOne) sqlSave(ctx context.Context) (_node *DriverProfile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driverprofile.Table, driverprofile.Columns, sqlgraph.NewFieldSpec(driverprofile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DriverProfile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, driverprofile.FieldID)
+		for _, f := range fields {
+			if !driverprofile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != driverprofile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(driverprofile.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseNumber(); ok {
+		_spec.SetField(driverprofile.FieldLicenseNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LicenseExpiry(); ok {
+		_spec.SetField(driverprofile.FieldLicenseExpiry, field.TypeTime, value)
+	}
+	if _u.mutation.LicenseExpiryCleared() {
+		_spec.ClearField(driverprofile.FieldLicenseExpiry, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(driverprofile.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(driverprofile.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(driverprofile.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(driverprofile.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(driverprofile.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.AssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   driverprofile.AssignmentsTable,
+			Columns: []string{driverprofile.AssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DriverProfile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driverprofile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
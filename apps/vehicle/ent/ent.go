@@ -12,7 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicleevent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +79,13 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			vehicle.Table: vehicle.ValidColumn,
+			device.Table:           device.ValidColumn,
+			driverassignment.Table: driverassignment.ValidColumn,
+			driverprofile.Table:    driverprofile.ValidColumn,
+			group.Table:            group.ValidColumn,
+			vehicle.Table:          vehicle.ValidColumn,
+			vehicleevent.Table:     vehicleevent.ValidColumn,
+			vehiclehistory.Table:   vehiclehistory.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,4 @@
+package ent
+
+// intercept 特性用于软删除的查询过滤，sql/versioned-migration 用于生成迁移文件 (ent/migrate/main.go)，重新生成时需要带上
+//go:generate go run -mod=mod entgo.io/ent/cmd/ent generate --feature intercept,sql/versioned-migration ./schema
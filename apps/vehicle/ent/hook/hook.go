@@ -9,6 +9,54 @@ import (
 	"github.com/xuewentao/cheya/apps/vehicle/ent"
 )
 
+// The DeviceFunc type is an adapter to allow the use of ordinary
+// function as Device mutator.
+type DeviceFunc func(context.Context, *ent.DeviceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
+}
+
+// The DriverAssignmentFunc type is an adapter to allow the use of ordinary
+// function as DriverAssignment mutator.
+type DriverAssignmentFunc func(context.Context, *ent.DriverAssignmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DriverAssignmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DriverAssignmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DriverAssignmentMutation", m)
+}
+
+// The DriverProfileFunc type is an adapter to allow the use of ordinary
+// function as DriverProfile mutator.
+type DriverProfileFunc func(context.Context, *ent.DriverProfileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DriverProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DriverProfileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DriverProfileMutation", m)
+}
+
+// The GroupFunc type is an adapter to allow the use of ordinary
+// function as Group mutator.
+type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMutation", m)
+}
+
 // The VehicleFunc type is an adapter to allow the use of ordinary
 // function as Vehicle mutator.
 type VehicleFunc func(context.Context, *ent.VehicleMutation) (ent.Value, error)
@@ -21,6 +69,30 @@ func (f VehicleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleMutation", m)
 }
 
+// The VehicleEventFunc type is an adapter to allow the use of ordinary
+// function as VehicleEvent mutator.
+type VehicleEventFunc func(context.Context, *ent.VehicleEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleEventMutation", m)
+}
+
+// The VehicleHistoryFunc type is an adapter to allow the use of ordinary
+// function as VehicleHistory mutator.
+type VehicleHistoryFunc func(context.Context, *ent.VehicleHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f VehicleHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.VehicleHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.VehicleHistoryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
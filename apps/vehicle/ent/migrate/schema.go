@@ -3,22 +3,176 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "credential_hash", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "vehicle_id", Type: field.TypeInt},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:       "devices",
+		Columns:    DevicesColumns,
+		PrimaryKey: []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "devices_vehicles_devices",
+				Columns:    []*schema.Column{DevicesColumns[7]},
+				RefColumns: []*schema.Column{VehiclesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DriverAssignmentsColumns holds the columns for the "driver_assignments" table.
+	DriverAssignmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "assigned_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "driver_id", Type: field.TypeInt},
+		{Name: "vehicle_id", Type: field.TypeInt},
+	}
+	// DriverAssignmentsTable holds the schema information for the "driver_assignments" table.
+	DriverAssignmentsTable = &schema.Table{
+		Name:       "driver_assignments",
+		Columns:    DriverAssignmentsColumns,
+		PrimaryKey: []*schema.Column{DriverAssignmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "driver_assignments_drivers_assignments",
+				Columns:    []*schema.Column{DriverAssignmentsColumns[5]},
+				RefColumns: []*schema.Column{DriversColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "driver_assignments_vehicles_driver_assignments",
+				Columns:    []*schema.Column{DriverAssignmentsColumns[6]},
+				RefColumns: []*schema.Column{VehiclesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "driverassignment_vehicle_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{DriverAssignmentsColumns[6], DriverAssignmentsColumns[1]},
+			},
+			{
+				Name:    "driverassignment_driver_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{DriverAssignmentsColumns[5], DriverAssignmentsColumns[1]},
+			},
+			{
+				Name:    "driverassignment_vehicle_id_current",
+				Unique:  true,
+				Columns: []*schema.Column{DriverAssignmentsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "ended_at IS NULL",
+				},
+			},
+			{
+				Name:    "driverassignment_driver_id_current",
+				Unique:  true,
+				Columns: []*schema.Column{DriverAssignmentsColumns[5]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "ended_at IS NULL",
+				},
+			},
+		},
+	}
+	// DriversColumns holds the columns for the "drivers" table.
+	DriversColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "license_number", Type: field.TypeString, Size: 32},
+		{Name: "license_expiry", Type: field.TypeTime, Nullable: true},
+		{Name: "phone", Type: field.TypeString, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DriversTable holds the schema information for the "drivers" table.
+	DriversTable = &schema.Table{
+		Name:       "drivers",
+		Columns:    DriversColumns,
+		PrimaryKey: []*schema.Column{DriversColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "driverprofile_tenant_id_license_number",
+				Unique:  true,
+				Columns: []*schema.Column{DriversColumns[5], DriversColumns[2]},
+			},
+		},
+	}
+	// GroupsColumns holds the columns for the "groups" table.
+	GroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// GroupsTable holds the schema information for the "groups" table.
+	GroupsTable = &schema.Table{
+		Name:       "groups",
+		Columns:    GroupsColumns,
+		PrimaryKey: []*schema.Column{GroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "groups_groups_children",
+				Columns:    []*schema.Column{GroupsColumns[6]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "group_tenant_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{GroupsColumns[3], GroupsColumns[1]},
+			},
+			{
+				Name:    "group_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{GroupsColumns[6]},
+			},
+		},
+	}
 	// VehiclesColumns holds the columns for the "vehicles" table.
 	VehiclesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "vin", Type: field.TypeString, Unique: true},
 		{Name: "license_plate", Type: field.TypeString},
-		{Name: "status", Type: field.TypeString, Default: "Offline"},
+		{Name: "status", Type: field.TypeString, Default: "offline"},
 		{Name: "last_heartbeat", Type: field.TypeTime, Nullable: true},
 		{Name: "location", Type: field.TypeJSON, Nullable: true},
 		{Name: "telemetry", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tenant_id", Type: field.TypeInt, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "version", Type: field.TypeInt64, Default: 1},
+		{Name: "make", Type: field.TypeString, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "year", Type: field.TypeInt, Nullable: true},
+		{Name: "fuel_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"ice", "ev", "hybrid"}},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "capacity_kg", Type: field.TypeInt, Nullable: true},
 	}
 	// VehiclesTable holds the schema information for the "vehicles" table.
 	VehiclesTable = &schema.Table{
@@ -29,15 +183,138 @@ var (
 			{
 				Name:    "vehicle_status",
 				Unique:  false,
-				Columns: []*schema.Column{VehiclesColumns[3]},
+				Columns: []*schema.Column{VehiclesColumns[4]},
+			},
+			{
+				Name:    "vehicle_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{VehiclesColumns[10]},
+			},
+			{
+				Name:    "vehicle_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehiclesColumns[8]},
+			},
+			{
+				Name:    "vehicle_last_heartbeat",
+				Unique:  false,
+				Columns: []*schema.Column{VehiclesColumns[5]},
+			},
+			{
+				Name:    "vehicle_tags",
+				Unique:  false,
+				Columns: []*schema.Column{VehiclesColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+			{
+				Name:    "vehicle_attributes",
+				Unique:  false,
+				Columns: []*schema.Column{VehiclesColumns[17]},
+				Annotation: &entsql.IndexAnnotation{
+					Types: map[string]string{
+						"postgres": "GIN",
+					},
+				},
+			},
+		},
+	}
+	// VehicleEventsColumns holds the columns for the "vehicle_events" table.
+	VehicleEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "vin", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeInt, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"created", "updated", "deleted"}},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VehicleEventsTable holds the schema information for the "vehicle_events" table.
+	VehicleEventsTable = &schema.Table{
+		Name:       "vehicle_events",
+		Columns:    VehicleEventsColumns,
+		PrimaryKey: []*schema.Column{VehicleEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehicleevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleEventsColumns[6]},
+			},
+		},
+	}
+	// VehicleHistoriesColumns holds the columns for the "vehicle_histories" table.
+	VehicleHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "vehicle_id", Type: field.TypeInt},
+		{Name: "vin", Type: field.TypeString},
+		{Name: "tenant_id", Type: field.TypeInt, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "restore"}},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// VehicleHistoriesTable holds the schema information for the "vehicle_histories" table.
+	VehicleHistoriesTable = &schema.Table{
+		Name:       "vehicle_histories",
+		Columns:    VehicleHistoriesColumns,
+		PrimaryKey: []*schema.Column{VehicleHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "vehiclehistory_vehicle_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{VehicleHistoriesColumns[1], VehicleHistoriesColumns[7]},
+			},
+		},
+	}
+	// GroupVehiclesColumns holds the columns for the "group_vehicles" table.
+	GroupVehiclesColumns = []*schema.Column{
+		{Name: "group_id", Type: field.TypeInt},
+		{Name: "vehicle_id", Type: field.TypeInt},
+	}
+	// GroupVehiclesTable holds the schema information for the "group_vehicles" table.
+	GroupVehiclesTable = &schema.Table{
+		Name:       "group_vehicles",
+		Columns:    GroupVehiclesColumns,
+		PrimaryKey: []*schema.Column{GroupVehiclesColumns[0], GroupVehiclesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "group_vehicles_group_id",
+				Columns:    []*schema.Column{GroupVehiclesColumns[0]},
+				RefColumns: []*schema.Column{GroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "group_vehicles_vehicle_id",
+				Columns:    []*schema.Column{GroupVehiclesColumns[1]},
+				RefColumns: []*schema.Column{VehiclesColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DevicesTable,
+		DriverAssignmentsTable,
+		DriversTable,
+		GroupsTable,
 		VehiclesTable,
+		VehicleEventsTable,
+		VehicleHistoriesTable,
+		GroupVehiclesTable,
 	}
 )
 
 func init() {
+	DevicesTable.ForeignKeys[0].RefTable = VehiclesTable
+	DriverAssignmentsTable.ForeignKeys[0].RefTable = DriversTable
+	DriverAssignmentsTable.ForeignKeys[1].RefTable = VehiclesTable
+	DriversTable.Annotation = &entsql.Annotation{
+		Table: "drivers",
+	}
+	GroupsTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupVehiclesTable.ForeignKeys[0].RefTable = GroupsTable
+	GroupVehiclesTable.ForeignKeys[1].RefTable = VehiclesTable
 }
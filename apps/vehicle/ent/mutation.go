@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -11,9 +12,15 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
-	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicleevent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
 )
 
 const (
@@ -25,40 +32,46 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeVehicle = "Vehicle"
+	TypeDevice           = "Device"
+	TypeDriverAssignment = "DriverAssignment"
+	TypeDriverProfile    = "DriverProfile"
+	TypeGroup            = "Group"
+	TypeVehicle          = "Vehicle"
+	TypeVehicleEvent     = "VehicleEvent"
+	TypeVehicleHistory   = "VehicleHistory"
 )
 
-// VehicleMutation represents an operation that mutates the Vehicle nodes in the graph.
-type VehicleMutation struct {
+// DeviceMutation represents an operation that mutates the Device nodes in the graph.
+type DeviceMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	vin            *string
-	license_plate  *string
-	status         *string
-	last_heartbeat *time.Time
-	location       **schema.Location
-	telemetry      *map[string]interface{}
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Vehicle, error)
-	predicates     []predicate.Vehicle
+	op              Op
+	typ             string
+	id              *int
+	prefix          *string
+	credential_hash *string
+	name            *string
+	last_seen_at    *time.Time
+	revoked_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	vehicle         *int
+	clearedvehicle  bool
+	done            bool
+	oldValue        func(context.Context) (*Device, error)
+	predicates      []predicate.Device
 }
 
-var _ ent.Mutation = (*VehicleMutation)(nil)
+var _ ent.Mutation = (*DeviceMutation)(nil)
 
-// vehicleOption allows management of the mutation configuration using functional options.
-type vehicleOption func(*VehicleMutation)
+// deviceOption allows management of the mutation configuration using functional options.
+type deviceOption func(*DeviceMutation)
 
-// newVehicleMutation creates new mutation for the Vehicle entity.
-func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation {
-	m := &VehicleMutation{
+// newDeviceMutation creates new mutation for the Device entity.
+func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
+	m := &DeviceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeVehicle,
+		typ:           TypeDevice,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -67,20 +80,20 @@ func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation
 	return m
 }
 
-// withVehicleID sets the ID field of the mutation.
-func withVehicleID(id int) vehicleOption {
-	return func(m *VehicleMutation) {
+// withDeviceID sets the ID field of the mutation.
+func withDeviceID(id int) deviceOption {
+	return func(m *DeviceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Vehicle
+			value *Device
 		)
-		m.oldValue = func(ctx context.Context) (*Vehicle, error) {
+		m.oldValue = func(ctx context.Context) (*Device, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Vehicle.Get(ctx, id)
+					value, err = m.Client().Device.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -89,10 +102,10 @@ func withVehicleID(id int) vehicleOption {
 	}
 }
 
-// withVehicle sets the old Vehicle of the mutation.
-func withVehicle(node *Vehicle) vehicleOption {
-	return func(m *VehicleMutation) {
-		m.oldValue = func(context.Context) (*Vehicle, error) {
+// withDevice sets the old Device of the mutation.
+func withDevice(node *Device) deviceOption {
+	return func(m *DeviceMutation) {
+		m.oldValue = func(context.Context) (*Device, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -101,7 +114,7 @@ func withVehicle(node *Vehicle) vehicleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m VehicleMutation) Client() *Client {
+func (m DeviceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -109,7 +122,7 @@ func (m VehicleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m VehicleMutation) Tx() (*Tx, error) {
+func (m DeviceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -120,7 +133,7 @@ func (m VehicleMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *VehicleMutation) ID() (id int, exists bool) {
+func (m *DeviceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -131,7 +144,7 @@ func (m *VehicleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeviceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -140,274 +153,274 @@ func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Vehicle.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetVin sets the "vin" field.
-func (m *VehicleMutation) SetVin(s string) {
-	m.vin = &s
+// SetPrefix sets the "prefix" field.
+func (m *DeviceMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Vin returns the value of the "vin" field in the mutation.
-func (m *VehicleMutation) Vin() (r string, exists bool) {
-	v := m.vin
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *DeviceMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVin returns the old "vin" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldVin(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVin requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Vin, nil
+	return oldValue.Prefix, nil
 }
 
-// ResetVin resets all changes to the "vin" field.
-func (m *VehicleMutation) ResetVin() {
-	m.vin = nil
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *DeviceMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetLicensePlate sets the "license_plate" field.
-func (m *VehicleMutation) SetLicensePlate(s string) {
-	m.license_plate = &s
+// SetCredentialHash sets the "credential_hash" field.
+func (m *DeviceMutation) SetCredentialHash(s string) {
+	m.credential_hash = &s
 }
 
-// LicensePlate returns the value of the "license_plate" field in the mutation.
-func (m *VehicleMutation) LicensePlate() (r string, exists bool) {
-	v := m.license_plate
+// CredentialHash returns the value of the "credential_hash" field in the mutation.
+func (m *DeviceMutation) CredentialHash() (r string, exists bool) {
+	v := m.credential_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLicensePlate returns the old "license_plate" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldCredentialHash returns the old "credential_hash" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldLicensePlate(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldCredentialHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLicensePlate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCredentialHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLicensePlate requires an ID field in the mutation")
+		return v, errors.New("OldCredentialHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLicensePlate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCredentialHash: %w", err)
 	}
-	return oldValue.LicensePlate, nil
+	return oldValue.CredentialHash, nil
 }
 
-// ResetLicensePlate resets all changes to the "license_plate" field.
-func (m *VehicleMutation) ResetLicensePlate() {
-	m.license_plate = nil
+// ResetCredentialHash resets all changes to the "credential_hash" field.
+func (m *DeviceMutation) ResetCredentialHash() {
+	m.credential_hash = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *VehicleMutation) SetStatus(s string) {
-	m.status = &s
+// SetName sets the "name" field.
+func (m *DeviceMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *VehicleMutation) Status() (r string, exists bool) {
-	v := m.status
+// Name returns the value of the "name" field in the mutation.
+func (m *DeviceMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *DeviceMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Name, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *VehicleMutation) ResetStatus() {
-	m.status = nil
+// ClearName clears the value of the "name" field.
+func (m *DeviceMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[device.FieldName] = struct{}{}
 }
 
-// SetLastHeartbeat sets the "last_heartbeat" field.
-func (m *VehicleMutation) SetLastHeartbeat(t time.Time) {
-	m.last_heartbeat = &t
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *DeviceMutation) NameCleared() bool {
+	_, ok := m.clearedFields[device.FieldName]
+	return ok
 }
 
-// LastHeartbeat returns the value of the "last_heartbeat" field in the mutation.
-func (m *VehicleMutation) LastHeartbeat() (r time.Time, exists bool) {
-	v := m.last_heartbeat
+// ResetName resets all changes to the "name" field.
+func (m *DeviceMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, device.FieldName)
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *DeviceMutation) SetVehicleID(i int) {
+	m.vehicle = &i
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *DeviceMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastHeartbeat returns the old "last_heartbeat" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldVehicleID returns the old "vehicle_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldLastHeartbeat(ctx context.Context) (v *time.Time, err error) {
+func (m *DeviceMutation) OldVehicleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastHeartbeat is only allowed on UpdateOne operations")
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastHeartbeat requires an ID field in the mutation")
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastHeartbeat: %w", err)
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
 	}
-	return oldValue.LastHeartbeat, nil
-}
-
-// ClearLastHeartbeat clears the value of the "last_heartbeat" field.
-func (m *VehicleMutation) ClearLastHeartbeat() {
-	m.last_heartbeat = nil
-	m.clearedFields[vehicle.FieldLastHeartbeat] = struct{}{}
-}
-
-// LastHeartbeatCleared returns if the "last_heartbeat" field was cleared in this mutation.
-func (m *VehicleMutation) LastHeartbeatCleared() bool {
-	_, ok := m.clearedFields[vehicle.FieldLastHeartbeat]
-	return ok
+	return oldValue.VehicleID, nil
 }
 
-// ResetLastHeartbeat resets all changes to the "last_heartbeat" field.
-func (m *VehicleMutation) ResetLastHeartbeat() {
-	m.last_heartbeat = nil
-	delete(m.clearedFields, vehicle.FieldLastHeartbeat)
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *DeviceMutation) ResetVehicleID() {
+	m.vehicle = nil
 }
 
-// SetLocation sets the "location" field.
-func (m *VehicleMutation) SetLocation(s *schema.Location) {
-	m.location = &s
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *DeviceMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *VehicleMutation) Location() (r *schema.Location, exists bool) {
-	v := m.location
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *DeviceMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSeenAt returns the old "last_seen_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldLocation(ctx context.Context) (v *schema.Location, err error) {
+func (m *DeviceMutation) OldLastSeenAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
 	}
-	return oldValue.Location, nil
+	return oldValue.LastSeenAt, nil
 }
 
-// ClearLocation clears the value of the "location" field.
-func (m *VehicleMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[vehicle.FieldLocation] = struct{}{}
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *DeviceMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[device.FieldLastSeenAt] = struct{}{}
 }
 
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *VehicleMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[vehicle.FieldLocation]
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *DeviceMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldLastSeenAt]
 	return ok
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *VehicleMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, vehicle.FieldLocation)
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *DeviceMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, device.FieldLastSeenAt)
 }
 
-// SetTelemetry sets the "telemetry" field.
-func (m *VehicleMutation) SetTelemetry(value map[string]interface{}) {
-	m.telemetry = &value
+// SetRevokedAt sets the "revoked_at" field.
+func (m *DeviceMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// Telemetry returns the value of the "telemetry" field in the mutation.
-func (m *VehicleMutation) Telemetry() (r map[string]interface{}, exists bool) {
-	v := m.telemetry
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *DeviceMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTelemetry returns the old "telemetry" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldTelemetry(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *DeviceMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTelemetry is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTelemetry requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTelemetry: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.Telemetry, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearTelemetry clears the value of the "telemetry" field.
-func (m *VehicleMutation) ClearTelemetry() {
-	m.telemetry = nil
-	m.clearedFields[vehicle.FieldTelemetry] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *DeviceMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[device.FieldRevokedAt] = struct{}{}
 }
 
-// TelemetryCleared returns if the "telemetry" field was cleared in this mutation.
-func (m *VehicleMutation) TelemetryCleared() bool {
-	_, ok := m.clearedFields[vehicle.FieldTelemetry]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *DeviceMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[device.FieldRevokedAt]
 	return ok
 }
 
-// ResetTelemetry resets all changes to the "telemetry" field.
-func (m *VehicleMutation) ResetTelemetry() {
-	m.telemetry = nil
-	delete(m.clearedFields, vehicle.FieldTelemetry)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *DeviceMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, device.FieldRevokedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *VehicleMutation) SetCreatedAt(t time.Time) {
+func (m *DeviceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *VehicleMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeviceMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -415,10 +428,10 @@ func (m *VehicleMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -433,55 +446,46 @@ func (m *VehicleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *VehicleMutation) ResetCreatedAt() {
+func (m *DeviceMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *VehicleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearVehicle clears the "vehicle" edge to the Vehicle entity.
+func (m *DeviceMutation) ClearVehicle() {
+	m.clearedvehicle = true
+	m.clearedFields[device.FieldVehicleID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *VehicleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// VehicleCleared reports if the "vehicle" edge to the Vehicle entity was cleared.
+func (m *DeviceMutation) VehicleCleared() bool {
+	return m.clearedvehicle
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Vehicle entity.
-// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *VehicleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// VehicleIDs returns the "vehicle" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VehicleID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) VehicleIDs() (ids []int) {
+	if id := m.vehicle; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *VehicleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetVehicle resets all changes to the "vehicle" edge.
+func (m *DeviceMutation) ResetVehicle() {
+	m.vehicle = nil
+	m.clearedvehicle = false
 }
 
-// Where appends a list predicates to the VehicleMutation builder.
-func (m *VehicleMutation) Where(ps ...predicate.Vehicle) {
+// Where appends a list predicates to the DeviceMutation builder.
+func (m *DeviceMutation) Where(ps ...predicate.Device) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the VehicleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *VehicleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Vehicle, len(ps))
+func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Device, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -489,48 +493,45 @@ func (m *VehicleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *VehicleMutation) Op() Op {
+func (m *DeviceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *VehicleMutation) SetOp(op Op) {
+func (m *DeviceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Vehicle).
-func (m *VehicleMutation) Type() string {
+// Type returns the node type of this mutation (Device).
+func (m *DeviceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *VehicleMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.vin != nil {
-		fields = append(fields, vehicle.FieldVin)
+func (m *DeviceMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.prefix != nil {
+		fields = append(fields, device.FieldPrefix)
 	}
-	if m.license_plate != nil {
-		fields = append(fields, vehicle.FieldLicensePlate)
+	if m.credential_hash != nil {
+		fields = append(fields, device.FieldCredentialHash)
 	}
-	if m.status != nil {
-		fields = append(fields, vehicle.FieldStatus)
+	if m.name != nil {
+		fields = append(fields, device.FieldName)
 	}
-	if m.last_heartbeat != nil {
-		fields = append(fields, vehicle.FieldLastHeartbeat)
+	if m.vehicle != nil {
+		fields = append(fields, device.FieldVehicleID)
 	}
-	if m.location != nil {
-		fields = append(fields, vehicle.FieldLocation)
+	if m.last_seen_at != nil {
+		fields = append(fields, device.FieldLastSeenAt)
 	}
-	if m.telemetry != nil {
-		fields = append(fields, vehicle.FieldTelemetry)
+	if m.revoked_at != nil {
+		fields = append(fields, device.FieldRevokedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, vehicle.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, vehicle.FieldUpdatedAt)
+		fields = append(fields, device.FieldCreatedAt)
 	}
 	return fields
 }
@@ -538,24 +539,22 @@ func (m *VehicleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
+func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case vehicle.FieldVin:
-		return m.Vin()
-	case vehicle.FieldLicensePlate:
-		return m.LicensePlate()
-	case vehicle.FieldStatus:
-		return m.Status()
-	case vehicle.FieldLastHeartbeat:
-		return m.LastHeartbeat()
-	case vehicle.FieldLocation:
-		return m.Location()
-	case vehicle.FieldTelemetry:
-		return m.Telemetry()
-	case vehicle.FieldCreatedAt:
+	case device.FieldPrefix:
+		return m.Prefix()
+	case device.FieldCredentialHash:
+		return m.CredentialHash()
+	case device.FieldName:
+		return m.Name()
+	case device.FieldVehicleID:
+		return m.VehicleID()
+	case device.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case device.FieldRevokedAt:
+		return m.RevokedAt()
+	case device.FieldCreatedAt:
 		return m.CreatedAt()
-	case vehicle.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -563,231 +562,6055 @@ func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *VehicleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case vehicle.FieldVin:
-		return m.OldVin(ctx)
-	case vehicle.FieldLicensePlate:
-		return m.OldLicensePlate(ctx)
-	case vehicle.FieldStatus:
-		return m.OldStatus(ctx)
-	case vehicle.FieldLastHeartbeat:
-		return m.OldLastHeartbeat(ctx)
-	case vehicle.FieldLocation:
-		return m.OldLocation(ctx)
-	case vehicle.FieldTelemetry:
-		return m.OldTelemetry(ctx)
-	case vehicle.FieldCreatedAt:
+	case device.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case device.FieldCredentialHash:
+		return m.OldCredentialHash(ctx)
+	case device.FieldName:
+		return m.OldName(ctx)
+	case device.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case device.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case device.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case device.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case vehicle.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Vehicle field %s", name)
+	return nil, fmt.Errorf("unknown Device field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VehicleMutation) SetField(name string, value ent.Value) error {
+func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case vehicle.FieldVin:
+	case device.FieldPrefix:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVin(v)
+		m.SetPrefix(v)
 		return nil
-	case vehicle.FieldLicensePlate:
+	case device.FieldCredentialHash:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLicensePlate(v)
+		m.SetCredentialHash(v)
 		return nil
-	case vehicle.FieldStatus:
+	case device.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case device.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case device.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case device.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case device.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Device numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(device.FieldName) {
+		fields = append(fields, device.FieldName)
+	}
+	if m.FieldCleared(device.FieldLastSeenAt) {
+		fields = append(fields, device.FieldLastSeenAt)
+	}
+	if m.FieldCleared(device.FieldRevokedAt) {
+		fields = append(fields, device.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceMutation) ClearField(name string) error {
+	switch name {
+	case device.FieldName:
+		m.ClearName()
+		return nil
+	case device.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
+	case device.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Device nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceMutation) ResetField(name string) error {
+	switch name {
+	case device.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case device.FieldCredentialHash:
+		m.ResetCredentialHash()
+		return nil
+	case device.FieldName:
+		m.ResetName()
+		return nil
+	case device.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case device.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case device.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case device.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.vehicle != nil {
+		edges = append(edges, device.EdgeVehicle)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case device.EdgeVehicle:
+		if id := m.vehicle; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedvehicle {
+		edges = append(edges, device.EdgeVehicle)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case device.EdgeVehicle:
+		return m.clearedvehicle
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceMutation) ClearEdge(name string) error {
+	switch name {
+	case device.EdgeVehicle:
+		m.ClearVehicle()
+		return nil
+	}
+	return fmt.Errorf("unknown Device unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceMutation) ResetEdge(name string) error {
+	switch name {
+	case device.EdgeVehicle:
+		m.ResetVehicle()
+		return nil
+	}
+	return fmt.Errorf("unknown Device edge %s", name)
+}
+
+// DriverAssignmentMutation represents an operation that mutates the DriverAssignment nodes in the graph.
+type DriverAssignmentMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	started_at     *time.Time
+	ended_at       *time.Time
+	assigned_by    *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	_driver        *int
+	cleared_driver bool
+	vehicle        *int
+	clearedvehicle bool
+	done           bool
+	oldValue       func(context.Context) (*DriverAssignment, error)
+	predicates     []predicate.DriverAssignment
+}
+
+var _ ent.Mutation = (*DriverAssignmentMutation)(nil)
+
+// driverassignmentOption allows management of the mutation configuration using functional options.
+type driverassignmentOption func(*DriverAssignmentMutation)
+
+// newDriverAssignmentMutation creates new mutation for the DriverAssignment entity.
+func newDriverAssignmentMutation(c config, op Op, opts ...driverassignmentOption) *DriverAssignmentMutation {
+	m := &DriverAssignmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDriverAssignment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDriverAssignmentID sets the ID field of the mutation.
+func withDriverAssignmentID(id int) driverassignmentOption {
+	return func(m *DriverAssignmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DriverAssignment
+		)
+		m.oldValue = func(ctx context.Context) (*DriverAssignment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DriverAssignment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDriverAssignment sets the old DriverAssignment of the mutation.
+func withDriverAssignment(node *DriverAssignment) driverassignmentOption {
+	return func(m *DriverAssignmentMutation) {
+		m.oldValue = func(context.Context) (*DriverAssignment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DriverAssignmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DriverAssignmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DriverAssignmentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DriverAssignmentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DriverAssignment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDriverID sets the "driver_id" field.
+func (m *DriverAssignmentMutation) SetDriverID(i int) {
+	m._driver = &i
+}
+
+// DriverID returns the value of the "driver_id" field in the mutation.
+func (m *DriverAssignmentMutation) DriverID() (r int, exists bool) {
+	v := m._driver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDriverID returns the old "driver_id" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldDriverID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDriverID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDriverID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDriverID: %w", err)
+	}
+	return oldValue.DriverID, nil
+}
+
+// ResetDriverID resets all changes to the "driver_id" field.
+func (m *DriverAssignmentMutation) ResetDriverID() {
+	m._driver = nil
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *DriverAssignmentMutation) SetVehicleID(i int) {
+	m.vehicle = &i
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *DriverAssignmentMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *DriverAssignmentMutation) ResetVehicleID() {
+	m.vehicle = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *DriverAssignmentMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DriverAssignmentMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DriverAssignmentMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *DriverAssignmentMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *DriverAssignmentMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *DriverAssignmentMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[driverassignment.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *DriverAssignmentMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[driverassignment.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *DriverAssignmentMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, driverassignment.FieldEndedAt)
+}
+
+// SetAssignedBy sets the "assigned_by" field.
+func (m *DriverAssignmentMutation) SetAssignedBy(s string) {
+	m.assigned_by = &s
+}
+
+// AssignedBy returns the value of the "assigned_by" field in the mutation.
+func (m *DriverAssignmentMutation) AssignedBy() (r string, exists bool) {
+	v := m.assigned_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedBy returns the old "assigned_by" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldAssignedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedBy: %w", err)
+	}
+	return oldValue.AssignedBy, nil
+}
+
+// ResetAssignedBy resets all changes to the "assigned_by" field.
+func (m *DriverAssignmentMutation) ResetAssignedBy() {
+	m.assigned_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DriverAssignmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DriverAssignmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DriverAssignment entity.
+// If the DriverAssignment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverAssignmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DriverAssignmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearDriver clears the "driver" edge to the DriverProfile entity.
+func (m *DriverAssignmentMutation) ClearDriver() {
+	m.cleared_driver = true
+	m.clearedFields[driverassignment.FieldDriverID] = struct{}{}
+}
+
+// DriverCleared reports if the "driver" edge to the DriverProfile entity was cleared.
+func (m *DriverAssignmentMutation) DriverCleared() bool {
+	return m.cleared_driver
+}
+
+// DriverIDs returns the "driver" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DriverID instead. It exists only for internal usage by the builders.
+func (m *DriverAssignmentMutation) DriverIDs() (ids []int) {
+	if id := m._driver; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDriver resets all changes to the "driver" edge.
+func (m *DriverAssignmentMutation) ResetDriver() {
+	m._driver = nil
+	m.cleared_driver = false
+}
+
+// ClearVehicle clears the "vehicle" edge to the Vehicle entity.
+func (m *DriverAssignmentMutation) ClearVehicle() {
+	m.clearedvehicle = true
+	m.clearedFields[driverassignment.FieldVehicleID] = struct{}{}
+}
+
+// VehicleCleared reports if the "vehicle" edge to the Vehicle entity was cleared.
+func (m *DriverAssignmentMutation) VehicleCleared() bool {
+	return m.clearedvehicle
+}
+
+// VehicleIDs returns the "vehicle" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VehicleID instead. It exists only for internal usage by the builders.
+func (m *DriverAssignmentMutation) VehicleIDs() (ids []int) {
+	if id := m.vehicle; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVehicle resets all changes to the "vehicle" edge.
+func (m *DriverAssignmentMutation) ResetVehicle() {
+	m.vehicle = nil
+	m.clearedvehicle = false
+}
+
+// Where appends a list predicates to the DriverAssignmentMutation builder.
+func (m *DriverAssignmentMutation) Where(ps ...predicate.DriverAssignment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DriverAssignmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DriverAssignmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DriverAssignment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DriverAssignmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DriverAssignmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DriverAssignment).
+func (m *DriverAssignmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DriverAssignmentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._driver != nil {
+		fields = append(fields, driverassignment.FieldDriverID)
+	}
+	if m.vehicle != nil {
+		fields = append(fields, driverassignment.FieldVehicleID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, driverassignment.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, driverassignment.FieldEndedAt)
+	}
+	if m.assigned_by != nil {
+		fields = append(fields, driverassignment.FieldAssignedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, driverassignment.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DriverAssignmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case driverassignment.FieldDriverID:
+		return m.DriverID()
+	case driverassignment.FieldVehicleID:
+		return m.VehicleID()
+	case driverassignment.FieldStartedAt:
+		return m.StartedAt()
+	case driverassignment.FieldEndedAt:
+		return m.EndedAt()
+	case driverassignment.FieldAssignedBy:
+		return m.AssignedBy()
+	case driverassignment.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DriverAssignmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case driverassignment.FieldDriverID:
+		return m.OldDriverID(ctx)
+	case driverassignment.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case driverassignment.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case driverassignment.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	case driverassignment.FieldAssignedBy:
+		return m.OldAssignedBy(ctx)
+	case driverassignment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DriverAssignment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverAssignmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case driverassignment.FieldDriverID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDriverID(v)
+		return nil
+	case driverassignment.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case driverassignment.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case driverassignment.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	case driverassignment.FieldAssignedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedBy(v)
+		return nil
+	case driverassignment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriverAssignment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DriverAssignmentMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DriverAssignmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverAssignmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DriverAssignment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DriverAssignmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(driverassignment.FieldEndedAt) {
+		fields = append(fields, driverassignment.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DriverAssignmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DriverAssignmentMutation) ClearField(name string) error {
+	switch name {
+	case driverassignment.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverAssignment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DriverAssignmentMutation) ResetField(name string) error {
+	switch name {
+	case driverassignment.FieldDriverID:
+		m.ResetDriverID()
+		return nil
+	case driverassignment.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case driverassignment.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case driverassignment.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	case driverassignment.FieldAssignedBy:
+		m.ResetAssignedBy()
+		return nil
+	case driverassignment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverAssignment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DriverAssignmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._driver != nil {
+		edges = append(edges, driverassignment.EdgeDriver)
+	}
+	if m.vehicle != nil {
+		edges = append(edges, driverassignment.EdgeVehicle)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DriverAssignmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case driverassignment.EdgeDriver:
+		if id := m._driver; id != nil {
+			return []ent.Value{*id}
+		}
+	case driverassignment.EdgeVehicle:
+		if id := m.vehicle; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DriverAssignmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DriverAssignmentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DriverAssignmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_driver {
+		edges = append(edges, driverassignment.EdgeDriver)
+	}
+	if m.clearedvehicle {
+		edges = append(edges, driverassignment.EdgeVehicle)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DriverAssignmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case driverassignment.EdgeDriver:
+		return m.cleared_driver
+	case driverassignment.EdgeVehicle:
+		return m.clearedvehicle
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DriverAssignmentMutation) ClearEdge(name string) error {
+	switch name {
+	case driverassignment.EdgeDriver:
+		m.ClearDriver()
+		return nil
+	case driverassignment.EdgeVehicle:
+		m.ClearVehicle()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverAssignment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DriverAssignmentMutation) ResetEdge(name string) error {
+	switch name {
+	case driverassignment.EdgeDriver:
+		m.ResetDriver()
+		return nil
+	case driverassignment.EdgeVehicle:
+		m.ResetVehicle()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverAssignment edge %s", name)
+}
+
+// DriverProfileMutation represents an operation that mutates the DriverProfile nodes in the graph.
+type DriverProfileMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	license_number     *string
+	license_expiry     *time.Time
+	phone              *string
+	tenant_id          *int
+	addtenant_id       *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	assignments        map[int]struct{}
+	removedassignments map[int]struct{}
+	clearedassignments bool
+	done               bool
+	oldValue           func(context.Context) (*DriverProfile, error)
+	predicates         []predicate.DriverProfile
+}
+
+var _ ent.Mutation = (*DriverProfileMutation)(nil)
+
+// driverprofileOption allows management of the mutation configuration using functional options.
+type driverprofileOption func(*DriverProfileMutation)
+
+// newDriverProfileMutation creates new mutation for the DriverProfile entity.
+func newDriverProfileMutation(c config, op Op, opts ...driverprofileOption) *DriverProfileMutation {
+	m := &DriverProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDriverProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDriverProfileID sets the ID field of the mutation.
+func withDriverProfileID(id int) driverprofileOption {
+	return func(m *DriverProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DriverProfile
+		)
+		m.oldValue = func(ctx context.Context) (*DriverProfile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DriverProfile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDriverProfile sets the old DriverProfile of the mutation.
+func withDriverProfile(node *DriverProfile) driverprofileOption {
+	return func(m *DriverProfileMutation) {
+		m.oldValue = func(context.Context) (*DriverProfile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DriverProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DriverProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DriverProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DriverProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DriverProfile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DriverProfileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DriverProfileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DriverProfileMutation) ResetName() {
+	m.name = nil
+}
+
+// SetLicenseNumber sets the "license_number" field.
+func (m *DriverProfileMutation) SetLicenseNumber(s string) {
+	m.license_number = &s
+}
+
+// LicenseNumber returns the value of the "license_number" field in the mutation.
+func (m *DriverProfileMutation) LicenseNumber() (r string, exists bool) {
+	v := m.license_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicenseNumber returns the old "license_number" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldLicenseNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicenseNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicenseNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicenseNumber: %w", err)
+	}
+	return oldValue.LicenseNumber, nil
+}
+
+// ResetLicenseNumber resets all changes to the "license_number" field.
+func (m *DriverProfileMutation) ResetLicenseNumber() {
+	m.license_number = nil
+}
+
+// SetLicenseExpiry sets the "license_expiry" field.
+func (m *DriverProfileMutation) SetLicenseExpiry(t time.Time) {
+	m.license_expiry = &t
+}
+
+// LicenseExpiry returns the value of the "license_expiry" field in the mutation.
+func (m *DriverProfileMutation) LicenseExpiry() (r time.Time, exists bool) {
+	v := m.license_expiry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicenseExpiry returns the old "license_expiry" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldLicenseExpiry(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicenseExpiry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicenseExpiry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicenseExpiry: %w", err)
+	}
+	return oldValue.LicenseExpiry, nil
+}
+
+// ClearLicenseExpiry clears the value of the "license_expiry" field.
+func (m *DriverProfileMutation) ClearLicenseExpiry() {
+	m.license_expiry = nil
+	m.clearedFields[driverprofile.FieldLicenseExpiry] = struct{}{}
+}
+
+// LicenseExpiryCleared returns if the "license_expiry" field was cleared in this mutation.
+func (m *DriverProfileMutation) LicenseExpiryCleared() bool {
+	_, ok := m.clearedFields[driverprofile.FieldLicenseExpiry]
+	return ok
+}
+
+// ResetLicenseExpiry resets all changes to the "license_expiry" field.
+func (m *DriverProfileMutation) ResetLicenseExpiry() {
+	m.license_expiry = nil
+	delete(m.clearedFields, driverprofile.FieldLicenseExpiry)
+}
+
+// SetPhone sets the "phone" field.
+func (m *DriverProfileMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *DriverProfileMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *DriverProfileMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[driverprofile.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *DriverProfileMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[driverprofile.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *DriverProfileMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, driverprofile.FieldPhone)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *DriverProfileMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *DriverProfileMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldTenantID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *DriverProfileMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *DriverProfileMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *DriverProfileMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DriverProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DriverProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DriverProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DriverProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DriverProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DriverProfile entity.
+// If the DriverProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DriverProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddAssignmentIDs adds the "assignments" edge to the DriverAssignment entity by ids.
+func (m *DriverProfileMutation) AddAssignmentIDs(ids ...int) {
+	if m.assignments == nil {
+		m.assignments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAssignments clears the "assignments" edge to the DriverAssignment entity.
+func (m *DriverProfileMutation) ClearAssignments() {
+	m.clearedassignments = true
+}
+
+// AssignmentsCleared reports if the "assignments" edge to the DriverAssignment entity was cleared.
+func (m *DriverProfileMutation) AssignmentsCleared() bool {
+	return m.clearedassignments
+}
+
+// RemoveAssignmentIDs removes the "assignments" edge to the DriverAssignment entity by IDs.
+func (m *DriverProfileMutation) RemoveAssignmentIDs(ids ...int) {
+	if m.removedassignments == nil {
+		m.removedassignments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.assignments, ids[i])
+		m.removedassignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAssignments returns the removed IDs of the "assignments" edge to the DriverAssignment entity.
+func (m *DriverProfileMutation) RemovedAssignmentsIDs() (ids []int) {
+	for id := range m.removedassignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AssignmentsIDs returns the "assignments" edge IDs in the mutation.
+func (m *DriverProfileMutation) AssignmentsIDs() (ids []int) {
+	for id := range m.assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAssignments resets all changes to the "assignments" edge.
+func (m *DriverProfileMutation) ResetAssignments() {
+	m.assignments = nil
+	m.clearedassignments = false
+	m.removedassignments = nil
+}
+
+// Where appends a list predicates to the DriverProfileMutation builder.
+func (m *DriverProfileMutation) Where(ps ...predicate.DriverProfile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DriverProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DriverProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DriverProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DriverProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DriverProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DriverProfile).
+func (m *DriverProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DriverProfileMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, driverprofile.FieldName)
+	}
+	if m.license_number != nil {
+		fields = append(fields, driverprofile.FieldLicenseNumber)
+	}
+	if m.license_expiry != nil {
+		fields = append(fields, driverprofile.FieldLicenseExpiry)
+	}
+	if m.phone != nil {
+		fields = append(fields, driverprofile.FieldPhone)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, driverprofile.FieldTenantID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, driverprofile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, driverprofile.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DriverProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case driverprofile.FieldName:
+		return m.Name()
+	case driverprofile.FieldLicenseNumber:
+		return m.LicenseNumber()
+	case driverprofile.FieldLicenseExpiry:
+		return m.LicenseExpiry()
+	case driverprofile.FieldPhone:
+		return m.Phone()
+	case driverprofile.FieldTenantID:
+		return m.TenantID()
+	case driverprofile.FieldCreatedAt:
+		return m.CreatedAt()
+	case driverprofile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DriverProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case driverprofile.FieldName:
+		return m.OldName(ctx)
+	case driverprofile.FieldLicenseNumber:
+		return m.OldLicenseNumber(ctx)
+	case driverprofile.FieldLicenseExpiry:
+		return m.OldLicenseExpiry(ctx)
+	case driverprofile.FieldPhone:
+		return m.OldPhone(ctx)
+	case driverprofile.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case driverprofile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case driverprofile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DriverProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case driverprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case driverprofile.FieldLicenseNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicenseNumber(v)
+		return nil
+	case driverprofile.FieldLicenseExpiry:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicenseExpiry(v)
+		return nil
+	case driverprofile.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case driverprofile.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case driverprofile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case driverprofile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriverProfile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DriverProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, driverprofile.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DriverProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case driverprofile.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case driverprofile.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriverProfile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DriverProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(driverprofile.FieldLicenseExpiry) {
+		fields = append(fields, driverprofile.FieldLicenseExpiry)
+	}
+	if m.FieldCleared(driverprofile.FieldPhone) {
+		fields = append(fields, driverprofile.FieldPhone)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DriverProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DriverProfileMutation) ClearField(name string) error {
+	switch name {
+	case driverprofile.FieldLicenseExpiry:
+		m.ClearLicenseExpiry()
+		return nil
+	case driverprofile.FieldPhone:
+		m.ClearPhone()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverProfile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DriverProfileMutation) ResetField(name string) error {
+	switch name {
+	case driverprofile.FieldName:
+		m.ResetName()
+		return nil
+	case driverprofile.FieldLicenseNumber:
+		m.ResetLicenseNumber()
+		return nil
+	case driverprofile.FieldLicenseExpiry:
+		m.ResetLicenseExpiry()
+		return nil
+	case driverprofile.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case driverprofile.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case driverprofile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case driverprofile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverProfile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DriverProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.assignments != nil {
+		edges = append(edges, driverprofile.EdgeAssignments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DriverProfileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case driverprofile.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.assignments))
+		for id := range m.assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DriverProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedassignments != nil {
+		edges = append(edges, driverprofile.EdgeAssignments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DriverProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case driverprofile.EdgeAssignments:
+		ids := make([]ent.Value, 0, len(m.removedassignments))
+		for id := range m.removedassignments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DriverProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedassignments {
+		edges = append(edges, driverprofile.EdgeAssignments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DriverProfileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case driverprofile.EdgeAssignments:
+		return m.clearedassignments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DriverProfileMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DriverProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DriverProfileMutation) ResetEdge(name string) error {
+	switch name {
+	case driverprofile.EdgeAssignments:
+		m.ResetAssignments()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverProfile edge %s", name)
+}
+
+// GroupMutation represents an operation that mutates the Group nodes in the graph.
+type GroupMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	description     *string
+	tenant_id       *int
+	addtenant_id    *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	vehicles        map[int]struct{}
+	removedvehicles map[int]struct{}
+	clearedvehicles bool
+	parent          *int
+	clearedparent   bool
+	children        map[int]struct{}
+	removedchildren map[int]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Group, error)
+	predicates      []predicate.Group
+}
+
+var _ ent.Mutation = (*GroupMutation)(nil)
+
+// groupOption allows management of the mutation configuration using functional options.
+type groupOption func(*GroupMutation)
+
+// newGroupMutation creates new mutation for the Group entity.
+func newGroupMutation(c config, op Op, opts ...groupOption) *GroupMutation {
+	m := &GroupMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGroup,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGroupID sets the ID field of the mutation.
+func withGroupID(id int) groupOption {
+	return func(m *GroupMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Group
+		)
+		m.oldValue = func(ctx context.Context) (*Group, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Group.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGroup sets the old Group of the mutation.
+func withGroup(node *Group) groupOption {
+	return func(m *GroupMutation) {
+		m.oldValue = func(context.Context) (*Group, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GroupMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GroupMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GroupMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GroupMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Group.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GroupMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GroupMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GroupMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *GroupMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *GroupMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *GroupMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[group.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *GroupMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[group.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *GroupMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, group.FieldDescription)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *GroupMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *GroupMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *GroupMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[group.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *GroupMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[group.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *GroupMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, group.FieldParentID)
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *GroupMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *GroupMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldTenantID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *GroupMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *GroupMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *GroupMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GroupMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GroupMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GroupMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Group entity.
+// If the Group object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GroupMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GroupMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddVehicleIDs adds the "vehicles" edge to the Vehicle entity by ids.
+func (m *GroupMutation) AddVehicleIDs(ids ...int) {
+	if m.vehicles == nil {
+		m.vehicles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.vehicles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVehicles clears the "vehicles" edge to the Vehicle entity.
+func (m *GroupMutation) ClearVehicles() {
+	m.clearedvehicles = true
+}
+
+// VehiclesCleared reports if the "vehicles" edge to the Vehicle entity was cleared.
+func (m *GroupMutation) VehiclesCleared() bool {
+	return m.clearedvehicles
+}
+
+// RemoveVehicleIDs removes the "vehicles" edge to the Vehicle entity by IDs.
+func (m *GroupMutation) RemoveVehicleIDs(ids ...int) {
+	if m.removedvehicles == nil {
+		m.removedvehicles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.vehicles, ids[i])
+		m.removedvehicles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVehicles returns the removed IDs of the "vehicles" edge to the Vehicle entity.
+func (m *GroupMutation) RemovedVehiclesIDs() (ids []int) {
+	for id := range m.removedvehicles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VehiclesIDs returns the "vehicles" edge IDs in the mutation.
+func (m *GroupMutation) VehiclesIDs() (ids []int) {
+	for id := range m.vehicles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVehicles resets all changes to the "vehicles" edge.
+func (m *GroupMutation) ResetVehicles() {
+	m.vehicles = nil
+	m.clearedvehicles = false
+	m.removedvehicles = nil
+}
+
+// ClearParent clears the "parent" edge to the Group entity.
+func (m *GroupMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[group.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Group entity was cleared.
+func (m *GroupMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *GroupMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *GroupMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Group entity by ids.
+func (m *GroupMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Group entity.
+func (m *GroupMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Group entity was cleared.
+func (m *GroupMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Group entity by IDs.
+func (m *GroupMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Group entity.
+func (m *GroupMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *GroupMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *GroupMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the GroupMutation builder.
+func (m *GroupMutation) Where(ps ...predicate.Group) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GroupMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Group, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GroupMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GroupMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Group).
+func (m *GroupMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GroupMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, group.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.parent != nil {
+		fields = append(fields, group.FieldParentID)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, group.FieldTenantID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, group.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, group.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GroupMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldName:
+		return m.Name()
+	case group.FieldDescription:
+		return m.Description()
+	case group.FieldParentID:
+		return m.ParentID()
+	case group.FieldTenantID:
+		return m.TenantID()
+	case group.FieldCreatedAt:
+		return m.CreatedAt()
+	case group.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case group.FieldName:
+		return m.OldName(ctx)
+	case group.FieldDescription:
+		return m.OldDescription(ctx)
+	case group.FieldParentID:
+		return m.OldParentID(ctx)
+	case group.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case group.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case group.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Group field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case group.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case group.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case group.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case group.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case group.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GroupMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, group.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GroupMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case group.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GroupMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case group.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Group numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GroupMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(group.FieldDescription) {
+		fields = append(fields, group.FieldDescription)
+	}
+	if m.FieldCleared(group.FieldParentID) {
+		fields = append(fields, group.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GroupMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GroupMutation) ClearField(name string) error {
+	switch name {
+	case group.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case group.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Group nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GroupMutation) ResetField(name string) error {
+	switch name {
+	case group.FieldName:
+		m.ResetName()
+		return nil
+	case group.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case group.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case group.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case group.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case group.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Group field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GroupMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.vehicles != nil {
+		edges = append(edges, group.EdgeVehicles)
+	}
+	if m.parent != nil {
+		edges = append(edges, group.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, group.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GroupMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeVehicles:
+		ids := make([]ent.Value, 0, len(m.vehicles))
+		for id := range m.vehicles {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case group.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GroupMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedvehicles != nil {
+		edges = append(edges, group.EdgeVehicles)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, group.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GroupMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case group.EdgeVehicles:
+		ids := make([]ent.Value, 0, len(m.removedvehicles))
+		for id := range m.removedvehicles {
+			ids = append(ids, id)
+		}
+		return ids
+	case group.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GroupMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedvehicles {
+		edges = append(edges, group.EdgeVehicles)
+	}
+	if m.clearedparent {
+		edges = append(edges, group.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, group.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GroupMutation) EdgeCleared(name string) bool {
+	switch name {
+	case group.EdgeVehicles:
+		return m.clearedvehicles
+	case group.EdgeParent:
+		return m.clearedparent
+	case group.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GroupMutation) ClearEdge(name string) error {
+	switch name {
+	case group.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Group unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GroupMutation) ResetEdge(name string) error {
+	switch name {
+	case group.EdgeVehicles:
+		m.ResetVehicles()
+		return nil
+	case group.EdgeParent:
+		m.ResetParent()
+		return nil
+	case group.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Group edge %s", name)
+}
+
+// VehicleMutation represents an operation that mutates the Vehicle nodes in the graph.
+type VehicleMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	deleted_at                *time.Time
+	vin                       *string
+	license_plate             *string
+	status                    *string
+	last_heartbeat            *time.Time
+	location                  **schematype.Location
+	telemetry                 *map[string]interface{}
+	created_at                *time.Time
+	updated_at                *time.Time
+	tenant_id                 *int
+	addtenant_id              *int
+	tags                      *[]string
+	appendtags                []string
+	version                   *int64
+	addversion                *int64
+	make                      *string
+	model                     *string
+	year                      *int
+	addyear                   *int
+	fuel_type                 *vehicle.FuelType
+	attributes                *map[string]string
+	capacity_kg               *int
+	addcapacity_kg            *int
+	clearedFields             map[string]struct{}
+	devices                   map[int]struct{}
+	removeddevices            map[int]struct{}
+	cleareddevices            bool
+	groups                    map[int]struct{}
+	removedgroups             map[int]struct{}
+	clearedgroups             bool
+	driver_assignments        map[int]struct{}
+	removeddriver_assignments map[int]struct{}
+	cleareddriver_assignments bool
+	done                      bool
+	oldValue                  func(context.Context) (*Vehicle, error)
+	predicates                []predicate.Vehicle
+}
+
+var _ ent.Mutation = (*VehicleMutation)(nil)
+
+// vehicleOption allows management of the mutation configuration using functional options.
+type vehicleOption func(*VehicleMutation)
+
+// newVehicleMutation creates new mutation for the Vehicle entity.
+func newVehicleMutation(c config, op Op, opts ...vehicleOption) *VehicleMutation {
+	m := &VehicleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicle,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleID sets the ID field of the mutation.
+func withVehicleID(id int) vehicleOption {
+	return func(m *VehicleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Vehicle
+		)
+		m.oldValue = func(ctx context.Context) (*Vehicle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Vehicle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicle sets the old Vehicle of the mutation.
+func withVehicle(node *Vehicle) vehicleOption {
+	return func(m *VehicleMutation) {
+		m.oldValue = func(context.Context) (*Vehicle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Vehicle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *VehicleMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *VehicleMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *VehicleMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[vehicle.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *VehicleMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *VehicleMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, vehicle.FieldDeletedAt)
+}
+
+// SetVin sets the "vin" field.
+func (m *VehicleMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *VehicleMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldVin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *VehicleMutation) ResetVin() {
+	m.vin = nil
+}
+
+// SetLicensePlate sets the "license_plate" field.
+func (m *VehicleMutation) SetLicensePlate(s string) {
+	m.license_plate = &s
+}
+
+// LicensePlate returns the value of the "license_plate" field in the mutation.
+func (m *VehicleMutation) LicensePlate() (r string, exists bool) {
+	v := m.license_plate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLicensePlate returns the old "license_plate" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldLicensePlate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLicensePlate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLicensePlate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLicensePlate: %w", err)
+	}
+	return oldValue.LicensePlate, nil
+}
+
+// ResetLicensePlate resets all changes to the "license_plate" field.
+func (m *VehicleMutation) ResetLicensePlate() {
+	m.license_plate = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *VehicleMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *VehicleMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *VehicleMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLastHeartbeat sets the "last_heartbeat" field.
+func (m *VehicleMutation) SetLastHeartbeat(t time.Time) {
+	m.last_heartbeat = &t
+}
+
+// LastHeartbeat returns the value of the "last_heartbeat" field in the mutation.
+func (m *VehicleMutation) LastHeartbeat() (r time.Time, exists bool) {
+	v := m.last_heartbeat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastHeartbeat returns the old "last_heartbeat" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldLastHeartbeat(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastHeartbeat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastHeartbeat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastHeartbeat: %w", err)
+	}
+	return oldValue.LastHeartbeat, nil
+}
+
+// ClearLastHeartbeat clears the value of the "last_heartbeat" field.
+func (m *VehicleMutation) ClearLastHeartbeat() {
+	m.last_heartbeat = nil
+	m.clearedFields[vehicle.FieldLastHeartbeat] = struct{}{}
+}
+
+// LastHeartbeatCleared returns if the "last_heartbeat" field was cleared in this mutation.
+func (m *VehicleMutation) LastHeartbeatCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldLastHeartbeat]
+	return ok
+}
+
+// ResetLastHeartbeat resets all changes to the "last_heartbeat" field.
+func (m *VehicleMutation) ResetLastHeartbeat() {
+	m.last_heartbeat = nil
+	delete(m.clearedFields, vehicle.FieldLastHeartbeat)
+}
+
+// SetLocation sets the "location" field.
+func (m *VehicleMutation) SetLocation(s *schematype.Location) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *VehicleMutation) Location() (r *schematype.Location, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldLocation(ctx context.Context) (v *schematype.Location, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *VehicleMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[vehicle.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *VehicleMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *VehicleMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, vehicle.FieldLocation)
+}
+
+// SetTelemetry sets the "telemetry" field.
+func (m *VehicleMutation) SetTelemetry(value map[string]interface{}) {
+	m.telemetry = &value
+}
+
+// Telemetry returns the value of the "telemetry" field in the mutation.
+func (m *VehicleMutation) Telemetry() (r map[string]interface{}, exists bool) {
+	v := m.telemetry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTelemetry returns the old "telemetry" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldTelemetry(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTelemetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTelemetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTelemetry: %w", err)
+	}
+	return oldValue.Telemetry, nil
+}
+
+// ClearTelemetry clears the value of the "telemetry" field.
+func (m *VehicleMutation) ClearTelemetry() {
+	m.telemetry = nil
+	m.clearedFields[vehicle.FieldTelemetry] = struct{}{}
+}
+
+// TelemetryCleared returns if the "telemetry" field was cleared in this mutation.
+func (m *VehicleMutation) TelemetryCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldTelemetry]
+	return ok
+}
+
+// ResetTelemetry resets all changes to the "telemetry" field.
+func (m *VehicleMutation) ResetTelemetry() {
+	m.telemetry = nil
+	delete(m.clearedFields, vehicle.FieldTelemetry)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *VehicleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *VehicleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VehicleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *VehicleMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *VehicleMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldTenantID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *VehicleMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *VehicleMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *VehicleMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[vehicle.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *VehicleMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *VehicleMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, vehicle.FieldTenantID)
+}
+
+// SetTags sets the "tags" field.
+func (m *VehicleMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *VehicleMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *VehicleMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *VehicleMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *VehicleMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[vehicle.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *VehicleMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *VehicleMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, vehicle.FieldTags)
+}
+
+// SetVersion sets the "version" field.
+func (m *VehicleMutation) SetVersion(i int64) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *VehicleMutation) Version() (r int64, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldVersion(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *VehicleMutation) AddVersion(i int64) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *VehicleMutation) AddedVersion() (r int64, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *VehicleMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetMake sets the "make" field.
+func (m *VehicleMutation) SetMake(s string) {
+	m.make = &s
+}
+
+// Make returns the value of the "make" field in the mutation.
+func (m *VehicleMutation) Make() (r string, exists bool) {
+	v := m.make
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMake returns the old "make" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldMake(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMake is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMake requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMake: %w", err)
+	}
+	return oldValue.Make, nil
+}
+
+// ClearMake clears the value of the "make" field.
+func (m *VehicleMutation) ClearMake() {
+	m.make = nil
+	m.clearedFields[vehicle.FieldMake] = struct{}{}
+}
+
+// MakeCleared returns if the "make" field was cleared in this mutation.
+func (m *VehicleMutation) MakeCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldMake]
+	return ok
+}
+
+// ResetMake resets all changes to the "make" field.
+func (m *VehicleMutation) ResetMake() {
+	m.make = nil
+	delete(m.clearedFields, vehicle.FieldMake)
+}
+
+// SetModel sets the "model" field.
+func (m *VehicleMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *VehicleMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ClearModel clears the value of the "model" field.
+func (m *VehicleMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[vehicle.FieldModel] = struct{}{}
+}
+
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *VehicleMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldModel]
+	return ok
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *VehicleMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, vehicle.FieldModel)
+}
+
+// SetYear sets the "year" field.
+func (m *VehicleMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *VehicleMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *VehicleMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *VehicleMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearYear clears the value of the "year" field.
+func (m *VehicleMutation) ClearYear() {
+	m.year = nil
+	m.addyear = nil
+	m.clearedFields[vehicle.FieldYear] = struct{}{}
+}
+
+// YearCleared returns if the "year" field was cleared in this mutation.
+func (m *VehicleMutation) YearCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldYear]
+	return ok
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *VehicleMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+	delete(m.clearedFields, vehicle.FieldYear)
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (m *VehicleMutation) SetFuelType(vt vehicle.FuelType) {
+	m.fuel_type = &vt
+}
+
+// FuelType returns the value of the "fuel_type" field in the mutation.
+func (m *VehicleMutation) FuelType() (r vehicle.FuelType, exists bool) {
+	v := m.fuel_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFuelType returns the old "fuel_type" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldFuelType(ctx context.Context) (v vehicle.FuelType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFuelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFuelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFuelType: %w", err)
+	}
+	return oldValue.FuelType, nil
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (m *VehicleMutation) ClearFuelType() {
+	m.fuel_type = nil
+	m.clearedFields[vehicle.FieldFuelType] = struct{}{}
+}
+
+// FuelTypeCleared returns if the "fuel_type" field was cleared in this mutation.
+func (m *VehicleMutation) FuelTypeCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldFuelType]
+	return ok
+}
+
+// ResetFuelType resets all changes to the "fuel_type" field.
+func (m *VehicleMutation) ResetFuelType() {
+	m.fuel_type = nil
+	delete(m.clearedFields, vehicle.FieldFuelType)
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *VehicleMutation) SetAttributes(value map[string]string) {
+	m.attributes = &value
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *VehicleMutation) Attributes() (r map[string]string, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldAttributes(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (m *VehicleMutation) ClearAttributes() {
+	m.attributes = nil
+	m.clearedFields[vehicle.FieldAttributes] = struct{}{}
+}
+
+// AttributesCleared returns if the "attributes" field was cleared in this mutation.
+func (m *VehicleMutation) AttributesCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldAttributes]
+	return ok
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *VehicleMutation) ResetAttributes() {
+	m.attributes = nil
+	delete(m.clearedFields, vehicle.FieldAttributes)
+}
+
+// SetCapacityKg sets the "capacity_kg" field.
+func (m *VehicleMutation) SetCapacityKg(i int) {
+	m.capacity_kg = &i
+	m.addcapacity_kg = nil
+}
+
+// CapacityKg returns the value of the "capacity_kg" field in the mutation.
+func (m *VehicleMutation) CapacityKg() (r int, exists bool) {
+	v := m.capacity_kg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapacityKg returns the old "capacity_kg" field's value of the Vehicle entity.
+// If the Vehicle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleMutation) OldCapacityKg(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacityKg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacityKg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacityKg: %w", err)
+	}
+	return oldValue.CapacityKg, nil
+}
+
+// AddCapacityKg adds i to the "capacity_kg" field.
+func (m *VehicleMutation) AddCapacityKg(i int) {
+	if m.addcapacity_kg != nil {
+		*m.addcapacity_kg += i
+	} else {
+		m.addcapacity_kg = &i
+	}
+}
+
+// AddedCapacityKg returns the value that was added to the "capacity_kg" field in this mutation.
+func (m *VehicleMutation) AddedCapacityKg() (r int, exists bool) {
+	v := m.addcapacity_kg
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCapacityKg clears the value of the "capacity_kg" field.
+func (m *VehicleMutation) ClearCapacityKg() {
+	m.capacity_kg = nil
+	m.addcapacity_kg = nil
+	m.clearedFields[vehicle.FieldCapacityKg] = struct{}{}
+}
+
+// CapacityKgCleared returns if the "capacity_kg" field was cleared in this mutation.
+func (m *VehicleMutation) CapacityKgCleared() bool {
+	_, ok := m.clearedFields[vehicle.FieldCapacityKg]
+	return ok
+}
+
+// ResetCapacityKg resets all changes to the "capacity_kg" field.
+func (m *VehicleMutation) ResetCapacityKg() {
+	m.capacity_kg = nil
+	m.addcapacity_kg = nil
+	delete(m.clearedFields, vehicle.FieldCapacityKg)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by ids.
+func (m *VehicleMutation) AddDeviceIDs(ids ...int) {
+	if m.devices == nil {
+		m.devices = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.devices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDevices clears the "devices" edge to the Device entity.
+func (m *VehicleMutation) ClearDevices() {
+	m.cleareddevices = true
+}
+
+// DevicesCleared reports if the "devices" edge to the Device entity was cleared.
+func (m *VehicleMutation) DevicesCleared() bool {
+	return m.cleareddevices
+}
+
+// RemoveDeviceIDs removes the "devices" edge to the Device entity by IDs.
+func (m *VehicleMutation) RemoveDeviceIDs(ids ...int) {
+	if m.removeddevices == nil {
+		m.removeddevices = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.devices, ids[i])
+		m.removeddevices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDevices returns the removed IDs of the "devices" edge to the Device entity.
+func (m *VehicleMutation) RemovedDevicesIDs() (ids []int) {
+	for id := range m.removeddevices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DevicesIDs returns the "devices" edge IDs in the mutation.
+func (m *VehicleMutation) DevicesIDs() (ids []int) {
+	for id := range m.devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDevices resets all changes to the "devices" edge.
+func (m *VehicleMutation) ResetDevices() {
+	m.devices = nil
+	m.cleareddevices = false
+	m.removeddevices = nil
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by ids.
+func (m *VehicleMutation) AddGroupIDs(ids ...int) {
+	if m.groups == nil {
+		m.groups = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroups clears the "groups" edge to the Group entity.
+func (m *VehicleMutation) ClearGroups() {
+	m.clearedgroups = true
+}
+
+// GroupsCleared reports if the "groups" edge to the Group entity was cleared.
+func (m *VehicleMutation) GroupsCleared() bool {
+	return m.clearedgroups
+}
+
+// RemoveGroupIDs removes the "groups" edge to the Group entity by IDs.
+func (m *VehicleMutation) RemoveGroupIDs(ids ...int) {
+	if m.removedgroups == nil {
+		m.removedgroups = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.groups, ids[i])
+		m.removedgroups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroups returns the removed IDs of the "groups" edge to the Group entity.
+func (m *VehicleMutation) RemovedGroupsIDs() (ids []int) {
+	for id := range m.removedgroups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupsIDs returns the "groups" edge IDs in the mutation.
+func (m *VehicleMutation) GroupsIDs() (ids []int) {
+	for id := range m.groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroups resets all changes to the "groups" edge.
+func (m *VehicleMutation) ResetGroups() {
+	m.groups = nil
+	m.clearedgroups = false
+	m.removedgroups = nil
+}
+
+// AddDriverAssignmentIDs adds the "driver_assignments" edge to the DriverAssignment entity by ids.
+func (m *VehicleMutation) AddDriverAssignmentIDs(ids ...int) {
+	if m.driver_assignments == nil {
+		m.driver_assignments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.driver_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDriverAssignments clears the "driver_assignments" edge to the DriverAssignment entity.
+func (m *VehicleMutation) ClearDriverAssignments() {
+	m.cleareddriver_assignments = true
+}
+
+// DriverAssignmentsCleared reports if the "driver_assignments" edge to the DriverAssignment entity was cleared.
+func (m *VehicleMutation) DriverAssignmentsCleared() bool {
+	return m.cleareddriver_assignments
+}
+
+// RemoveDriverAssignmentIDs removes the "driver_assignments" edge to the DriverAssignment entity by IDs.
+func (m *VehicleMutation) RemoveDriverAssignmentIDs(ids ...int) {
+	if m.removeddriver_assignments == nil {
+		m.removeddriver_assignments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.driver_assignments, ids[i])
+		m.removeddriver_assignments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDriverAssignments returns the removed IDs of the "driver_assignments" edge to the DriverAssignment entity.
+func (m *VehicleMutation) RemovedDriverAssignmentsIDs() (ids []int) {
+	for id := range m.removeddriver_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DriverAssignmentsIDs returns the "driver_assignments" edge IDs in the mutation.
+func (m *VehicleMutation) DriverAssignmentsIDs() (ids []int) {
+	for id := range m.driver_assignments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDriverAssignments resets all changes to the "driver_assignments" edge.
+func (m *VehicleMutation) ResetDriverAssignments() {
+	m.driver_assignments = nil
+	m.cleareddriver_assignments = false
+	m.removeddriver_assignments = nil
+}
+
+// Where appends a list predicates to the VehicleMutation builder.
+func (m *VehicleMutation) Where(ps ...predicate.Vehicle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Vehicle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Vehicle).
+func (m *VehicleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.deleted_at != nil {
+		fields = append(fields, vehicle.FieldDeletedAt)
+	}
+	if m.vin != nil {
+		fields = append(fields, vehicle.FieldVin)
+	}
+	if m.license_plate != nil {
+		fields = append(fields, vehicle.FieldLicensePlate)
+	}
+	if m.status != nil {
+		fields = append(fields, vehicle.FieldStatus)
+	}
+	if m.last_heartbeat != nil {
+		fields = append(fields, vehicle.FieldLastHeartbeat)
+	}
+	if m.location != nil {
+		fields = append(fields, vehicle.FieldLocation)
+	}
+	if m.telemetry != nil {
+		fields = append(fields, vehicle.FieldTelemetry)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehicle.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, vehicle.FieldUpdatedAt)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, vehicle.FieldTenantID)
+	}
+	if m.tags != nil {
+		fields = append(fields, vehicle.FieldTags)
+	}
+	if m.version != nil {
+		fields = append(fields, vehicle.FieldVersion)
+	}
+	if m.make != nil {
+		fields = append(fields, vehicle.FieldMake)
+	}
+	if m.model != nil {
+		fields = append(fields, vehicle.FieldModel)
+	}
+	if m.year != nil {
+		fields = append(fields, vehicle.FieldYear)
+	}
+	if m.fuel_type != nil {
+		fields = append(fields, vehicle.FieldFuelType)
+	}
+	if m.attributes != nil {
+		fields = append(fields, vehicle.FieldAttributes)
+	}
+	if m.capacity_kg != nil {
+		fields = append(fields, vehicle.FieldCapacityKg)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehicle.FieldDeletedAt:
+		return m.DeletedAt()
+	case vehicle.FieldVin:
+		return m.Vin()
+	case vehicle.FieldLicensePlate:
+		return m.LicensePlate()
+	case vehicle.FieldStatus:
+		return m.Status()
+	case vehicle.FieldLastHeartbeat:
+		return m.LastHeartbeat()
+	case vehicle.FieldLocation:
+		return m.Location()
+	case vehicle.FieldTelemetry:
+		return m.Telemetry()
+	case vehicle.FieldCreatedAt:
+		return m.CreatedAt()
+	case vehicle.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case vehicle.FieldTenantID:
+		return m.TenantID()
+	case vehicle.FieldTags:
+		return m.Tags()
+	case vehicle.FieldVersion:
+		return m.Version()
+	case vehicle.FieldMake:
+		return m.Make()
+	case vehicle.FieldModel:
+		return m.Model()
+	case vehicle.FieldYear:
+		return m.Year()
+	case vehicle.FieldFuelType:
+		return m.FuelType()
+	case vehicle.FieldAttributes:
+		return m.Attributes()
+	case vehicle.FieldCapacityKg:
+		return m.CapacityKg()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehicle.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case vehicle.FieldVin:
+		return m.OldVin(ctx)
+	case vehicle.FieldLicensePlate:
+		return m.OldLicensePlate(ctx)
+	case vehicle.FieldStatus:
+		return m.OldStatus(ctx)
+	case vehicle.FieldLastHeartbeat:
+		return m.OldLastHeartbeat(ctx)
+	case vehicle.FieldLocation:
+		return m.OldLocation(ctx)
+	case vehicle.FieldTelemetry:
+		return m.OldTelemetry(ctx)
+	case vehicle.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case vehicle.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case vehicle.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case vehicle.FieldTags:
+		return m.OldTags(ctx)
+	case vehicle.FieldVersion:
+		return m.OldVersion(ctx)
+	case vehicle.FieldMake:
+		return m.OldMake(ctx)
+	case vehicle.FieldModel:
+		return m.OldModel(ctx)
+	case vehicle.FieldYear:
+		return m.OldYear(ctx)
+	case vehicle.FieldFuelType:
+		return m.OldFuelType(ctx)
+	case vehicle.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case vehicle.FieldCapacityKg:
+		return m.OldCapacityKg(ctx)
+	}
+	return nil, fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehicle.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case vehicle.FieldVin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVin(v)
+		return nil
+	case vehicle.FieldLicensePlate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLicensePlate(v)
+		return nil
+	case vehicle.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStatus(v)
 		return nil
-	case vehicle.FieldLastHeartbeat:
-		v, ok := value.(time.Time)
+	case vehicle.FieldLastHeartbeat:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastHeartbeat(v)
+		return nil
+	case vehicle.FieldLocation:
+		v, ok := value.(*schematype.Location)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case vehicle.FieldTelemetry:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTelemetry(v)
+		return nil
+	case vehicle.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case vehicle.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case vehicle.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case vehicle.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case vehicle.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case vehicle.FieldMake:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMake(v)
+		return nil
+	case vehicle.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case vehicle.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case vehicle.FieldFuelType:
+		v, ok := value.(vehicle.FuelType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFuelType(v)
+		return nil
+	case vehicle.FieldAttributes:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	case vehicle.FieldCapacityKg:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacityKg(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, vehicle.FieldTenantID)
+	}
+	if m.addversion != nil {
+		fields = append(fields, vehicle.FieldVersion)
+	}
+	if m.addyear != nil {
+		fields = append(fields, vehicle.FieldYear)
+	}
+	if m.addcapacity_kg != nil {
+		fields = append(fields, vehicle.FieldCapacityKg)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehicle.FieldTenantID:
+		return m.AddedTenantID()
+	case vehicle.FieldVersion:
+		return m.AddedVersion()
+	case vehicle.FieldYear:
+		return m.AddedYear()
+	case vehicle.FieldCapacityKg:
+		return m.AddedCapacityKg()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehicle.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case vehicle.FieldVersion:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	case vehicle.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case vehicle.FieldCapacityKg:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacityKg(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehicle.FieldDeletedAt) {
+		fields = append(fields, vehicle.FieldDeletedAt)
+	}
+	if m.FieldCleared(vehicle.FieldLastHeartbeat) {
+		fields = append(fields, vehicle.FieldLastHeartbeat)
+	}
+	if m.FieldCleared(vehicle.FieldLocation) {
+		fields = append(fields, vehicle.FieldLocation)
+	}
+	if m.FieldCleared(vehicle.FieldTelemetry) {
+		fields = append(fields, vehicle.FieldTelemetry)
+	}
+	if m.FieldCleared(vehicle.FieldTenantID) {
+		fields = append(fields, vehicle.FieldTenantID)
+	}
+	if m.FieldCleared(vehicle.FieldTags) {
+		fields = append(fields, vehicle.FieldTags)
+	}
+	if m.FieldCleared(vehicle.FieldMake) {
+		fields = append(fields, vehicle.FieldMake)
+	}
+	if m.FieldCleared(vehicle.FieldModel) {
+		fields = append(fields, vehicle.FieldModel)
+	}
+	if m.FieldCleared(vehicle.FieldYear) {
+		fields = append(fields, vehicle.FieldYear)
+	}
+	if m.FieldCleared(vehicle.FieldFuelType) {
+		fields = append(fields, vehicle.FieldFuelType)
+	}
+	if m.FieldCleared(vehicle.FieldAttributes) {
+		fields = append(fields, vehicle.FieldAttributes)
+	}
+	if m.FieldCleared(vehicle.FieldCapacityKg) {
+		fields = append(fields, vehicle.FieldCapacityKg)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleMutation) ClearField(name string) error {
+	switch name {
+	case vehicle.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case vehicle.FieldLastHeartbeat:
+		m.ClearLastHeartbeat()
+		return nil
+	case vehicle.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case vehicle.FieldTelemetry:
+		m.ClearTelemetry()
+		return nil
+	case vehicle.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	case vehicle.FieldTags:
+		m.ClearTags()
+		return nil
+	case vehicle.FieldMake:
+		m.ClearMake()
+		return nil
+	case vehicle.FieldModel:
+		m.ClearModel()
+		return nil
+	case vehicle.FieldYear:
+		m.ClearYear()
+		return nil
+	case vehicle.FieldFuelType:
+		m.ClearFuelType()
+		return nil
+	case vehicle.FieldAttributes:
+		m.ClearAttributes()
+		return nil
+	case vehicle.FieldCapacityKg:
+		m.ClearCapacityKg()
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleMutation) ResetField(name string) error {
+	switch name {
+	case vehicle.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case vehicle.FieldVin:
+		m.ResetVin()
+		return nil
+	case vehicle.FieldLicensePlate:
+		m.ResetLicensePlate()
+		return nil
+	case vehicle.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case vehicle.FieldLastHeartbeat:
+		m.ResetLastHeartbeat()
+		return nil
+	case vehicle.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case vehicle.FieldTelemetry:
+		m.ResetTelemetry()
+		return nil
+	case vehicle.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case vehicle.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case vehicle.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case vehicle.FieldTags:
+		m.ResetTags()
+		return nil
+	case vehicle.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case vehicle.FieldMake:
+		m.ResetMake()
+		return nil
+	case vehicle.FieldModel:
+		m.ResetModel()
+		return nil
+	case vehicle.FieldYear:
+		m.ResetYear()
+		return nil
+	case vehicle.FieldFuelType:
+		m.ResetFuelType()
+		return nil
+	case vehicle.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	case vehicle.FieldCapacityKg:
+		m.ResetCapacityKg()
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.devices != nil {
+		edges = append(edges, vehicle.EdgeDevices)
+	}
+	if m.groups != nil {
+		edges = append(edges, vehicle.EdgeGroups)
+	}
+	if m.driver_assignments != nil {
+		edges = append(edges, vehicle.EdgeDriverAssignments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case vehicle.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.devices))
+		for id := range m.devices {
+			ids = append(ids, id)
+		}
+		return ids
+	case vehicle.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.groups))
+		for id := range m.groups {
+			ids = append(ids, id)
+		}
+		return ids
+	case vehicle.EdgeDriverAssignments:
+		ids := make([]ent.Value, 0, len(m.driver_assignments))
+		for id := range m.driver_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddevices != nil {
+		edges = append(edges, vehicle.EdgeDevices)
+	}
+	if m.removedgroups != nil {
+		edges = append(edges, vehicle.EdgeGroups)
+	}
+	if m.removeddriver_assignments != nil {
+		edges = append(edges, vehicle.EdgeDriverAssignments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case vehicle.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.removeddevices))
+		for id := range m.removeddevices {
+			ids = append(ids, id)
+		}
+		return ids
+	case vehicle.EdgeGroups:
+		ids := make([]ent.Value, 0, len(m.removedgroups))
+		for id := range m.removedgroups {
+			ids = append(ids, id)
+		}
+		return ids
+	case vehicle.EdgeDriverAssignments:
+		ids := make([]ent.Value, 0, len(m.removeddriver_assignments))
+		for id := range m.removeddriver_assignments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareddevices {
+		edges = append(edges, vehicle.EdgeDevices)
+	}
+	if m.clearedgroups {
+		edges = append(edges, vehicle.EdgeGroups)
+	}
+	if m.cleareddriver_assignments {
+		edges = append(edges, vehicle.EdgeDriverAssignments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case vehicle.EdgeDevices:
+		return m.cleareddevices
+	case vehicle.EdgeGroups:
+		return m.clearedgroups
+	case vehicle.EdgeDriverAssignments:
+		return m.cleareddriver_assignments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Vehicle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleMutation) ResetEdge(name string) error {
+	switch name {
+	case vehicle.EdgeDevices:
+		m.ResetDevices()
+		return nil
+	case vehicle.EdgeGroups:
+		m.ResetGroups()
+		return nil
+	case vehicle.EdgeDriverAssignments:
+		m.ResetDriverAssignments()
+		return nil
+	}
+	return fmt.Errorf("unknown Vehicle edge %s", name)
+}
+
+// VehicleEventMutation represents an operation that mutates the VehicleEvent nodes in the graph.
+type VehicleEventMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	vehicle_id     *int
+	addvehicle_id  *int
+	vin            *string
+	tenant_id      *int
+	addtenant_id   *int
+	_type          *vehicleevent.Type
+	snapshot       *jsontext.Value
+	appendsnapshot jsontext.Value
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*VehicleEvent, error)
+	predicates     []predicate.VehicleEvent
+}
+
+var _ ent.Mutation = (*VehicleEventMutation)(nil)
+
+// vehicleeventOption allows management of the mutation configuration using functional options.
+type vehicleeventOption func(*VehicleEventMutation)
+
+// newVehicleEventMutation creates new mutation for the VehicleEvent entity.
+func newVehicleEventMutation(c config, op Op, opts ...vehicleeventOption) *VehicleEventMutation {
+	m := &VehicleEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleEventID sets the ID field of the mutation.
+func withVehicleEventID(id int) vehicleeventOption {
+	return func(m *VehicleEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleEvent
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleEvent sets the old VehicleEvent of the mutation.
+func withVehicleEvent(node *VehicleEvent) vehicleeventOption {
+	return func(m *VehicleEventMutation) {
+		m.oldValue = func(context.Context) (*VehicleEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleEventMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleEventMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleEventMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleEventMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleEventMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetVin sets the "vin" field.
+func (m *VehicleEventMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *VehicleEventMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldVin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *VehicleEventMutation) ResetVin() {
+	m.vin = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *VehicleEventMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *VehicleEventMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldTenantID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *VehicleEventMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *VehicleEventMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *VehicleEventMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[vehicleevent.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *VehicleEventMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[vehicleevent.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *VehicleEventMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, vehicleevent.FieldTenantID)
+}
+
+// SetType sets the "type" field.
+func (m *VehicleEventMutation) SetType(v vehicleevent.Type) {
+	m._type = &v
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *VehicleEventMutation) GetType() (r vehicleevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldType(ctx context.Context) (v vehicleevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *VehicleEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *VehicleEventMutation) SetSnapshot(j jsontext.Value) {
+	m.snapshot = &j
+	m.appendsnapshot = nil
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *VehicleEventMutation) Snapshot() (r jsontext.Value, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldSnapshot(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// AppendSnapshot adds j to the "snapshot" field.
+func (m *VehicleEventMutation) AppendSnapshot(j jsontext.Value) {
+	m.appendsnapshot = append(m.appendsnapshot, j...)
+}
+
+// AppendedSnapshot returns the list of values that were appended to the "snapshot" field in this mutation.
+func (m *VehicleEventMutation) AppendedSnapshot() (jsontext.Value, bool) {
+	if len(m.appendsnapshot) == 0 {
+		return nil, false
+	}
+	return m.appendsnapshot, true
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *VehicleEventMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.appendsnapshot = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VehicleEvent entity.
+// If the VehicleEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the VehicleEventMutation builder.
+func (m *VehicleEventMutation) Where(ps ...predicate.VehicleEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleEvent).
+func (m *VehicleEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehicleevent.FieldVehicleID)
+	}
+	if m.vin != nil {
+		fields = append(fields, vehicleevent.FieldVin)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, vehicleevent.FieldTenantID)
+	}
+	if m._type != nil {
+		fields = append(fields, vehicleevent.FieldType)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, vehicleevent.FieldSnapshot)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehicleevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.VehicleID()
+	case vehicleevent.FieldVin:
+		return m.Vin()
+	case vehicleevent.FieldTenantID:
+		return m.TenantID()
+	case vehicleevent.FieldType:
+		return m.GetType()
+	case vehicleevent.FieldSnapshot:
+		return m.Snapshot()
+	case vehicleevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehicleevent.FieldVin:
+		return m.OldVin(ctx)
+	case vehicleevent.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case vehicleevent.FieldType:
+		return m.OldType(ctx)
+	case vehicleevent.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case vehicleevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehicleevent.FieldVin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVin(v)
+		return nil
+	case vehicleevent.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case vehicleevent.FieldType:
+		v, ok := value.(vehicleevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case vehicleevent.FieldSnapshot:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case vehicleevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *VehicleEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehicleevent.FieldVehicleID)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, vehicleevent.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *VehicleEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehicleevent.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case vehicleevent.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *VehicleEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(vehicleevent.FieldTenantID) {
+		fields = append(fields, vehicleevent.FieldTenantID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *VehicleEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *VehicleEventMutation) ClearField(name string) error {
+	switch name {
+	case vehicleevent.FieldTenantID:
+		m.ClearTenantID()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *VehicleEventMutation) ResetField(name string) error {
+	switch name {
+	case vehicleevent.FieldVehicleID:
+		m.ResetVehicleID()
+		return nil
+	case vehicleevent.FieldVin:
+		m.ResetVin()
+		return nil
+	case vehicleevent.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case vehicleevent.FieldType:
+		m.ResetType()
+		return nil
+	case vehicleevent.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case vehicleevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown VehicleEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *VehicleEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *VehicleEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *VehicleEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *VehicleEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *VehicleEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *VehicleEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *VehicleEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *VehicleEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleEvent edge %s", name)
+}
+
+// VehicleHistoryMutation represents an operation that mutates the VehicleHistory nodes in the graph.
+type VehicleHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	vehicle_id    *int
+	addvehicle_id *int
+	vin           *string
+	tenant_id     *int
+	addtenant_id  *int
+	action        *vehiclehistory.Action
+	actor         *string
+	changes       *[]schematype.FieldChange
+	appendchanges []schematype.FieldChange
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*VehicleHistory, error)
+	predicates    []predicate.VehicleHistory
+}
+
+var _ ent.Mutation = (*VehicleHistoryMutation)(nil)
+
+// vehiclehistoryOption allows management of the mutation configuration using functional options.
+type vehiclehistoryOption func(*VehicleHistoryMutation)
+
+// newVehicleHistoryMutation creates new mutation for the VehicleHistory entity.
+func newVehicleHistoryMutation(c config, op Op, opts ...vehiclehistoryOption) *VehicleHistoryMutation {
+	m := &VehicleHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVehicleHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withVehicleHistoryID sets the ID field of the mutation.
+func withVehicleHistoryID(id int) vehiclehistoryOption {
+	return func(m *VehicleHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *VehicleHistory
+		)
+		m.oldValue = func(ctx context.Context) (*VehicleHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().VehicleHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withVehicleHistory sets the old VehicleHistory of the mutation.
+func withVehicleHistory(node *VehicleHistory) vehiclehistoryOption {
+	return func(m *VehicleHistoryMutation) {
+		m.oldValue = func(context.Context) (*VehicleHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VehicleHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VehicleHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VehicleHistoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VehicleHistoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().VehicleHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (m *VehicleHistoryMutation) SetVehicleID(i int) {
+	m.vehicle_id = &i
+	m.addvehicle_id = nil
+}
+
+// VehicleID returns the value of the "vehicle_id" field in the mutation.
+func (m *VehicleHistoryMutation) VehicleID() (r int, exists bool) {
+	v := m.vehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVehicleID returns the old "vehicle_id" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldVehicleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVehicleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVehicleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVehicleID: %w", err)
+	}
+	return oldValue.VehicleID, nil
+}
+
+// AddVehicleID adds i to the "vehicle_id" field.
+func (m *VehicleHistoryMutation) AddVehicleID(i int) {
+	if m.addvehicle_id != nil {
+		*m.addvehicle_id += i
+	} else {
+		m.addvehicle_id = &i
+	}
+}
+
+// AddedVehicleID returns the value that was added to the "vehicle_id" field in this mutation.
+func (m *VehicleHistoryMutation) AddedVehicleID() (r int, exists bool) {
+	v := m.addvehicle_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVehicleID resets all changes to the "vehicle_id" field.
+func (m *VehicleHistoryMutation) ResetVehicleID() {
+	m.vehicle_id = nil
+	m.addvehicle_id = nil
+}
+
+// SetVin sets the "vin" field.
+func (m *VehicleHistoryMutation) SetVin(s string) {
+	m.vin = &s
+}
+
+// Vin returns the value of the "vin" field in the mutation.
+func (m *VehicleHistoryMutation) Vin() (r string, exists bool) {
+	v := m.vin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVin returns the old "vin" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldVin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVin: %w", err)
+	}
+	return oldValue.Vin, nil
+}
+
+// ResetVin resets all changes to the "vin" field.
+func (m *VehicleHistoryMutation) ResetVin() {
+	m.vin = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *VehicleHistoryMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *VehicleHistoryMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldTenantID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// AddTenantID adds i to the "tenant_id" field.
+func (m *VehicleHistoryMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the "tenant_id" field in this mutation.
+func (m *VehicleHistoryMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (m *VehicleHistoryMutation) ClearTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	m.clearedFields[vehiclehistory.FieldTenantID] = struct{}{}
+}
+
+// TenantIDCleared returns if the "tenant_id" field was cleared in this mutation.
+func (m *VehicleHistoryMutation) TenantIDCleared() bool {
+	_, ok := m.clearedFields[vehiclehistory.FieldTenantID]
+	return ok
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *VehicleHistoryMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+	delete(m.clearedFields, vehiclehistory.FieldTenantID)
+}
+
+// SetAction sets the "action" field.
+func (m *VehicleHistoryMutation) SetAction(v vehiclehistory.Action) {
+	m.action = &v
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *VehicleHistoryMutation) Action() (r vehiclehistory.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldAction(ctx context.Context) (v vehiclehistory.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *VehicleHistoryMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *VehicleHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *VehicleHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *VehicleHistoryMutation) ResetActor() {
+	m.actor = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *VehicleHistoryMutation) SetChanges(sc []schematype.FieldChange) {
+	m.changes = &sc
+	m.appendchanges = nil
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *VehicleHistoryMutation) Changes() (r []schematype.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldChanges(ctx context.Context) (v []schematype.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// AppendChanges adds sc to the "changes" field.
+func (m *VehicleHistoryMutation) AppendChanges(sc []schematype.FieldChange) {
+	m.appendchanges = append(m.appendchanges, sc...)
+}
+
+// AppendedChanges returns the list of values that were appended to the "changes" field in this mutation.
+func (m *VehicleHistoryMutation) AppendedChanges() ([]schematype.FieldChange, bool) {
+	if len(m.appendchanges) == 0 {
+		return nil, false
+	}
+	return m.appendchanges, true
+}
+
+// ClearChanges clears the value of the "changes" field.
+func (m *VehicleHistoryMutation) ClearChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	m.clearedFields[vehiclehistory.FieldChanges] = struct{}{}
+}
+
+// ChangesCleared returns if the "changes" field was cleared in this mutation.
+func (m *VehicleHistoryMutation) ChangesCleared() bool {
+	_, ok := m.clearedFields[vehiclehistory.FieldChanges]
+	return ok
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *VehicleHistoryMutation) ResetChanges() {
+	m.changes = nil
+	m.appendchanges = nil
+	delete(m.clearedFields, vehiclehistory.FieldChanges)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *VehicleHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *VehicleHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the VehicleHistory entity.
+// If the VehicleHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VehicleHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *VehicleHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the VehicleHistoryMutation builder.
+func (m *VehicleHistoryMutation) Where(ps ...predicate.VehicleHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the VehicleHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *VehicleHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.VehicleHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *VehicleHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *VehicleHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (VehicleHistory).
+func (m *VehicleHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *VehicleHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.vehicle_id != nil {
+		fields = append(fields, vehiclehistory.FieldVehicleID)
+	}
+	if m.vin != nil {
+		fields = append(fields, vehiclehistory.FieldVin)
+	}
+	if m.tenant_id != nil {
+		fields = append(fields, vehiclehistory.FieldTenantID)
+	}
+	if m.action != nil {
+		fields = append(fields, vehiclehistory.FieldAction)
+	}
+	if m.actor != nil {
+		fields = append(fields, vehiclehistory.FieldActor)
+	}
+	if m.changes != nil {
+		fields = append(fields, vehiclehistory.FieldChanges)
+	}
+	if m.created_at != nil {
+		fields = append(fields, vehiclehistory.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *VehicleHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclehistory.FieldVehicleID:
+		return m.VehicleID()
+	case vehiclehistory.FieldVin:
+		return m.Vin()
+	case vehiclehistory.FieldTenantID:
+		return m.TenantID()
+	case vehiclehistory.FieldAction:
+		return m.Action()
+	case vehiclehistory.FieldActor:
+		return m.Actor()
+	case vehiclehistory.FieldChanges:
+		return m.Changes()
+	case vehiclehistory.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *VehicleHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case vehiclehistory.FieldVehicleID:
+		return m.OldVehicleID(ctx)
+	case vehiclehistory.FieldVin:
+		return m.OldVin(ctx)
+	case vehiclehistory.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case vehiclehistory.FieldAction:
+		return m.OldAction(ctx)
+	case vehiclehistory.FieldActor:
+		return m.OldActor(ctx)
+	case vehiclehistory.FieldChanges:
+		return m.OldChanges(ctx)
+	case vehiclehistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown VehicleHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *VehicleHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case vehiclehistory.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVehicleID(v)
+		return nil
+	case vehiclehistory.FieldVin:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastHeartbeat(v)
+		m.SetVin(v)
 		return nil
-	case vehicle.FieldLocation:
-		v, ok := value.(*schema.Location)
+	case vehiclehistory.FieldTenantID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetTenantID(v)
 		return nil
-	case vehicle.FieldTelemetry:
-		v, ok := value.(map[string]interface{})
+	case vehiclehistory.FieldAction:
+		v, ok := value.(vehiclehistory.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTelemetry(v)
+		m.SetAction(v)
 		return nil
-	case vehicle.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case vehiclehistory.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetActor(v)
 		return nil
-	case vehicle.FieldUpdatedAt:
+	case vehiclehistory.FieldChanges:
+		v, ok := value.([]schematype.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case vehiclehistory.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Vehicle field %s", name)
+	return fmt.Errorf("unknown VehicleHistory field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *VehicleMutation) AddedFields() []string {
-	return nil
+func (m *VehicleHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addvehicle_id != nil {
+		fields = append(fields, vehiclehistory.FieldVehicleID)
+	}
+	if m.addtenant_id != nil {
+		fields = append(fields, vehiclehistory.FieldTenantID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *VehicleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VehicleHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case vehiclehistory.FieldVehicleID:
+		return m.AddedVehicleID()
+	case vehiclehistory.FieldTenantID:
+		return m.AddedTenantID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *VehicleMutation) AddField(name string, value ent.Value) error {
+func (m *VehicleHistoryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case vehiclehistory.FieldVehicleID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVehicleID(v)
+		return nil
+	case vehiclehistory.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Vehicle numeric field %s", name)
+	return fmt.Errorf("unknown VehicleHistory numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *VehicleMutation) ClearedFields() []string {
+func (m *VehicleHistoryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(vehicle.FieldLastHeartbeat) {
-		fields = append(fields, vehicle.FieldLastHeartbeat)
+	if m.FieldCleared(vehiclehistory.FieldTenantID) {
+		fields = append(fields, vehiclehistory.FieldTenantID)
 	}
-	if m.FieldCleared(vehicle.FieldLocation) {
-		fields = append(fields, vehicle.FieldLocation)
-	}
-	if m.FieldCleared(vehicle.FieldTelemetry) {
-		fields = append(fields, vehicle.FieldTelemetry)
+	if m.FieldCleared(vehiclehistory.FieldChanges) {
+		fields = append(fields, vehiclehistory.FieldChanges)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *VehicleMutation) FieldCleared(name string) bool {
+func (m *VehicleHistoryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *VehicleMutation) ClearField(name string) error {
+func (m *VehicleHistoryMutation) ClearField(name string) error {
 	switch name {
-	case vehicle.FieldLastHeartbeat:
-		m.ClearLastHeartbeat()
-		return nil
-	case vehicle.FieldLocation:
-		m.ClearLocation()
+	case vehiclehistory.FieldTenantID:
+		m.ClearTenantID()
 		return nil
-	case vehicle.FieldTelemetry:
-		m.ClearTelemetry()
+	case vehiclehistory.FieldChanges:
+		m.ClearChanges()
 		return nil
 	}
-	return fmt.Errorf("unknown Vehicle nullable field %s", name)
+	return fmt.Errorf("unknown VehicleHistory nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *VehicleMutation) ResetField(name string) error {
+func (m *VehicleHistoryMutation) ResetField(name string) error {
 	switch name {
-	case vehicle.FieldVin:
-		m.ResetVin()
+	case vehiclehistory.FieldVehicleID:
+		m.ResetVehicleID()
 		return nil
-	case vehicle.FieldLicensePlate:
-		m.ResetLicensePlate()
+	case vehiclehistory.FieldVin:
+		m.ResetVin()
 		return nil
-	case vehicle.FieldStatus:
-		m.ResetStatus()
+	case vehiclehistory.FieldTenantID:
+		m.ResetTenantID()
 		return nil
-	case vehicle.FieldLastHeartbeat:
-		m.ResetLastHeartbeat()
+	case vehiclehistory.FieldAction:
+		m.ResetAction()
 		return nil
-	case vehicle.FieldLocation:
-		m.ResetLocation()
+	case vehiclehistory.FieldActor:
+		m.ResetActor()
 		return nil
-	case vehicle.FieldTelemetry:
-		m.ResetTelemetry()
+	case vehiclehistory.FieldChanges:
+		m.ResetChanges()
 		return nil
-	case vehicle.FieldCreatedAt:
+	case vehiclehistory.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case vehicle.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Vehicle field %s", name)
+	return fmt.Errorf("unknown VehicleHistory field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *VehicleMutation) AddedEdges() []string {
+func (m *VehicleHistoryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *VehicleMutation) AddedIDs(name string) []ent.Value {
+func (m *VehicleHistoryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *VehicleMutation) RemovedEdges() []string {
+func (m *VehicleHistoryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *VehicleMutation) RemovedIDs(name string) []ent.Value {
+func (m *VehicleHistoryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *VehicleMutation) ClearedEdges() []string {
+func (m *VehicleHistoryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *VehicleMutation) EdgeCleared(name string) bool {
+func (m *VehicleHistoryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *VehicleMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Vehicle unique edge %s", name)
+func (m *VehicleHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown VehicleHistory unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *VehicleMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Vehicle edge %s", name)
+func (m *VehicleHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown VehicleHistory edge %s", name)
 }
@@ -6,5 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Device is the predicate function for device builders.
+type Device func(*sql.Selector)
+
+// DriverAssignment is the predicate function for driverassignment builders.
+type DriverAssignment func(*sql.Selector)
+
+// DriverProfile is the predicate function for driverprofile builders.
+type DriverProfile func(*sql.Selector)
+
+// Group is the predicate function for group builders.
+type Group func(*sql.Selector)
+
 // Vehicle is the predicate function for vehicle builders.
 type Vehicle func(*sql.Selector)
+
+// VehicleEvent is the predicate function for vehicleevent builders.
+type VehicleEvent func(*sql.Selector)
+
+// VehicleHistory is the predicate function for vehiclehistory builders.
+type VehicleHistory func(*sql.Selector)
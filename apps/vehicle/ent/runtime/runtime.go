@@ -2,7 +2,169 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/xuewentao/cheya/apps/vehicle/ent/runtime.go
+import (
+	"time"
+
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicleevent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescPrefix is the schema descriptor for prefix field.
+	deviceDescPrefix := deviceFields[0].Descriptor()
+	// device.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	device.PrefixValidator = deviceDescPrefix.Validators[0].(func(string) error)
+	// deviceDescCredentialHash is the schema descriptor for credential_hash field.
+	deviceDescCredentialHash := deviceFields[1].Descriptor()
+	// device.CredentialHashValidator is a validator for the "credential_hash" field. It is called by the builders before save.
+	device.CredentialHashValidator = deviceDescCredentialHash.Validators[0].(func(string) error)
+	// deviceDescCreatedAt is the schema descriptor for created_at field.
+	deviceDescCreatedAt := deviceFields[6].Descriptor()
+	// device.DefaultCreatedAt holds the default value on creation for the created_at field.
+	device.DefaultCreatedAt = deviceDescCreatedAt.Default.(func() time.Time)
+	driverassignmentFields := schema.DriverAssignment{}.Fields()
+	_ = driverassignmentFields
+	// driverassignmentDescCreatedAt is the schema descriptor for created_at field.
+	driverassignmentDescCreatedAt := driverassignmentFields[5].Descriptor()
+	// driverassignment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	driverassignment.DefaultCreatedAt = driverassignmentDescCreatedAt.Default.(func() time.Time)
+	driverprofileFields := schema.DriverProfile{}.Fields()
+	_ = driverprofileFields
+	// driverprofileDescName is the schema descriptor for name field.
+	driverprofileDescName := driverprofileFields[0].Descriptor()
+	// driverprofile.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	driverprofile.NameValidator = func() func(string) error {
+		validators := driverprofileDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// driverprofileDescLicenseNumber is the schema descriptor for license_number field.
+	driverprofileDescLicenseNumber := driverprofileFields[1].Descriptor()
+	// driverprofile.LicenseNumberValidator is a validator for the "license_number" field. It is called by the builders before save.
+	driverprofile.LicenseNumberValidator = func() func(string) error {
+		validators := driverprofileDescLicenseNumber.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(license_number string) error {
+			for _, fn := range fns {
+				if err := fn(license_number); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// driverprofileDescCreatedAt is the schema descriptor for created_at field.
+	driverprofileDescCreatedAt := driverprofileFields[5].Descriptor()
+	// driverprofile.DefaultCreatedAt holds the default value on creation for the created_at field.
+	driverprofile.DefaultCreatedAt = driverprofileDescCreatedAt.Default.(func() time.Time)
+	// driverprofileDescUpdatedAt is the schema descriptor for updated_at field.
+	driverprofileDescUpdatedAt := driverprofileFields[6].Descriptor()
+	// driverprofile.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	driverprofile.DefaultUpdatedAt = driverprofileDescUpdatedAt.Default.(func() time.Time)
+	// driverprofile.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	driverprofile.UpdateDefaultUpdatedAt = driverprofileDescUpdatedAt.UpdateDefault.(func() time.Time)
+	groupFields := schema.Group{}.Fields()
+	_ = groupFields
+	// groupDescName is the schema descriptor for name field.
+	groupDescName := groupFields[0].Descriptor()
+	// group.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	group.NameValidator = func() func(string) error {
+		validators := groupDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// groupDescCreatedAt is the schema descriptor for created_at field.
+	groupDescCreatedAt := groupFields[4].Descriptor()
+	// group.DefaultCreatedAt holds the default value on creation for the created_at field.
+	group.DefaultCreatedAt = groupDescCreatedAt.Default.(func() time.Time)
+	// groupDescUpdatedAt is the schema descriptor for updated_at field.
+	groupDescUpdatedAt := groupFields[5].Descriptor()
+	// group.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	group.DefaultUpdatedAt = groupDescUpdatedAt.Default.(func() time.Time)
+	// group.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	group.UpdateDefaultUpdatedAt = groupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	vehicleMixin := schema.Vehicle{}.Mixin()
+	vehicleMixinHooks0 := vehicleMixin[0].Hooks()
+	vehicleHooks := schema.Vehicle{}.Hooks()
+	vehicle.Hooks[0] = vehicleMixinHooks0[0]
+	vehicle.Hooks[1] = vehicleHooks[0]
+	vehicleMixinInters0 := vehicleMixin[0].Interceptors()
+	vehicle.Interceptors[0] = vehicleMixinInters0[0]
+	vehicleFields := schema.Vehicle{}.Fields()
+	_ = vehicleFields
+	// vehicleDescVin is the schema descriptor for vin field.
+	vehicleDescVin := vehicleFields[0].Descriptor()
+	// vehicle.VinValidator is a validator for the "vin" field. It is called by the builders before save.
+	vehicle.VinValidator = vehicleDescVin.Validators[0].(func(string) error)
+	// vehicleDescLicensePlate is the schema descriptor for license_plate field.
+	vehicleDescLicensePlate := vehicleFields[1].Descriptor()
+	// vehicle.LicensePlateValidator is a validator for the "license_plate" field. It is called by the builders before save.
+	vehicle.LicensePlateValidator = vehicleDescLicensePlate.Validators[0].(func(string) error)
+	// vehicleDescStatus is the schema descriptor for status field.
+	vehicleDescStatus := vehicleFields[2].Descriptor()
+	// vehicle.DefaultStatus holds the default value on creation for the status field.
+	vehicle.DefaultStatus = vehicleDescStatus.Default.(string)
+	// vehicleDescCreatedAt is the schema descriptor for created_at field.
+	vehicleDescCreatedAt := vehicleFields[6].Descriptor()
+	// vehicle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehicle.DefaultCreatedAt = vehicleDescCreatedAt.Default.(func() time.Time)
+	// vehicleDescUpdatedAt is the schema descriptor for updated_at field.
+	vehicleDescUpdatedAt := vehicleFields[7].Descriptor()
+	// vehicle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	vehicle.DefaultUpdatedAt = vehicleDescUpdatedAt.Default.(func() time.Time)
+	// vehicle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	vehicle.UpdateDefaultUpdatedAt = vehicleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// vehicleDescVersion is the schema descriptor for version field.
+	vehicleDescVersion := vehicleFields[10].Descriptor()
+	// vehicle.DefaultVersion holds the default value on creation for the version field.
+	vehicle.DefaultVersion = vehicleDescVersion.Default.(int64)
+	vehicleeventFields := schema.VehicleEvent{}.Fields()
+	_ = vehicleeventFields
+	// vehicleeventDescCreatedAt is the schema descriptor for created_at field.
+	vehicleeventDescCreatedAt := vehicleeventFields[5].Descriptor()
+	// vehicleevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehicleevent.DefaultCreatedAt = vehicleeventDescCreatedAt.Default.(func() time.Time)
+	vehiclehistoryFields := schema.VehicleHistory{}.Fields()
+	_ = vehiclehistoryFields
+	// vehiclehistoryDescCreatedAt is the schema descriptor for created_at field.
+	vehiclehistoryDescCreatedAt := vehiclehistoryFields[6].Descriptor()
+	// vehiclehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	vehiclehistory.DefaultCreatedAt = vehiclehistoryDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
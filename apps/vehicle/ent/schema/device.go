@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Device 车载终端 (遥测上报设备)
+// 每个设备绑定一辆车，开通时签发一次设备凭证，库中只保存 sha256。
+// 设备只能以绑定车辆的 VIN 上报数据。
+type Device struct {
+	ent.Schema
+}
+
+// Fields 定义 devices 表字段
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 凭证的公开前缀 (cd_xxxxxxxx)，用于在列表和日志中识别设备
+		field.String("prefix").
+			Unique().
+			NotEmpty(),
+
+		// 2. 凭证哈希 (sha256)
+		field.String("credential_hash").
+			Sensitive().
+			Unique().
+			NotEmpty(),
+
+		// 3. 名称 / 型号，可选
+		field.String("name").
+			Optional(),
+
+		// 4. 绑定的车辆
+		field.Int("vehicle_id"),
+
+		// 5. 最后上报时间
+		field.Time("last_seen_at").
+			Optional().
+			Nillable(),
+
+		// 6. 吊销时间，非空表示设备已停用
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+
+		// 7. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("vehicle", Vehicle.Type).
+			Ref("devices").
+			Field("vehicle_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DriverProfile 司机
+// 通过 DriverAssignment 记录每段时间驾驶的车辆，遥测报表据此把驾驶行为归属到人。
+// ent 生成的代码中已有 Driver (数据库驱动)，类型名加 Profile 后缀，表名仍为 drivers
+type DriverProfile struct {
+	ent.Schema
+}
+
+// Annotations 表名
+func (DriverProfile) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "drivers"},
+	}
+}
+
+// Fields 定义 drivers 表字段
+func (DriverProfile) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 姓名
+		field.String("name").
+			NotEmpty().
+			MaxLen(64),
+
+		// 2. 驾驶证号，同一租户内唯一
+		field.String("license_number").
+			NotEmpty().
+			MaxLen(32),
+
+		// 3. 驾驶证有效期，过期后不能再分配车辆
+		field.Time("license_expiry").
+			Optional().
+			Nillable(),
+
+		// 4. 联系电话
+		field.String("phone").
+			Optional(),
+
+		// 5. 所属租户
+		field.Int("tenant_id"),
+
+		// 6. 创建 / 更新时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges 定义关联关系
+func (DriverProfile) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 驾驶记录
+		edge.To("assignments", DriverAssignment.Type),
+	}
+}
+
+// Indexes 定义索引
+func (DriverProfile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "license_number").
+			Unique(),
+	}
+}
@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DriverAssignment 司机驾驶车辆的时间段 [started_at, ended_at)
+// ended_at 为空表示正在驾驶；同一时刻一辆车只有一名司机，一名司机也只驾驶一辆车
+type DriverAssignment struct {
+	ent.Schema
+}
+
+// Fields 定义 driver_assignments 表字段
+func (DriverAssignment) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 司机和车辆
+		field.Int("driver_id").
+			Immutable(),
+		field.Int("vehicle_id").
+			Immutable(),
+
+		// 2. 开始 / 结束时间
+		field.Time("started_at").
+			Immutable(),
+		field.Time("ended_at").
+			Optional().
+			Nillable(),
+
+		// 3. 分配人
+		field.String("assigned_by").
+			Immutable(),
+
+		// 4. 创建时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges 定义关联关系
+func (DriverAssignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("driver", DriverProfile.Type).
+			Ref("assignments").
+			Field("driver_id").
+			Unique().
+			Required().
+			Immutable(),
+		edge.From("vehicle", Vehicle.Type).
+			Ref("driver_assignments").
+			Field("vehicle_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes 定义索引
+func (DriverAssignment) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按车辆 / 司机查询时间段
+		index.Fields("vehicle_id", "started_at"),
+		index.Fields("driver_id", "started_at"),
+		// 部分唯一索引: 并发分配时由数据库保证只有一条进行中的记录
+		index.Fields("vehicle_id").
+			Unique().
+			Annotations(entsql.IndexWhere("ended_at IS NULL")).
+			StorageKey("driverassignment_vehicle_id_current"),
+		index.Fields("driver_id").
+			Unique().
+			Annotations(entsql.IndexWhere("ended_at IS NULL")).
+			StorageKey("driverassignment_driver_id_current"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Group 车辆分组 (车队)，按场站、客户线路或区域组织车辆
+// 一辆车可以属于多个分组；分组可以嵌套，子分组只能属于一个上级分组
+type Group struct {
+	ent.Schema
+}
+
+// Fields 定义 groups 表字段
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 名称，同一租户内唯一
+		field.String("name").
+			NotEmpty().
+			MaxLen(64),
+
+		// 2. 描述
+		field.String("description").
+			Optional(),
+
+		// 3. 上级分组，为空表示顶级分组
+		field.Int("parent_id").
+			Optional().
+			Nillable(),
+
+		// 4. 所属租户，分组和成员车辆必须属于同一租户
+		field.Int("tenant_id"),
+
+		// 5. 创建 / 更新时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges 定义关联关系
+func (Group) Edges() []ent.Edge {
+	return []ent.Edge{
+		// 成员车辆 (多对多)
+		edge.To("vehicles", Vehicle.Type),
+		// 子分组
+		edge.To("children", Group.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+	}
+}
+
+// Indexes 定义索引
+func (Group) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "name").
+			Unique(),
+		index.Fields("parent_id"),
+	}
+}
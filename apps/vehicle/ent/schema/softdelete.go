@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+
+	gen "github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/hook"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/intercept"
+)
+
+// SoftDeleteMixin 软删除
+// 删除操作改为写入 deleted_at，查询默认过滤掉已删除的行；
+// 需要查看或恢复已删除数据时使用 SkipSoftDelete(ctx)
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields 删除时间，为空表示未删除
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete 返回的 ctx 中查询包含已删除的行，删除操作变为物理删除
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, softDeleteKey{}, true)
+}
+
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+// Interceptors 查询时过滤已删除的行，包括通过关联边的查询
+func (d SoftDeleteMixin) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if !skipSoftDelete(ctx) {
+				d.notDeleted(q)
+			}
+			return nil
+		}),
+	}
+}
+
+// Hooks 把删除操作改写为更新 deleted_at
+func (d SoftDeleteMixin) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if skipSoftDelete(ctx) {
+					return next.Mutate(ctx, m)
+				}
+				mx, ok := m.(interface {
+					SetOp(ent.Op)
+					Client() *gen.Client
+					SetDeletedAt(time.Time)
+					WhereP(...func(*sql.Selector))
+				})
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				//已删除的行不再重复删除
+				d.notDeleted(mx)
+				mx.SetOp(ent.OpUpdate)
+				mx.SetDeletedAt(time.Now())
+				return mx.Client().Mutate(ctx, m)
+			})
+		}, ent.OpDeleteOne|ent.OpDelete),
+	}
+}
+
+func (SoftDeleteMixin) notDeleted(w interface{ WhereP(...func(*sql.Selector)) }) {
+	w.WhereP(sql.FieldIsNull("deleted_at"))
+}
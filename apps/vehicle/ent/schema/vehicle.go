@@ -1,17 +1,59 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	gen "github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/hook"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
 )
 
-type Vehicle struct{
+type Vehicle struct {
 	ent.Schema
 }
 
+// Mixin 删除车辆只做软删除，可以恢复
+func (Vehicle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		SoftDeleteMixin{},
+	}
+}
+
+// runtimeFields 遥测上报维护的运行数据，只修改这些字段时版本号不变，
+// 不会让正在编辑车辆信息的用户因为心跳而更新失败
+var runtimeFields = map[string]bool{
+	"status":         true,
+	"last_heartbeat": true,
+	"location":       true,
+	"telemetry":      true,
+	"updated_at":     true,
+}
+
+// Hooks 修改主数据 (包括删除和恢复) 时版本号加一
+func (Vehicle) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hook.On(func(next ent.Mutator) ent.Mutator {
+			return hook.VehicleFunc(func(ctx context.Context, m *gen.VehicleMutation) (ent.Value, error) {
+				for _, f := range append(m.Fields(), m.ClearedFields()...) {
+					if !runtimeFields[f] {
+						m.AddVersion(1)
+						break
+					}
+				}
+				return next.Mutate(ctx, m)
+			})
+		}, ent.OpUpdate|ent.OpUpdateOne),
+	}
+}
+
 // Fields 定义数据库字段
 // 对应白皮书 6.1 章节的 vehicles 表设计
 func (Vehicle) Fields() []ent.Field {
@@ -28,9 +70,10 @@ func (Vehicle) Fields() []ent.Field {
 			NotEmpty(),
 
 		// 3. 车辆状态
-		// 定义: VARCHAR(20) DEFAULT 'Offline'
+		// 定义: VARCHAR(20) DEFAULT 'offline'
+		// 取值统一为小写的 online / offline，按状态过滤时精确匹配以使用索引
 		field.String("status").
-			Default("Offline"),
+			Default("offline"),
 
 		// 4. 最后心跳时间
 		// 定义: TIMESTAMP
@@ -42,7 +85,7 @@ func (Vehicle) Fields() []ent.Field {
 		// 5. 当前位置 (JSONB)
 		// 定义: location JSONB
 		// 使用结构体存储，Ent 会自动转为 JSON 存入数据库
-		field.JSON("location", &Location{}).
+		field.JSON("location", &schematype.Location{}).
 			Optional(),
 
 		// 6. 最新遥测数据 (JSONB)
@@ -62,12 +105,58 @@ func (Vehicle) Fields() []ent.Field {
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now), // 每次更新数据自动刷新此时间
+
+		// 9. 所属租户
+		// 引用 auth service 中 organizations 表的 ID (跨服务，不建外键)
+		// 为空的历史数据只有 platform_admin 可见
+		field.Int("tenant_id").
+			Optional(),
+
+		// 10. 标签
+		// JSONB 数组，列表按标签过滤时使用 @> 包含查询
+		field.Strings("tags").
+			Optional(),
+
+		// 11. 版本号 (乐观并发控制)
+		// 修改主数据时加一，网关以 ETag 返回，更新时通过 If-Match 带回
+		field.Int64("version").
+			Default(1),
+
+		// 12. 品牌、型号、年款，报表按品牌 / 车型统计
+		field.String("make").
+			Optional(),
+		field.String("model").
+			Optional(),
+		field.Int("year").
+			Optional(),
+
+		// 13. 燃料类型: 燃油、纯电、混动
+		field.Enum("fuel_type").
+			Values("ice", "ev", "hybrid").
+			Optional(),
+
+		// 14. 自定义属性 (各客户自己的字段，如 "成本中心")
+		// JSONB 对象，列表按属性过滤时使用 @> 包含查询
+		field.JSON("attributes", map[string]string{}).
+			Optional(),
+
+		// 15. 额定载质量 (kg)，报表按载重分档统计
+		field.Int("capacity_kg").
+			Optional(),
 	}
 }
 
 // Edges 定义关联关系
 func (Vehicle) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// 车载终端
+		edge.To("devices", Device.Type),
+		// 所属分组
+		edge.From("groups", Group.Type).
+			Ref("vehicles"),
+		// 司机驾驶记录
+		edge.To("driver_assignments", DriverAssignment.Type),
+	}
 }
 
 // Indexes 定义索引
@@ -76,13 +165,15 @@ func (Vehicle) Indexes() []ent.Index {
 		// 对应白皮书: CREATE INDEX idx_vehicles_status ON vehicles(status);
 		// 优化 "查询所有在线车辆" 的速度
 		index.Fields("status"),
+		// 所有查询都会按租户过滤
+		index.Fields("tenant_id"),
+		// 列表默认按创建时间翻页，心跳时间用于范围过滤和排序
+		index.Fields("created_at"),
+		index.Fields("last_heartbeat"),
+		// 标签和自定义属性的 @> 查询使用 GIN 索引
+		index.Fields("tags").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
+		index.Fields("attributes").
+			Annotations(entsql.IndexTypes(map[string]string{dialect.Postgres: "GIN"})),
 	}
 }
-
-// Location 结构体用于配合 JSON 字段
-// 这不是数据库表，只是 JSON 的数据结构
-type Location struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Address   string  `json:"address"`
-}
\ No newline at end of file
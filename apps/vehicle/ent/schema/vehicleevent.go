@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VehicleEvent 车辆变更事件 (transactional outbox)
+// 由 Vehicle 的 mutation hook 在同一事务中写入，事务回滚时事件一起回滚；
+// WatchVehicles 按 id 顺序读取推送，超过保留时间的事件定期清理。
+type VehicleEvent struct {
+	ent.Schema
+}
+
+// Fields 定义 vehicle_events 表字段
+func (VehicleEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 车辆 ID 和 VIN
+		field.Int("vehicle_id").
+			Immutable(),
+		field.String("vin").
+			Immutable(),
+
+		// 2. 车辆所属租户，推送时按租户过滤
+		field.Int("tenant_id").
+			Optional().
+			Immutable(),
+
+		// 3. 事件类型，恢复车辆记为 created
+		field.Enum("type").
+			Values("created", "updated", "deleted").
+			Immutable(),
+
+		// 4. 变更后的车辆数据 (ent.Vehicle 的 JSON)，删除事件为删除前的数据
+		field.JSON("snapshot", json.RawMessage{}).
+			Immutable(),
+
+		// 5. 发生时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes 定义索引
+func (VehicleEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按时间清理过期事件
+		index.Fields("created_at"),
+	}
+}
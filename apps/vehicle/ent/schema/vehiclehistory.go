@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
+)
+
+// VehicleHistory 车辆变更记录
+// 由 Vehicle 的 mutation hook 在同一事务中写入，只追加不修改。
+// 不建外键，车辆被物理删除后记录仍然保留。
+type VehicleHistory struct {
+	ent.Schema
+}
+
+// Fields 定义 vehicle_histories 表字段
+func (VehicleHistory) Fields() []ent.Field {
+	return []ent.Field{
+		// 1. 车辆 ID 和当时的 VIN
+		field.Int("vehicle_id").
+			Immutable(),
+		field.String("vin").
+			Immutable(),
+
+		// 2. 车辆所属租户，查询历史时按租户过滤
+		field.Int("tenant_id").
+			Optional().
+			Immutable(),
+
+		// 3. 操作类型
+		field.Enum("action").
+			Values("create", "update", "delete", "restore").
+			Immutable(),
+
+		// 4. 操作人: 用户名、device:<VIN>，没有调用者身份时为 system
+		field.String("actor").
+			Immutable(),
+
+		// 5. 字段变更
+		field.JSON("changes", []schematype.FieldChange{}).
+			Optional().
+			Immutable(),
+
+		// 6. 发生时间
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes 定义索引
+func (VehicleHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		// 按车辆倒序查看历史
+		index.Fields("vehicle_id", "created_at"),
+	}
+}
@@ -0,0 +1,18 @@
+// Package schematype JSON 字段使用的结构体
+// 生成的 ent 代码会引用这些类型，单独成包以免与带 hook 的 schema 包循环引用
+package schematype
+
+// Location 结构体用于配合 JSON 字段
+// 这不是数据库表，只是 JSON 的数据结构
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Address   string  `json:"address"`
+}
+
+// FieldChange 单个字段的变更，值为 JSON 格式，nil 表示未设置
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   any    `json:"old,omitempty"`
+	New   any    `json:"new,omitempty"`
+}
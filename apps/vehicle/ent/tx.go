@@ -12,8 +12,20 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Device is the client for interacting with the Device builders.
+	Device *DeviceClient
+	// DriverAssignment is the client for interacting with the DriverAssignment builders.
+	DriverAssignment *DriverAssignmentClient
+	// DriverProfile is the client for interacting with the DriverProfile builders.
+	DriverProfile *DriverProfileClient
+	// Group is the client for interacting with the Group builders.
+	Group *GroupClient
 	// Vehicle is the client for interacting with the Vehicle builders.
 	Vehicle *VehicleClient
+	// VehicleEvent is the client for interacting with the VehicleEvent builders.
+	VehicleEvent *VehicleEventClient
+	// VehicleHistory is the client for interacting with the VehicleHistory builders.
+	VehicleHistory *VehicleHistoryClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +157,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Device = NewDeviceClient(tx.config)
+	tx.DriverAssignment = NewDriverAssignmentClient(tx.config)
+	tx.DriverProfile = NewDriverProfileClient(tx.config)
+	tx.Group = NewGroupClient(tx.config)
 	tx.Vehicle = NewVehicleClient(tx.config)
+	tx.VehicleEvent = NewVehicleEventClient(tx.config)
+	tx.VehicleHistory = NewVehicleHistoryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +173,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Vehicle.QueryXXX(), the query will be executed
+// applies a query, for example: Device.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
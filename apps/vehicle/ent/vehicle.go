@@ -10,7 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
-	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
 )
 
@@ -19,6 +19,8 @@ type Vehicle struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Vin holds the value of the "vin" field.
 	Vin string `json:"vin,omitempty"`
 	// LicensePlate holds the value of the "license_plate" field.
@@ -28,28 +30,89 @@ type Vehicle struct {
 	// LastHeartbeat holds the value of the "last_heartbeat" field.
 	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
 	// Location holds the value of the "location" field.
-	Location *schema.Location `json:"location,omitempty"`
+	Location *schematype.Location `json:"location,omitempty"`
 	// Telemetry holds the value of the "telemetry" field.
 	Telemetry map[string]interface{} `json:"telemetry,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int `json:"tenant_id,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version,omitempty"`
+	// Make holds the value of the "make" field.
+	Make string `json:"make,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Year holds the value of the "year" field.
+	Year int `json:"year,omitempty"`
+	// FuelType holds the value of the "fuel_type" field.
+	FuelType vehicle.FuelType `json:"fuel_type,omitempty"`
+	// Attributes holds the value of the "attributes" field.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// CapacityKg holds the value of the "capacity_kg" field.
+	CapacityKg int `json:"capacity_kg,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VehicleQuery when eager-loading is set.
+	Edges        VehicleEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// VehicleEdges holds the relations/edges for other nodes in the graph.
+type VehicleEdges struct {
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// Groups holds the value of the groups edge.
+	Groups []*Group `json:"groups,omitempty"`
+	// DriverAssignments holds the value of the driver_assignments edge.
+	DriverAssignments []*DriverAssignment `json:"driver_assignments,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e VehicleEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[0] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// GroupsOrErr returns the Groups value or an error if the edge
+// was not loaded in eager-loading.
+func (e VehicleEdges) GroupsOrErr() ([]*Group, error) {
+	if e.loadedTypes[1] {
+		return e.Groups, nil
+	}
+	return nil, &NotLoadedError{edge: "groups"}
+}
+
+// DriverAssignmentsOrErr returns the DriverAssignments value or an error if the edge
+// was not loaded in eager-loading.
+func (e VehicleEdges) DriverAssignmentsOrErr() ([]*DriverAssignment, error) {
+	if e.loadedTypes[2] {
+		return e.DriverAssignments, nil
+	}
+	return nil, &NotLoadedError{edge: "driver_assignments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Vehicle) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case vehicle.FieldLocation, vehicle.FieldTelemetry:
+		case vehicle.FieldLocation, vehicle.FieldTelemetry, vehicle.FieldTags, vehicle.FieldAttributes:
 			values[i] = new([]byte)
-		case vehicle.FieldID:
+		case vehicle.FieldID, vehicle.FieldTenantID, vehicle.FieldVersion, vehicle.FieldYear, vehicle.FieldCapacityKg:
 			values[i] = new(sql.NullInt64)
-		case vehicle.FieldVin, vehicle.FieldLicensePlate, vehicle.FieldStatus:
+		case vehicle.FieldVin, vehicle.FieldLicensePlate, vehicle.FieldStatus, vehicle.FieldMake, vehicle.FieldModel, vehicle.FieldFuelType:
 			values[i] = new(sql.NullString)
-		case vehicle.FieldLastHeartbeat, vehicle.FieldCreatedAt, vehicle.FieldUpdatedAt:
+		case vehicle.FieldDeletedAt, vehicle.FieldLastHeartbeat, vehicle.FieldCreatedAt, vehicle.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -72,6 +135,13 @@ func (_m *Vehicle) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case vehicle.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case vehicle.FieldVin:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field vin", values[i])
@@ -125,6 +195,64 @@ func (_m *Vehicle) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case vehicle.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = int(value.Int64)
+			}
+		case vehicle.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case vehicle.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = value.Int64
+			}
+		case vehicle.FieldMake:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field make", values[i])
+			} else if value.Valid {
+				_m.Make = value.String
+			}
+		case vehicle.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case vehicle.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				_m.Year = int(value.Int64)
+			}
+		case vehicle.FieldFuelType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fuel_type", values[i])
+			} else if value.Valid {
+				_m.FuelType = vehicle.FuelType(value.String)
+			}
+		case vehicle.FieldAttributes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
+		case vehicle.FieldCapacityKg:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity_kg", values[i])
+			} else if value.Valid {
+				_m.CapacityKg = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +266,21 @@ func (_m *Vehicle) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryDevices queries the "devices" edge of the Vehicle entity.
+func (_m *Vehicle) QueryDevices() *DeviceQuery {
+	return NewVehicleClient(_m.config).QueryDevices(_m)
+}
+
+// QueryGroups queries the "groups" edge of the Vehicle entity.
+func (_m *Vehicle) QueryGroups() *GroupQuery {
+	return NewVehicleClient(_m.config).QueryGroups(_m)
+}
+
+// QueryDriverAssignments queries the "driver_assignments" edge of the Vehicle entity.
+func (_m *Vehicle) QueryDriverAssignments() *DriverAssignmentQuery {
+	return NewVehicleClient(_m.config).QueryDriverAssignments(_m)
+}
+
 // Update returns a builder for updating this Vehicle.
 // Note that you need to call Vehicle.Unwrap() before calling this method if this Vehicle
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -161,6 +304,11 @@ func (_m *Vehicle) String() string {
 	var builder strings.Builder
 	builder.WriteString("Vehicle(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("vin=")
 	builder.WriteString(_m.Vin)
 	builder.WriteString(", ")
@@ -186,6 +334,33 @@ func (_m *Vehicle) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TenantID))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("make=")
+	builder.WriteString(_m.Make)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Year))
+	builder.WriteString(", ")
+	builder.WriteString("fuel_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FuelType))
+	builder.WriteString(", ")
+	builder.WriteString("attributes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attributes))
+	builder.WriteString(", ")
+	builder.WriteString("capacity_kg=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CapacityKg))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,9 +3,12 @@
 package vehicle
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -13,6 +16,8 @@ const (
 	Label = "vehicle"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldVin holds the string denoting the vin field in the database.
 	FieldVin = "vin"
 	// FieldLicensePlate holds the string denoting the license_plate field in the database.
@@ -29,13 +34,57 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldMake holds the string denoting the make field in the database.
+	FieldMake = "make"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldYear holds the string denoting the year field in the database.
+	FieldYear = "year"
+	// FieldFuelType holds the string denoting the fuel_type field in the database.
+	FieldFuelType = "fuel_type"
+	// FieldAttributes holds the string denoting the attributes field in the database.
+	FieldAttributes = "attributes"
+	// FieldCapacityKg holds the string denoting the capacity_kg field in the database.
+	FieldCapacityKg = "capacity_kg"
+	// EdgeDevices holds the string denoting the devices edge name in mutations.
+	EdgeDevices = "devices"
+	// EdgeGroups holds the string denoting the groups edge name in mutations.
+	EdgeGroups = "groups"
+	// EdgeDriverAssignments holds the string denoting the driver_assignments edge name in mutations.
+	EdgeDriverAssignments = "driver_assignments"
 	// Table holds the table name of the vehicle in the database.
 	Table = "vehicles"
+	// DevicesTable is the table that holds the devices relation/edge.
+	DevicesTable = "devices"
+	// DevicesInverseTable is the table name for the Device entity.
+	// It exists in this package in order to avoid circular dependency with the "device" package.
+	DevicesInverseTable = "devices"
+	// DevicesColumn is the table column denoting the devices relation/edge.
+	DevicesColumn = "vehicle_id"
+	// GroupsTable is the table that holds the groups relation/edge. The primary key declared below.
+	GroupsTable = "group_vehicles"
+	// GroupsInverseTable is the table name for the Group entity.
+	// It exists in this package in order to avoid circular dependency with the "group" package.
+	GroupsInverseTable = "groups"
+	// DriverAssignmentsTable is the table that holds the driver_assignments relation/edge.
+	DriverAssignmentsTable = "driver_assignments"
+	// DriverAssignmentsInverseTable is the table name for the DriverAssignment entity.
+	// It exists in this package in order to avoid circular dependency with the "driverassignment" package.
+	DriverAssignmentsInverseTable = "driver_assignments"
+	// DriverAssignmentsColumn is the table column denoting the driver_assignments relation/edge.
+	DriverAssignmentsColumn = "vehicle_id"
 )
 
 // Columns holds all SQL columns for vehicle fields.
 var Columns = []string{
 	FieldID,
+	FieldDeletedAt,
 	FieldVin,
 	FieldLicensePlate,
 	FieldStatus,
@@ -44,8 +93,23 @@ var Columns = []string{
 	FieldTelemetry,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldTenantID,
+	FieldTags,
+	FieldVersion,
+	FieldMake,
+	FieldModel,
+	FieldYear,
+	FieldFuelType,
+	FieldAttributes,
+	FieldCapacityKg,
 }
 
+var (
+	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
+	// primary key for the groups relation (M2M).
+	GroupsPrimaryKey = []string{"group_id", "vehicle_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -56,7 +120,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/xuewentao/cheya/apps/vehicle/ent/runtime"
 var (
+	Hooks        [2]ent.Hook
+	Interceptors [1]ent.Interceptor
 	// VinValidator is a validator for the "vin" field. It is called by the builders before save.
 	VinValidator func(string) error
 	// LicensePlateValidator is a validator for the "license_plate" field. It is called by the builders before save.
@@ -69,8 +140,34 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int64
+)
+
+// FuelType defines the type for the "fuel_type" enum field.
+type FuelType string
+
+// FuelType values.
+const (
+	FuelTypeIce    FuelType = "ice"
+	FuelTypeEv     FuelType = "ev"
+	FuelTypeHybrid FuelType = "hybrid"
 )
 
+func (ft FuelType) String() string {
+	return string(ft)
+}
+
+// FuelTypeValidator is a validator for the "fuel_type" field enum values. It is called by the builders before save.
+func FuelTypeValidator(ft FuelType) error {
+	switch ft {
+	case FuelTypeIce, FuelTypeEv, FuelTypeHybrid:
+		return nil
+	default:
+		return fmt.Errorf("vehicle: invalid enum value for fuel_type field: %q", ft)
+	}
+}
+
 // OrderOption defines the ordering options for the Vehicle queries.
 type OrderOption func(*sql.Selector)
 
@@ -79,6 +176,11 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByVin orders the results by the vin field.
 func ByVin(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVin, opts...).ToFunc()
@@ -108,3 +210,101 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByMake orders the results by the make field.
+func ByMake(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMake, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByYear orders the results by the year field.
+func ByYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYear, opts...).ToFunc()
+}
+
+// ByFuelType orders the results by the fuel_type field.
+func ByFuelType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFuelType, opts...).ToFunc()
+}
+
+// ByCapacityKg orders the results by the capacity_kg field.
+func ByCapacityKg(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacityKg, opts...).ToFunc()
+}
+
+// ByDevicesCount orders the results by devices count.
+func ByDevicesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDevicesStep(), opts...)
+	}
+}
+
+// ByDevices orders the results by devices terms.
+func ByDevices(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDevicesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByGroupsCount orders the results by groups count.
+func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGroupsStep(), opts...)
+	}
+}
+
+// ByGroups orders the results by groups terms.
+func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDriverAssignmentsCount orders the results by driver_assignments count.
+func ByDriverAssignmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDriverAssignmentsStep(), opts...)
+	}
+}
+
+// ByDriverAssignments orders the results by driver_assignments terms.
+func ByDriverAssignments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDriverAssignmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDevicesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DevicesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+	)
+}
+func newGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+	)
+}
+func newDriverAssignmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DriverAssignmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DriverAssignmentsTable, DriverAssignmentsColumn),
+	)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
 )
 
@@ -54,6 +55,11 @@ func IDLTE(id int) predicate.Vehicle {
 	return predicate.Vehicle(sql.FieldLTE(FieldID, id))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // Vin applies equality check predicate on the "vin" field. It's identical to VinEQ.
 func Vin(v string) predicate.Vehicle {
 	return predicate.Vehicle(sql.FieldEQ(FieldVin, v))
@@ -84,6 +90,86 @@ func UpdatedAt(v time.Time) predicate.Vehicle {
 	return predicate.Vehicle(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldVersion, v))
+}
+
+// Make applies equality check predicate on the "make" field. It's identical to MakeEQ.
+func Make(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldMake, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldModel, v))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldYear, v))
+}
+
+// CapacityKg applies equality check predicate on the "capacity_kg" field. It's identical to CapacityKgEQ.
+func CapacityKg(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldCapacityKg, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // VinEQ applies the EQ predicate on the "vin" field.
 func VinEQ(v string) predicate.Vehicle {
 	return predicate.Vehicle(sql.FieldEQ(FieldVin, v))
@@ -429,6 +515,465 @@ func UpdatedAtLTE(v time.Time) predicate.Vehicle {
 	return predicate.Vehicle(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldTenantID))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldTags))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int64) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldVersion, v))
+}
+
+// MakeEQ applies the EQ predicate on the "make" field.
+func MakeEQ(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldMake, v))
+}
+
+// MakeNEQ applies the NEQ predicate on the "make" field.
+func MakeNEQ(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldMake, v))
+}
+
+// MakeIn applies the In predicate on the "make" field.
+func MakeIn(vs ...string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldMake, vs...))
+}
+
+// MakeNotIn applies the NotIn predicate on the "make" field.
+func MakeNotIn(vs ...string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldMake, vs...))
+}
+
+// MakeGT applies the GT predicate on the "make" field.
+func MakeGT(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldMake, v))
+}
+
+// MakeGTE applies the GTE predicate on the "make" field.
+func MakeGTE(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldMake, v))
+}
+
+// MakeLT applies the LT predicate on the "make" field.
+func MakeLT(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldMake, v))
+}
+
+// MakeLTE applies the LTE predicate on the "make" field.
+func MakeLTE(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldMake, v))
+}
+
+// MakeContains applies the Contains predicate on the "make" field.
+func MakeContains(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldContains(FieldMake, v))
+}
+
+// MakeHasPrefix applies the HasPrefix predicate on the "make" field.
+func MakeHasPrefix(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldHasPrefix(FieldMake, v))
+}
+
+// MakeHasSuffix applies the HasSuffix predicate on the "make" field.
+func MakeHasSuffix(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldHasSuffix(FieldMake, v))
+}
+
+// MakeIsNil applies the IsNil predicate on the "make" field.
+func MakeIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldMake))
+}
+
+// MakeNotNil applies the NotNil predicate on the "make" field.
+func MakeNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldMake))
+}
+
+// MakeEqualFold applies the EqualFold predicate on the "make" field.
+func MakeEqualFold(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEqualFold(FieldMake, v))
+}
+
+// MakeContainsFold applies the ContainsFold predicate on the "make" field.
+func MakeContainsFold(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldContainsFold(FieldMake, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelIsNil applies the IsNil predicate on the "model" field.
+func ModelIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldModel))
+}
+
+// ModelNotNil applies the NotNil predicate on the "model" field.
+func ModelNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldModel))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldContainsFold(FieldModel, v))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldYear, v))
+}
+
+// YearIsNil applies the IsNil predicate on the "year" field.
+func YearIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldYear))
+}
+
+// YearNotNil applies the NotNil predicate on the "year" field.
+func YearNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldYear))
+}
+
+// FuelTypeEQ applies the EQ predicate on the "fuel_type" field.
+func FuelTypeEQ(v FuelType) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldFuelType, v))
+}
+
+// FuelTypeNEQ applies the NEQ predicate on the "fuel_type" field.
+func FuelTypeNEQ(v FuelType) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldFuelType, v))
+}
+
+// FuelTypeIn applies the In predicate on the "fuel_type" field.
+func FuelTypeIn(vs ...FuelType) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldFuelType, vs...))
+}
+
+// FuelTypeNotIn applies the NotIn predicate on the "fuel_type" field.
+func FuelTypeNotIn(vs ...FuelType) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldFuelType, vs...))
+}
+
+// FuelTypeIsNil applies the IsNil predicate on the "fuel_type" field.
+func FuelTypeIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldFuelType))
+}
+
+// FuelTypeNotNil applies the NotNil predicate on the "fuel_type" field.
+func FuelTypeNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldFuelType))
+}
+
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldAttributes))
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldAttributes))
+}
+
+// CapacityKgEQ applies the EQ predicate on the "capacity_kg" field.
+func CapacityKgEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldEQ(FieldCapacityKg, v))
+}
+
+// CapacityKgNEQ applies the NEQ predicate on the "capacity_kg" field.
+func CapacityKgNEQ(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNEQ(FieldCapacityKg, v))
+}
+
+// CapacityKgIn applies the In predicate on the "capacity_kg" field.
+func CapacityKgIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIn(FieldCapacityKg, vs...))
+}
+
+// CapacityKgNotIn applies the NotIn predicate on the "capacity_kg" field.
+func CapacityKgNotIn(vs ...int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotIn(FieldCapacityKg, vs...))
+}
+
+// CapacityKgGT applies the GT predicate on the "capacity_kg" field.
+func CapacityKgGT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGT(FieldCapacityKg, v))
+}
+
+// CapacityKgGTE applies the GTE predicate on the "capacity_kg" field.
+func CapacityKgGTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldGTE(FieldCapacityKg, v))
+}
+
+// CapacityKgLT applies the LT predicate on the "capacity_kg" field.
+func CapacityKgLT(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLT(FieldCapacityKg, v))
+}
+
+// CapacityKgLTE applies the LTE predicate on the "capacity_kg" field.
+func CapacityKgLTE(v int) predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldLTE(FieldCapacityKg, v))
+}
+
+// CapacityKgIsNil applies the IsNil predicate on the "capacity_kg" field.
+func CapacityKgIsNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldIsNull(FieldCapacityKg))
+}
+
+// CapacityKgNotNil applies the NotNil predicate on the "capacity_kg" field.
+func CapacityKgNotNil() predicate.Vehicle {
+	return predicate.Vehicle(sql.FieldNotNull(FieldCapacityKg))
+}
+
+// HasDevices applies the HasEdge predicate on the "devices" edge.
+func HasDevices() predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDevicesWith applies the HasEdge predicate on the "devices" edge with a given conditions (other predicates).
+func HasDevicesWith(preds ...predicate.Device) predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := newDevicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGroups applies the HasEdge predicate on the "groups" edge.
+func HasGroups() predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGroupsWith applies the HasEdge predicate on the "groups" edge with a given conditions (other predicates).
+func HasGroupsWith(preds ...predicate.Group) predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := newGroupsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDriverAssignments applies the HasEdge predicate on the "driver_assignments" edge.
+func HasDriverAssignments() predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DriverAssignmentsTable, DriverAssignmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDriverAssignmentsWith applies the HasEdge predicate on the "driver_assignments" edge with a given conditions (other predicates).
+func HasDriverAssignmentsWith(preds ...predicate.DriverAssignment) predicate.Vehicle {
+	return predicate.Vehicle(func(s *sql.Selector) {
+		step := newDriverAssignmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Vehicle) predicate.Vehicle {
 	return predicate.Vehicle(sql.AndPredicates(predicates...))
@@ -10,7 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
 )
 
@@ -21,6 +24,20 @@ type VehicleCreate struct {
 	hooks    []Hook
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *VehicleCreate) SetDeletedAt(v time.Time) *VehicleCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableDeletedAt(v *time.Time) *VehicleCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetVin sets the "vin" field.
 func (_c *VehicleCreate) SetVin(v string) *VehicleCreate {
 	_c.mutation.SetVin(v)
@@ -62,7 +79,7 @@ func (_c *VehicleCreate) SetNillableLastHeartbeat(v *time.Time) *VehicleCreate {
 }
 
 // SetLocation sets the "location" field.
-func (_c *VehicleCreate) SetLocation(v *schema.Location) *VehicleCreate {
+func (_c *VehicleCreate) SetLocation(v *schematype.Location) *VehicleCreate {
 	_c.mutation.SetLocation(v)
 	return _c
 }
@@ -101,6 +118,161 @@ func (_c *VehicleCreate) SetNillableUpdatedAt(v *time.Time) *VehicleCreate {
 	return _c
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_c *VehicleCreate) SetTenantID(v int) *VehicleCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableTenantID(v *int) *VehicleCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *VehicleCreate) SetTags(v []string) *VehicleCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *VehicleCreate) SetVersion(v int64) *VehicleCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableVersion(v *int64) *VehicleCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetMake sets the "make" field.
+func (_c *VehicleCreate) SetMake(v string) *VehicleCreate {
+	_c.mutation.SetMake(v)
+	return _c
+}
+
+// SetNillableMake sets the "make" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableMake(v *string) *VehicleCreate {
+	if v != nil {
+		_c.SetMake(*v)
+	}
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *VehicleCreate) SetModel(v string) *VehicleCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableModel(v *string) *VehicleCreate {
+	if v != nil {
+		_c.SetModel(*v)
+	}
+	return _c
+}
+
+// SetYear sets the "year" field.
+func (_c *VehicleCreate) SetYear(v int) *VehicleCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableYear(v *int) *VehicleCreate {
+	if v != nil {
+		_c.SetYear(*v)
+	}
+	return _c
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_c *VehicleCreate) SetFuelType(v vehicle.FuelType) *VehicleCreate {
+	_c.mutation.SetFuelType(v)
+	return _c
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableFuelType(v *vehicle.FuelType) *VehicleCreate {
+	if v != nil {
+		_c.SetFuelType(*v)
+	}
+	return _c
+}
+
+// SetAttributes sets the "attributes" field.
+func (_c *VehicleCreate) SetAttributes(v map[string]string) *VehicleCreate {
+	_c.mutation.SetAttributes(v)
+	return _c
+}
+
+// SetCapacityKg sets the "capacity_kg" field.
+func (_c *VehicleCreate) SetCapacityKg(v int) *VehicleCreate {
+	_c.mutation.SetCapacityKg(v)
+	return _c
+}
+
+// SetNillableCapacityKg sets the "capacity_kg" field if the given value is not nil.
+func (_c *VehicleCreate) SetNillableCapacityKg(v *int) *VehicleCreate {
+	if v != nil {
+		_c.SetCapacityKg(*v)
+	}
+	return _c
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_c *VehicleCreate) AddDeviceIDs(ids ...int) *VehicleCreate {
+	_c.mutation.AddDeviceIDs(ids...)
+	return _c
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_c *VehicleCreate) AddDevices(v ...*Device) *VehicleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeviceIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (_c *VehicleCreate) AddGroupIDs(ids ...int) *VehicleCreate {
+	_c.mutation.AddGroupIDs(ids...)
+	return _c
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (_c *VehicleCreate) AddGroups(v ...*Group) *VehicleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGroupIDs(ids...)
+}
+
+// AddDriverAssignmentIDs adds the "driver_assignments" edge to the DriverAssignment entity by IDs.
+func (_c *VehicleCreate) AddDriverAssignmentIDs(ids ...int) *VehicleCreate {
+	_c.mutation.AddDriverAssignmentIDs(ids...)
+	return _c
+}
+
+// AddDriverAssignments adds the "driver_assignments" edges to the DriverAssignment entity.
+func (_c *VehicleCreate) AddDriverAssignments(v ...*DriverAssignment) *VehicleCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDriverAssignmentIDs(ids...)
+}
+
 // Mutation returns the VehicleMutation object of the builder.
 func (_c *VehicleCreate) Mutation() *VehicleMutation {
 	return _c.mutation
@@ -108,7 +280,9 @@ func (_c *VehicleCreate) Mutation() *VehicleMutation {
 
 // Save creates the Vehicle in the database.
 func (_c *VehicleCreate) Save(ctx context.Context) (*Vehicle, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -135,19 +309,30 @@ func (_c *VehicleCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *VehicleCreate) defaults() {
+func (_c *VehicleCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := vehicle.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if vehicle.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized vehicle.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := vehicle.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if vehicle.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized vehicle.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := vehicle.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := vehicle.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -177,6 +362,14 @@ func (_c *VehicleCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Vehicle.updated_at"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Vehicle.version"`)}
+	}
+	if v, ok := _c.mutation.FuelType(); ok {
+		if err := vehicle.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`ent: validator failed for field "Vehicle.fuel_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -203,6 +396,10 @@ func (_c *VehicleCreate) createSpec() (*Vehicle, *sqlgraph.CreateSpec) {
 		_node = &Vehicle{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(vehicle.Table, sqlgraph.NewFieldSpec(vehicle.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(vehicle.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.Vin(); ok {
 		_spec.SetField(vehicle.FieldVin, field.TypeString, value)
 		_node.Vin = value
@@ -235,6 +432,90 @@ func (_c *VehicleCreate) createSpec() (*Vehicle, *sqlgraph.CreateSpec) {
 		_spec.SetField(vehicle.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(vehicle.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(vehicle.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(vehicle.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Make(); ok {
+		_spec.SetField(vehicle.FieldMake, field.TypeString, value)
+		_node.Make = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(vehicle.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(vehicle.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.FuelType(); ok {
+		_spec.SetField(vehicle.FieldFuelType, field.TypeEnum, value)
+		_node.FuelType = value
+	}
+	if value, ok := _c.mutation.Attributes(); ok {
+		_spec.SetField(vehicle.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := _c.mutation.CapacityKg(); ok {
+		_spec.SetField(vehicle.FieldCapacityKg, field.TypeInt, value)
+		_node.CapacityKg = value
+	}
+	if nodes := _c.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DriverAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
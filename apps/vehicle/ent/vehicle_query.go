@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
 )
@@ -18,10 +22,13 @@ import (
 // VehicleQuery is the builder for querying Vehicle entities.
 type VehicleQuery struct {
 	config
-	ctx        *QueryContext
-	order      []vehicle.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Vehicle
+	ctx                   *QueryContext
+	order                 []vehicle.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Vehicle
+	withDevices           *DeviceQuery
+	withGroups            *GroupQuery
+	withDriverAssignments *DriverAssignmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +65,72 @@ func (_q *VehicleQuery) Order(o ...vehicle.OrderOption) *VehicleQuery {
 	return _q
 }
 
+// QueryDevices chains the current query on the "devices" edge.
+func (_q *VehicleQuery) QueryDevices() *DeviceQuery {
+	query := (&DeviceClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, selector),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehicle.DevicesTable, vehicle.DevicesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGroups chains the current query on the "groups" edge.
+func (_q *VehicleQuery) QueryGroups() *GroupQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, selector),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, vehicle.GroupsTable, vehicle.GroupsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDriverAssignments chains the current query on the "driver_assignments" edge.
+func (_q *VehicleQuery) QueryDriverAssignments() *DriverAssignmentQuery {
+	query := (&DriverAssignmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(vehicle.Table, vehicle.FieldID, selector),
+			sqlgraph.To(driverassignment.Table, driverassignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, vehicle.DriverAssignmentsTable, vehicle.DriverAssignmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Vehicle entity from the query.
 // Returns a *NotFoundError when no Vehicle was found.
 func (_q *VehicleQuery) First(ctx context.Context) (*Vehicle, error) {
@@ -245,29 +318,65 @@ func (_q *VehicleQuery) Clone() *VehicleQuery {
 		return nil
 	}
 	return &VehicleQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]vehicle.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Vehicle{}, _q.predicates...),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]vehicle.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.Vehicle{}, _q.predicates...),
+		withDevices:           _q.withDevices.Clone(),
+		withGroups:            _q.withGroups.Clone(),
+		withDriverAssignments: _q.withDriverAssignments.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithDevices tells the query-builder to eager-load the nodes that are connected to
+// the "devices" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VehicleQuery) WithDevices(opts ...func(*DeviceQuery)) *VehicleQuery {
+	query := (&DeviceClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDevices = query
+	return _q
+}
+
+// WithGroups tells the query-builder to eager-load the nodes that are connected to
+// the "groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VehicleQuery) WithGroups(opts ...func(*GroupQuery)) *VehicleQuery {
+	query := (&GroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGroups = query
+	return _q
+}
+
+// WithDriverAssignments tells the query-builder to eager-load the nodes that are connected to
+// the "driver_assignments" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *VehicleQuery) WithDriverAssignments(opts ...func(*DriverAssignmentQuery)) *VehicleQuery {
+	query := (&DriverAssignmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDriverAssignments = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		Vin string `json:"vin,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Vehicle.Query().
-//		GroupBy(vehicle.FieldVin).
+//		GroupBy(vehicle.FieldDeletedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *VehicleQuery) GroupBy(field string, fields ...string) *VehicleGroupBy {
@@ -285,11 +394,11 @@ func (_q *VehicleQuery) GroupBy(field string, fields ...string) *VehicleGroupBy
 // Example:
 //
 //	var v []struct {
-//		Vin string `json:"vin,omitempty"`
+//		DeletedAt time.Time `json:"deleted_at,omitempty"`
 //	}
 //
 //	client.Vehicle.Query().
-//		Select(vehicle.FieldVin).
+//		Select(vehicle.FieldDeletedAt).
 //		Scan(ctx, &v)
 func (_q *VehicleQuery) Select(fields ...string) *VehicleSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -332,8 +441,13 @@ func (_q *VehicleQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *VehicleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vehicle, error) {
 	var (
-		nodes = []*Vehicle{}
-		_spec = _q.querySpec()
+		nodes       = []*Vehicle{}
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withDevices != nil,
+			_q.withGroups != nil,
+			_q.withDriverAssignments != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Vehicle).scanValues(nil, columns)
@@ -341,6 +455,7 @@ func (_q *VehicleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vehi
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Vehicle{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +467,154 @@ func (_q *VehicleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Vehi
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withDevices; query != nil {
+		if err := _q.loadDevices(ctx, query, nodes,
+			func(n *Vehicle) { n.Edges.Devices = []*Device{} },
+			func(n *Vehicle, e *Device) { n.Edges.Devices = append(n.Edges.Devices, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGroups; query != nil {
+		if err := _q.loadGroups(ctx, query, nodes,
+			func(n *Vehicle) { n.Edges.Groups = []*Group{} },
+			func(n *Vehicle, e *Group) { n.Edges.Groups = append(n.Edges.Groups, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDriverAssignments; query != nil {
+		if err := _q.loadDriverAssignments(ctx, query, nodes,
+			func(n *Vehicle) { n.Edges.DriverAssignments = []*DriverAssignment{} },
+			func(n *Vehicle, e *DriverAssignment) {
+				n.Edges.DriverAssignments = append(n.Edges.DriverAssignments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *VehicleQuery) loadDevices(ctx context.Context, query *DeviceQuery, nodes []*Vehicle, init func(*Vehicle), assign func(*Vehicle, *Device)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Vehicle)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(device.FieldVehicleID)
+	}
+	query.Where(predicate.Device(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vehicle.DevicesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VehicleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vehicle_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *VehicleQuery) loadGroups(ctx context.Context, query *GroupQuery, nodes []*Vehicle, init func(*Vehicle), assign func(*Vehicle, *Group)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Vehicle)
+	nids := make(map[int]map[*Vehicle]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(vehicle.GroupsTable)
+		s.Join(joinT).On(s.C(group.FieldID), joinT.C(vehicle.GroupsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(vehicle.GroupsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(vehicle.GroupsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Vehicle]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Group](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *VehicleQuery) loadDriverAssignments(ctx context.Context, query *DriverAssignmentQuery, nodes []*Vehicle, init func(*Vehicle), assign func(*Vehicle, *DriverAssignment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Vehicle)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(driverassignment.FieldVehicleID)
+	}
+	query.Where(predicate.DriverAssignment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(vehicle.DriverAssignmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VehicleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "vehicle_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *VehicleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
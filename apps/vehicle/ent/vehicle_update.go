@@ -10,9 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
-	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
 )
 
@@ -29,6 +33,26 @@ func (_u *VehicleUpdate) Where(ps ...predicate.Vehicle) *VehicleUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *VehicleUpdate) SetDeletedAt(v time.Time) *VehicleUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableDeletedAt(v *time.Time) *VehicleUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *VehicleUpdate) ClearDeletedAt() *VehicleUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetVin sets the "vin" field.
 func (_u *VehicleUpdate) SetVin(v string) *VehicleUpdate {
 	_u.mutation.SetVin(v)
@@ -92,7 +116,7 @@ func (_u *VehicleUpdate) ClearLastHeartbeat() *VehicleUpdate {
 }
 
 // SetLocation sets the "location" field.
-func (_u *VehicleUpdate) SetLocation(v *schema.Location) *VehicleUpdate {
+func (_u *VehicleUpdate) SetLocation(v *schematype.Location) *VehicleUpdate {
 	_u.mutation.SetLocation(v)
 	return _u
 }
@@ -121,14 +145,316 @@ func (_u *VehicleUpdate) SetUpdatedAt(v time.Time) *VehicleUpdate {
 	return _u
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *VehicleUpdate) SetTenantID(v int) *VehicleUpdate {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableTenantID(v *int) *VehicleUpdate {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *VehicleUpdate) AddTenantID(v int) *VehicleUpdate {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *VehicleUpdate) ClearTenantID() *VehicleUpdate {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *VehicleUpdate) SetTags(v []string) *VehicleUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *VehicleUpdate) AppendTags(v []string) *VehicleUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *VehicleUpdate) ClearTags() *VehicleUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *VehicleUpdate) SetVersion(v int64) *VehicleUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableVersion(v *int64) *VehicleUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *VehicleUpdate) AddVersion(v int64) *VehicleUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetMake sets the "make" field.
+func (_u *VehicleUpdate) SetMake(v string) *VehicleUpdate {
+	_u.mutation.SetMake(v)
+	return _u
+}
+
+// SetNillableMake sets the "make" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableMake(v *string) *VehicleUpdate {
+	if v != nil {
+		_u.SetMake(*v)
+	}
+	return _u
+}
+
+// ClearMake clears the value of the "make" field.
+func (_u *VehicleUpdate) ClearMake() *VehicleUpdate {
+	_u.mutation.ClearMake()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *VehicleUpdate) SetModel(v string) *VehicleUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableModel(v *string) *VehicleUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *VehicleUpdate) ClearModel() *VehicleUpdate {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *VehicleUpdate) SetYear(v int) *VehicleUpdate {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableYear(v *int) *VehicleUpdate {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *VehicleUpdate) AddYear(v int) *VehicleUpdate {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// ClearYear clears the value of the "year" field.
+func (_u *VehicleUpdate) ClearYear() *VehicleUpdate {
+	_u.mutation.ClearYear()
+	return _u
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_u *VehicleUpdate) SetFuelType(v vehicle.FuelType) *VehicleUpdate {
+	_u.mutation.SetFuelType(v)
+	return _u
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableFuelType(v *vehicle.FuelType) *VehicleUpdate {
+	if v != nil {
+		_u.SetFuelType(*v)
+	}
+	return _u
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (_u *VehicleUpdate) ClearFuelType() *VehicleUpdate {
+	_u.mutation.ClearFuelType()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *VehicleUpdate) SetAttributes(v map[string]string) *VehicleUpdate {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *VehicleUpdate) ClearAttributes() *VehicleUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetCapacityKg sets the "capacity_kg" field.
+func (_u *VehicleUpdate) SetCapacityKg(v int) *VehicleUpdate {
+	_u.mutation.ResetCapacityKg()
+	_u.mutation.SetCapacityKg(v)
+	return _u
+}
+
+// SetNillableCapacityKg sets the "capacity_kg" field if the given value is not nil.
+func (_u *VehicleUpdate) SetNillableCapacityKg(v *int) *VehicleUpdate {
+	if v != nil {
+		_u.SetCapacityKg(*v)
+	}
+	return _u
+}
+
+// AddCapacityKg adds value to the "capacity_kg" field.
+func (_u *VehicleUpdate) AddCapacityKg(v int) *VehicleUpdate {
+	_u.mutation.AddCapacityKg(v)
+	return _u
+}
+
+// ClearCapacityKg clears the value of the "capacity_kg" field.
+func (_u *VehicleUpdate) ClearCapacityKg() *VehicleUpdate {
+	_u.mutation.ClearCapacityKg()
+	return _u
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_u *VehicleUpdate) AddDeviceIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.AddDeviceIDs(ids...)
+	return _u
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_u *VehicleUpdate) AddDevices(v ...*Device) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeviceIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (_u *VehicleUpdate) AddGroupIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.AddGroupIDs(ids...)
+	return _u
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (_u *VehicleUpdate) AddGroups(v ...*Group) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// AddDriverAssignmentIDs adds the "driver_assignments" edge to the DriverAssignment entity by IDs.
+func (_u *VehicleUpdate) AddDriverAssignmentIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.AddDriverAssignmentIDs(ids...)
+	return _u
+}
+
+// AddDriverAssignments adds the "driver_assignments" edges to the DriverAssignment entity.
+func (_u *VehicleUpdate) AddDriverAssignments(v ...*DriverAssignment) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDriverAssignmentIDs(ids...)
+}
+
 // Mutation returns the VehicleMutation object of the builder.
 func (_u *VehicleUpdate) Mutation() *VehicleMutation {
 	return _u.mutation
 }
 
+// ClearDevices clears all "devices" edges to the Device entity.
+func (_u *VehicleUpdate) ClearDevices() *VehicleUpdate {
+	_u.mutation.ClearDevices()
+	return _u
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (_u *VehicleUpdate) RemoveDeviceIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.RemoveDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (_u *VehicleUpdate) RemoveDevices(v ...*Device) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (_u *VehicleUpdate) ClearGroups() *VehicleUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (_u *VehicleUpdate) RemoveGroupIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (_u *VehicleUpdate) RemoveGroups(v ...*Group) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// ClearDriverAssignments clears all "driver_assignments" edges to the DriverAssignment entity.
+func (_u *VehicleUpdate) ClearDriverAssignments() *VehicleUpdate {
+	_u.mutation.ClearDriverAssignments()
+	return _u
+}
+
+// RemoveDriverAssignmentIDs removes the "driver_assignments" edge to DriverAssignment entities by IDs.
+func (_u *VehicleUpdate) RemoveDriverAssignmentIDs(ids ...int) *VehicleUpdate {
+	_u.mutation.RemoveDriverAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveDriverAssignments removes "driver_assignments" edges to DriverAssignment entities.
+func (_u *VehicleUpdate) RemoveDriverAssignments(v ...*DriverAssignment) *VehicleUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDriverAssignmentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *VehicleUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -155,11 +481,15 @@ func (_u *VehicleUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *VehicleUpdate) defaults() {
+func (_u *VehicleUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if vehicle.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized vehicle.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := vehicle.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -174,6 +504,11 @@ func (_u *VehicleUpdate) check() error {
 			return &ValidationError{Name: "license_plate", err: fmt.Errorf(`ent: validator failed for field "Vehicle.license_plate": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FuelType(); ok {
+		if err := vehicle.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`ent: validator failed for field "Vehicle.fuel_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -189,6 +524,12 @@ func (_u *VehicleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(vehicle.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(vehicle.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Vin(); ok {
 		_spec.SetField(vehicle.FieldVin, field.TypeString, value)
 	}
@@ -219,6 +560,209 @@ func (_u *VehicleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(vehicle.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vehicle.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(vehicle.FieldTenantID, field.TypeInt, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(vehicle.FieldTenantID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(vehicle.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vehicle.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(vehicle.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(vehicle.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(vehicle.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Make(); ok {
+		_spec.SetField(vehicle.FieldMake, field.TypeString, value)
+	}
+	if _u.mutation.MakeCleared() {
+		_spec.ClearField(vehicle.FieldMake, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(vehicle.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(vehicle.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(vehicle.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(vehicle.FieldYear, field.TypeInt, value)
+	}
+	if _u.mutation.YearCleared() {
+		_spec.ClearField(vehicle.FieldYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FuelType(); ok {
+		_spec.SetField(vehicle.FieldFuelType, field.TypeEnum, value)
+	}
+	if _u.mutation.FuelTypeCleared() {
+		_spec.ClearField(vehicle.FieldFuelType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(vehicle.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(vehicle.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CapacityKg(); ok {
+		_spec.SetField(vehicle.FieldCapacityKg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacityKg(); ok {
+		_spec.AddField(vehicle.FieldCapacityKg, field.TypeInt, value)
+	}
+	if _u.mutation.CapacityKgCleared() {
+		_spec.ClearField(vehicle.FieldCapacityKg, field.TypeInt)
+	}
+	if _u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !_u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DriverAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDriverAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.DriverAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DriverAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{vehicle.Label}
@@ -239,6 +783,26 @@ type VehicleUpdateOne struct {
 	mutation *VehicleMutation
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *VehicleUpdateOne) SetDeletedAt(v time.Time) *VehicleUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableDeletedAt(v *time.Time) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *VehicleUpdateOne) ClearDeletedAt() *VehicleUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetVin sets the "vin" field.
 func (_u *VehicleUpdateOne) SetVin(v string) *VehicleUpdateOne {
 	_u.mutation.SetVin(v)
@@ -302,7 +866,7 @@ func (_u *VehicleUpdateOne) ClearLastHeartbeat() *VehicleUpdateOne {
 }
 
 // SetLocation sets the "location" field.
-func (_u *VehicleUpdateOne) SetLocation(v *schema.Location) *VehicleUpdateOne {
+func (_u *VehicleUpdateOne) SetLocation(v *schematype.Location) *VehicleUpdateOne {
 	_u.mutation.SetLocation(v)
 	return _u
 }
@@ -331,11 +895,311 @@ func (_u *VehicleUpdateOne) SetUpdatedAt(v time.Time) *VehicleUpdateOne {
 	return _u
 }
 
+// SetTenantID sets the "tenant_id" field.
+func (_u *VehicleUpdateOne) SetTenantID(v int) *VehicleUpdateOne {
+	_u.mutation.ResetTenantID()
+	_u.mutation.SetTenantID(v)
+	return _u
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableTenantID(v *int) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetTenantID(*v)
+	}
+	return _u
+}
+
+// AddTenantID adds value to the "tenant_id" field.
+func (_u *VehicleUpdateOne) AddTenantID(v int) *VehicleUpdateOne {
+	_u.mutation.AddTenantID(v)
+	return _u
+}
+
+// ClearTenantID clears the value of the "tenant_id" field.
+func (_u *VehicleUpdateOne) ClearTenantID() *VehicleUpdateOne {
+	_u.mutation.ClearTenantID()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *VehicleUpdateOne) SetTags(v []string) *VehicleUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *VehicleUpdateOne) AppendTags(v []string) *VehicleUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *VehicleUpdateOne) ClearTags() *VehicleUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *VehicleUpdateOne) SetVersion(v int64) *VehicleUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableVersion(v *int64) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *VehicleUpdateOne) AddVersion(v int64) *VehicleUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetMake sets the "make" field.
+func (_u *VehicleUpdateOne) SetMake(v string) *VehicleUpdateOne {
+	_u.mutation.SetMake(v)
+	return _u
+}
+
+// SetNillableMake sets the "make" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableMake(v *string) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetMake(*v)
+	}
+	return _u
+}
+
+// ClearMake clears the value of the "make" field.
+func (_u *VehicleUpdateOne) ClearMake() *VehicleUpdateOne {
+	_u.mutation.ClearMake()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *VehicleUpdateOne) SetModel(v string) *VehicleUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableModel(v *string) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *VehicleUpdateOne) ClearModel() *VehicleUpdateOne {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *VehicleUpdateOne) SetYear(v int) *VehicleUpdateOne {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableYear(v *int) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *VehicleUpdateOne) AddYear(v int) *VehicleUpdateOne {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// ClearYear clears the value of the "year" field.
+func (_u *VehicleUpdateOne) ClearYear() *VehicleUpdateOne {
+	_u.mutation.ClearYear()
+	return _u
+}
+
+// SetFuelType sets the "fuel_type" field.
+func (_u *VehicleUpdateOne) SetFuelType(v vehicle.FuelType) *VehicleUpdateOne {
+	_u.mutation.SetFuelType(v)
+	return _u
+}
+
+// SetNillableFuelType sets the "fuel_type" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableFuelType(v *vehicle.FuelType) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetFuelType(*v)
+	}
+	return _u
+}
+
+// ClearFuelType clears the value of the "fuel_type" field.
+func (_u *VehicleUpdateOne) ClearFuelType() *VehicleUpdateOne {
+	_u.mutation.ClearFuelType()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *VehicleUpdateOne) SetAttributes(v map[string]string) *VehicleUpdateOne {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *VehicleUpdateOne) ClearAttributes() *VehicleUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetCapacityKg sets the "capacity_kg" field.
+func (_u *VehicleUpdateOne) SetCapacityKg(v int) *VehicleUpdateOne {
+	_u.mutation.ResetCapacityKg()
+	_u.mutation.SetCapacityKg(v)
+	return _u
+}
+
+// SetNillableCapacityKg sets the "capacity_kg" field if the given value is not nil.
+func (_u *VehicleUpdateOne) SetNillableCapacityKg(v *int) *VehicleUpdateOne {
+	if v != nil {
+		_u.SetCapacityKg(*v)
+	}
+	return _u
+}
+
+// AddCapacityKg adds value to the "capacity_kg" field.
+func (_u *VehicleUpdateOne) AddCapacityKg(v int) *VehicleUpdateOne {
+	_u.mutation.AddCapacityKg(v)
+	return _u
+}
+
+// ClearCapacityKg clears the value of the "capacity_kg" field.
+func (_u *VehicleUpdateOne) ClearCapacityKg() *VehicleUpdateOne {
+	_u.mutation.ClearCapacityKg()
+	return _u
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_u *VehicleUpdateOne) AddDeviceIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.AddDeviceIDs(ids...)
+	return _u
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_u *VehicleUpdateOne) AddDevices(v ...*Device) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeviceIDs(ids...)
+}
+
+// AddGroupIDs adds the "groups" edge to the Group entity by IDs.
+func (_u *VehicleUpdateOne) AddGroupIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.AddGroupIDs(ids...)
+	return _u
+}
+
+// AddGroups adds the "groups" edges to the Group entity.
+func (_u *VehicleUpdateOne) AddGroups(v ...*Group) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGroupIDs(ids...)
+}
+
+// AddDriverAssignmentIDs adds the "driver_assignments" edge to the DriverAssignment entity by IDs.
+func (_u *VehicleUpdateOne) AddDriverAssignmentIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.AddDriverAssignmentIDs(ids...)
+	return _u
+}
+
+// AddDriverAssignments adds the "driver_assignments" edges to the DriverAssignment entity.
+func (_u *VehicleUpdateOne) AddDriverAssignments(v ...*DriverAssignment) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDriverAssignmentIDs(ids...)
+}
+
 // Mutation returns the VehicleMutation object of the builder.
 func (_u *VehicleUpdateOne) Mutation() *VehicleMutation {
 	return _u.mutation
 }
 
+// ClearDevices clears all "devices" edges to the Device entity.
+func (_u *VehicleUpdateOne) ClearDevices() *VehicleUpdateOne {
+	_u.mutation.ClearDevices()
+	return _u
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (_u *VehicleUpdateOne) RemoveDeviceIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.RemoveDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (_u *VehicleUpdateOne) RemoveDevices(v ...*Device) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceIDs(ids...)
+}
+
+// ClearGroups clears all "groups" edges to the Group entity.
+func (_u *VehicleUpdateOne) ClearGroups() *VehicleUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// RemoveGroupIDs removes the "groups" edge to Group entities by IDs.
+func (_u *VehicleUpdateOne) RemoveGroupIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.RemoveGroupIDs(ids...)
+	return _u
+}
+
+// RemoveGroups removes "groups" edges to Group entities.
+func (_u *VehicleUpdateOne) RemoveGroups(v ...*Group) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGroupIDs(ids...)
+}
+
+// ClearDriverAssignments clears all "driver_assignments" edges to the DriverAssignment entity.
+func (_u *VehicleUpdateOne) ClearDriverAssignments() *VehicleUpdateOne {
+	_u.mutation.ClearDriverAssignments()
+	return _u
+}
+
+// RemoveDriverAssignmentIDs removes the "driver_assignments" edge to DriverAssignment entities by IDs.
+func (_u *VehicleUpdateOne) RemoveDriverAssignmentIDs(ids ...int) *VehicleUpdateOne {
+	_u.mutation.RemoveDriverAssignmentIDs(ids...)
+	return _u
+}
+
+// RemoveDriverAssignments removes "driver_assignments" edges to DriverAssignment entities.
+func (_u *VehicleUpdateOne) RemoveDriverAssignments(v ...*DriverAssignment) *VehicleUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDriverAssignmentIDs(ids...)
+}
+
 // Where appends a list predicates to the VehicleUpdate builder.
 func (_u *VehicleUpdateOne) Where(ps ...predicate.Vehicle) *VehicleUpdateOne {
 	_u.mutation.Where(ps...)
@@ -351,7 +1215,9 @@ func (_u *VehicleUpdateOne) Select(field string, fields ...string) *VehicleUpdat
 
 // Save executes the query and returns the updated Vehicle entity.
 func (_u *VehicleUpdateOne) Save(ctx context.Context) (*Vehicle, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -378,11 +1244,15 @@ func (_u *VehicleUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *VehicleUpdateOne) defaults() {
+func (_u *VehicleUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if vehicle.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized vehicle.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := vehicle.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -397,6 +1267,11 @@ func (_u *VehicleUpdateOne) check() error {
 			return &ValidationError{Name: "license_plate", err: fmt.Errorf(`ent: validator failed for field "Vehicle.license_plate": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.FuelType(); ok {
+		if err := vehicle.FuelTypeValidator(v); err != nil {
+			return &ValidationError{Name: "fuel_type", err: fmt.Errorf(`ent: validator failed for field "Vehicle.fuel_type": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -429,6 +1304,12 @@ func (_u *VehicleUpdateOne) sqlSave(ctx context.Context) (_node *Vehicle, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(vehicle.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(vehicle.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.Vin(); ok {
 		_spec.SetField(vehicle.FieldVin, field.TypeString, value)
 	}
@@ -459,6 +1340,209 @@ func (_u *VehicleUpdateOne) sqlSave(ctx context.Context) (_node *Vehicle, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(vehicle.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.TenantID(); ok {
+		_spec.SetField(vehicle.FieldTenantID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTenantID(); ok {
+		_spec.AddField(vehicle.FieldTenantID, field.TypeInt, value)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(vehicle.FieldTenantID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(vehicle.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, vehicle.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(vehicle.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(vehicle.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(vehicle.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Make(); ok {
+		_spec.SetField(vehicle.FieldMake, field.TypeString, value)
+	}
+	if _u.mutation.MakeCleared() {
+		_spec.ClearField(vehicle.FieldMake, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(vehicle.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(vehicle.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(vehicle.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(vehicle.FieldYear, field.TypeInt, value)
+	}
+	if _u.mutation.YearCleared() {
+		_spec.ClearField(vehicle.FieldYear, field.TypeInt)
+	}
+	if value, ok := _u.mutation.FuelType(); ok {
+		_spec.SetField(vehicle.FieldFuelType, field.TypeEnum, value)
+	}
+	if _u.mutation.FuelTypeCleared() {
+		_spec.ClearField(vehicle.FieldFuelType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(vehicle.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(vehicle.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CapacityKg(); ok {
+		_spec.SetField(vehicle.FieldCapacityKg, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacityKg(); ok {
+		_spec.AddField(vehicle.FieldCapacityKg, field.TypeInt, value)
+	}
+	if _u.mutation.CapacityKgCleared() {
+		_spec.ClearField(vehicle.FieldCapacityKg, field.TypeInt)
+	}
+	if _u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !_u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DevicesTable,
+			Columns: []string{vehicle.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGroupsIDs(); len(nodes) > 0 && !_u.mutation.GroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   vehicle.GroupsTable,
+			Columns: vehicle.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(group.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DriverAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDriverAssignmentsIDs(); len(nodes) > 0 && !_u.mutation.DriverAssignmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DriverAssignmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   vehicle.DriverAssignmentsTable,
+			Columns: []string{vehicle.DriverAssignmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(driverassignment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Vehicle{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehicleevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// Vin applies equality check predicate on the "vin" field. It's identical to VinEQ.
+func Vin(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldVin, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// VinEQ applies the EQ predicate on the "vin" field.
+func VinEQ(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldVin, v))
+}
+
+// VinNEQ applies the NEQ predicate on the "vin" field.
+func VinNEQ(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldVin, v))
+}
+
+// VinIn applies the In predicate on the "vin" field.
+func VinIn(vs ...string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldVin, vs...))
+}
+
+// VinNotIn applies the NotIn predicate on the "vin" field.
+func VinNotIn(vs ...string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldVin, vs...))
+}
+
+// VinGT applies the GT predicate on the "vin" field.
+func VinGT(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGT(FieldVin, v))
+}
+
+// VinGTE applies the GTE predicate on the "vin" field.
+func VinGTE(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGTE(FieldVin, v))
+}
+
+// VinLT applies the LT predicate on the "vin" field.
+func VinLT(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLT(FieldVin, v))
+}
+
+// VinLTE applies the LTE predicate on the "vin" field.
+func VinLTE(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLTE(FieldVin, v))
+}
+
+// VinContains applies the Contains predicate on the "vin" field.
+func VinContains(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldContains(FieldVin, v))
+}
+
+// VinHasPrefix applies the HasPrefix predicate on the "vin" field.
+func VinHasPrefix(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldHasPrefix(FieldVin, v))
+}
+
+// VinHasSuffix applies the HasSuffix predicate on the "vin" field.
+func VinHasSuffix(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldHasSuffix(FieldVin, v))
+}
+
+// VinEqualFold applies the EqualFold predicate on the "vin" field.
+func VinEqualFold(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEqualFold(FieldVin, v))
+}
+
+// VinContainsFold applies the ContainsFold predicate on the "vin" field.
+func VinContainsFold(v string) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldContainsFold(FieldVin, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotNull(FieldTenantID))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleEvent) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleEvent) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleEvent) predicate.VehicleEvent {
+	return predicate.VehicleEvent(sql.NotPredicates(p))
+}
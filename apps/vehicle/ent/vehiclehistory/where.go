@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package vehiclehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldID, id))
+}
+
+// VehicleID applies equality check predicate on the "vehicle_id" field. It's identical to VehicleIDEQ.
+func VehicleID(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// Vin applies equality check predicate on the "vin" field. It's identical to VinEQ.
+func Vin(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldVin, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// VehicleIDEQ applies the EQ predicate on the "vehicle_id" field.
+func VehicleIDEQ(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldVehicleID, v))
+}
+
+// VehicleIDNEQ applies the NEQ predicate on the "vehicle_id" field.
+func VehicleIDNEQ(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldVehicleID, v))
+}
+
+// VehicleIDIn applies the In predicate on the "vehicle_id" field.
+func VehicleIDIn(vs ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDNotIn applies the NotIn predicate on the "vehicle_id" field.
+func VehicleIDNotIn(vs ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldVehicleID, vs...))
+}
+
+// VehicleIDGT applies the GT predicate on the "vehicle_id" field.
+func VehicleIDGT(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldVehicleID, v))
+}
+
+// VehicleIDGTE applies the GTE predicate on the "vehicle_id" field.
+func VehicleIDGTE(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldVehicleID, v))
+}
+
+// VehicleIDLT applies the LT predicate on the "vehicle_id" field.
+func VehicleIDLT(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldVehicleID, v))
+}
+
+// VehicleIDLTE applies the LTE predicate on the "vehicle_id" field.
+func VehicleIDLTE(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldVehicleID, v))
+}
+
+// VinEQ applies the EQ predicate on the "vin" field.
+func VinEQ(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldVin, v))
+}
+
+// VinNEQ applies the NEQ predicate on the "vin" field.
+func VinNEQ(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldVin, v))
+}
+
+// VinIn applies the In predicate on the "vin" field.
+func VinIn(vs ...string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldVin, vs...))
+}
+
+// VinNotIn applies the NotIn predicate on the "vin" field.
+func VinNotIn(vs ...string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldVin, vs...))
+}
+
+// VinGT applies the GT predicate on the "vin" field.
+func VinGT(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldVin, v))
+}
+
+// VinGTE applies the GTE predicate on the "vin" field.
+func VinGTE(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldVin, v))
+}
+
+// VinLT applies the LT predicate on the "vin" field.
+func VinLT(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldVin, v))
+}
+
+// VinLTE applies the LTE predicate on the "vin" field.
+func VinLTE(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldVin, v))
+}
+
+// VinContains applies the Contains predicate on the "vin" field.
+func VinContains(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldContains(FieldVin, v))
+}
+
+// VinHasPrefix applies the HasPrefix predicate on the "vin" field.
+func VinHasPrefix(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldHasPrefix(FieldVin, v))
+}
+
+// VinHasSuffix applies the HasSuffix predicate on the "vin" field.
+func VinHasSuffix(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldHasSuffix(FieldVin, v))
+}
+
+// VinEqualFold applies the EqualFold predicate on the "vin" field.
+func VinEqualFold(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEqualFold(FieldVin, v))
+}
+
+// VinContainsFold applies the ContainsFold predicate on the "vin" field.
+func VinContainsFold(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldContainsFold(FieldVin, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotNull(FieldTenantID))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// ChangesIsNil applies the IsNil predicate on the "changes" field.
+func ChangesIsNil() predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIsNull(FieldChanges))
+}
+
+// ChangesNotNil applies the NotNil predicate on the "changes" field.
+func ChangesNotNil() predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotNull(FieldChanges))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VehicleHistory) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VehicleHistory) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VehicleHistory) predicate.VehicleHistory {
+	return predicate.VehicleHistory(sql.NotPredicates(p))
+}
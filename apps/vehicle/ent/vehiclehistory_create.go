@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
+)
+
+// VehicleHistoryCreate is the builder for creating a VehicleHistory entity.
+type VehicleHistoryCreate struct {
+	config
+	mutation *VehicleHistoryMutation
+	hooks    []Hook
+}
+
+// SetVehicleID sets the "vehicle_id" field.
+func (_c *VehicleHistoryCreate) SetVehicleID(v int) *VehicleHistoryCreate {
+	_c.mutation.SetVehicleID(v)
+	return _c
+}
+
+// SetVin sets the "vin" field.
+func (_c *VehicleHistoryCreate) SetVin(v string) *VehicleHistoryCreate {
+	_c.mutation.SetVin(v)
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *VehicleHistoryCreate) SetTenantID(v int) *VehicleHistoryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *VehicleHistoryCreate) SetNillableTenantID(v *int) *VehicleHistoryCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *VehicleHistoryCreate) SetAction(v vehiclehistory.Action) *VehicleHistoryCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *VehicleHistoryCreate) SetActor(v string) *VehicleHistoryCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetChanges sets the "changes" field.
+func (_c *VehicleHistoryCreate) SetChanges(v []schematype.FieldChange) *VehicleHistoryCreate {
+	_c.mutation.SetChanges(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VehicleHistoryCreate) SetCreatedAt(v time.Time) *VehicleHistoryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VehicleHistoryCreate) SetNillableCreatedAt(v *time.Time) *VehicleHistoryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the VehicleHistoryMutation object of the builder.
+func (_c *VehicleHistoryCreate) Mutation() *VehicleHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the VehicleHistory in the database.
+func (_c *VehicleHistoryCreate) Save(ctx context.Context) (*VehicleHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VehicleHistoryCreate) SaveX(ctx context.Context) *VehicleHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VehicleHistoryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := vehiclehistory.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VehicleHistoryCreate) check() error {
+	if _, ok := _c.mutation.VehicleID(); !ok {
+		return &ValidationError{Name: "vehicle_id", err: errors.New(`ent: missing required field "VehicleHistory.vehicle_id"`)}
+	}
+	if _, ok := _c.mutation.Vin(); !ok {
+		return &ValidationError{Name: "vin", err: errors.New(`ent: missing required field "VehicleHistory.vin"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "VehicleHistory.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := vehiclehistory.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "VehicleHistory.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Actor(); !ok {
+		return &ValidationError{Name: "actor", err: errors.New(`ent: missing required field "VehicleHistory.actor"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "VehicleHistory.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *VehicleHistoryCreate) sqlSave(ctx context.Context) (*VehicleHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VehicleHistoryCreate) createSpec() (*VehicleHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &VehicleHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(vehiclehistory.Table, sqlgraph.NewFieldSpec(vehiclehistory.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.VehicleID(); ok {
+		_spec.SetField(vehiclehistory.FieldVehicleID, field.TypeInt, value)
+		_node.VehicleID = value
+	}
+	if value, ok := _c.mutation.Vin(); ok {
+		_spec.SetField(vehiclehistory.FieldVin, field.TypeString, value)
+		_node.Vin = value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(vehiclehistory.FieldTenantID, field.TypeInt, value)
+		_node.TenantID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(vehiclehistory.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(vehiclehistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Changes(); ok {
+		_spec.SetField(vehiclehistory.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(vehiclehistory.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// VehicleHistoryCreateBulk is the builder for creating many VehicleHistory entities in bulk.
+type VehicleHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*VehicleHistoryCreate
+}
+
+// Save creates the VehicleHistory entities in the database.
+func (_c *VehicleHistoryCreateBulk) Save(ctx context.Context) ([]*VehicleHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*VehicleHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VehicleHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VehicleHistoryCreateBulk) SaveX(ctx context.Context) []*VehicleHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VehicleHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VehicleHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
+)
+
+// VehicleHistoryUpdate is the builder for updating VehicleHistory entities.
+type VehicleHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VehicleHistoryMutation
+}
+
+// Where appends a list predicates to the VehicleHistoryUpdate builder.
+func (_u *VehicleHistoryUpdate) Where(ps ...predicate.VehicleHistory) *VehicleHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the VehicleHistoryMutation object of the builder.
+func (_u *VehicleHistoryUpdate) Mutation() *VehicleHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VehicleHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VehicleHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclehistory.Table, vehiclehistory.Columns, sqlgraph.NewFieldSpec(vehiclehistory.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(vehiclehistory.FieldTenantID, field.TypeInt)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(vehiclehistory.FieldChanges, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VehicleHistoryUpdateOne is the builder for updating a single VehicleHistory entity.
+type VehicleHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VehicleHistoryMutation
+}
+
+// Mutation returns the VehicleHistoryMutation object of the builder.
+func (_u *VehicleHistoryUpdateOne) Mutation() *VehicleHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the VehicleHistoryUpdate builder.
+func (_u *VehicleHistoryUpdateOne) Where(ps ...predicate.VehicleHistory) *VehicleHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VehicleHistoryUpdateOne) Select(field string, fields ...string) *VehicleHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated VehicleHistory entity.
+func (_u *VehicleHistoryUpdateOne) Save(ctx context.Context) (*VehicleHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VehicleHistoryUpdateOne) SaveX(ctx context.Context) *VehicleHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VehicleHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VehicleHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *VehicleHistoryUpdateOne) sqlSave(ctx context.Context) (_node *VehicleHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(vehiclehistory.Table, vehiclehistory.Columns, sqlgraph.NewFieldSpec(vehiclehistory.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "VehicleHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, vehiclehistory.FieldID)
+		for _, f := range fields {
+			if !vehiclehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != vehiclehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(vehiclehistory.FieldTenantID, field.TypeInt)
+	}
+	if _u.mutation.ChangesCleared() {
+		_spec.ClearField(vehiclehistory.FieldChanges, field.TypeJSON)
+	}
+	_node = &VehicleHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{vehiclehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
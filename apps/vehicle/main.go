@@ -2,13 +2,28 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"expvar"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/redis/go-redis/v9"
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
 	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
 	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	_ "github.com/xuewentao/cheya/apps/vehicle/ent/runtime" //软删除等 schema hook
+	"github.com/xuewentao/cheya/apps/vehicle/migrations"
 	"github.com/xuewentao/cheya/apps/vehicle/server"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/token"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	_ "github.com/lib/pq"
 )
@@ -16,32 +31,91 @@ import (
 func main() {
 	//1.链接数据库
 	dns := "host=localhost port=5432 user=wentao_xue  dbname=cheya password=Woe89132 sslmode=disable"
-	client, err := ent.Open("postgres", dns)
+	db, err := sql.Open("postgres", dns)
 	if err != nil {
 		log.Fatalf("❌ failed opening connection to postgres: %v", err)
 	}
+	defer db.Close()
+
+	//2.数据库迁移: vehicle migrate up|down|status|force 单独执行，服务启动时只检查版本
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(db, os.Args[2:])
+		return
+	}
+	migrator, err := migrations.New(db)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	schemaStatus, err := migrator.Check(context.Background())
+	if err != nil {
+		log.Fatalf("❌ %v, run `vehicle migrate up` first", err)
+	}
+	log.Printf("✅ Database schema is at version %d", schemaStatus.Current)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, db)))
 	defer client.Close()
 
-	//2.自动迁移
-	//在 db 中自动创建 vehicles
-	log.Println("📦 Migrating database schema...")
-	if err := client.Schema.Create(context.Background()); err != nil {
-		log.Fatalf("❌ failed creating schema resources: %v", err)
+	//3.鉴权: 从 auth service 拉取 JWKS 本地验签，并检查 Redis 吊销列表；API key 通过 auth service 校验
+	authConn, err := grpc.NewClient("localhost:50054", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("❌ failed to connect auth service: %v", err)
 	}
-	log.Println("✅ Schema migrated successfully!")
+	defer authConn.Close()
+	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+	defer rdb.Close()
+	authClient := authv1.NewAuthServiceClient(authConn)
+	jwks := token.NewJWKSCache(grpcauth.JWKSFetcher(authClient), 5*time.Minute)
+	authn := grpcauth.WithAPIKeys(grpcauth.NewLocalAuthenticator(token.NewVerifier(jwks, rdb)), authClient)
 
-	//3.启动 grpc
-	lis, err := net.Listen("tcp",":50051")
-	if err != nil{
+	//4.启动 grpc
+	lis, err := net.Listen("tcp", ":50051")
+	if err != nil {
 		log.Fatalf("❌ failed to listen : %v", err)
 	}
 
-	s := grpc.NewServer()
+	//AuthenticateDevice 由设备凭证本身鉴权，不需要用户 token
+	public := grpcauth.WithPublicMethods(vehiclev1.VehicleService_AuthenticateDevice_FullMethodName)
+	//API key 只能调用 scope 允许的方法
+	scopes := grpcauth.WithMethodScopes(server.MethodScopes)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(authn, public, scopes)),
+		grpc.ChainStreamInterceptor(grpcauth.StreamServerInterceptor(authn, public, scopes)),
+	)
 	//注入 client 到 server
-	vehiclev1.RegisterVehicleServiceServer(s,server.NewVehicleServer(*client))
+	//VIN_LENIENT=true 时不检查 VIN 校验位，测试环境录入模拟车辆使用
+	var opts []server.Option
+	if lenient, _ := strconv.ParseBool(os.Getenv("VIN_LENIENT")); lenient {
+		log.Println("⚠️ VIN check digit validation is disabled")
+		opts = append(opts, server.WithLenientVIN())
+	}
+	//VEHICLE_CACHE_TTL 修改 GetVehicle 的缓存时间 (例如 1m)，0 表示关闭缓存
+	if v := os.Getenv("VEHICLE_CACHE_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("❌ invalid VEHICLE_CACHE_TTL %q: %v", v, err)
+		}
+		opts = append(opts, server.WithCacheTTL(ttl))
+	}
+	vehicleServer := server.NewVehicleServer(*client, rdb, opts...)
+	if err := vehicleServer.SyncTenantIndex(context.Background()); err != nil {
+		log.Printf("⚠️ failed syncing tenant index: %v", err)
+	}
+	//定期清理过期的车辆变更事件
+	go vehicleServer.RunEventPruner(context.Background())
+	//其它程序发布的缓存失效消息
+	go vehicleServer.RunCacheInvalidation(context.Background())
+
+	//5.指标: GET /debug/vars，vehicle_cache 为 GetVehicle 缓存的命中率
+	go func() {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		if err := http.ListenAndServe(":9101", mux); err != nil {
+			log.Printf("⚠️ metrics server stopped: %v", err)
+		}
+	}()
+	vehiclev1.RegisterVehicleServiceServer(s, vehicleServer)
 	log.Printf("🚀 Vehicle Service is running on :50051")
 
-	//4.启动服务
+	//6.启动服务
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to server %v", err)
 	}
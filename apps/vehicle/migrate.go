@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/xuewentao/cheya/apps/vehicle/migrations"
+)
+
+const migrateUsage = `usage: vehicle migrate <command>
+
+  up [N]         执行 N 个待执行的迁移，不传表示全部
+  down [N]       回滚 N 个迁移，默认 1 个
+  status         查看当前版本和待执行的迁移
+  force VERSION  只修改版本记录不执行 SQL (清除 dirty，或把自动迁移创建的已有库标记为基线版本)`
+
+// runMigrate vehicle migrate 子命令
+func runMigrate(db *sql.DB, args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+	m, err := migrations.New(db)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	ctx := context.Background()
+	arg := func(def int) int {
+		if len(args) < 2 {
+			return def
+		}
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 {
+			log.Fatalf("❌ invalid argument %q", args[1])
+		}
+		return n
+	}
+
+	switch args[0] {
+	case "up":
+		done, err := m.Up(ctx, arg(0))
+		for _, mg := range done {
+			log.Printf("⬆️ applied %d_%s", mg.Version, mg.Name)
+		}
+		if err != nil {
+			log.Fatalf("❌ migrate up failed: %v", err)
+		}
+		if len(done) == 0 {
+			log.Println("✅ No pending migrations")
+		}
+	case "down":
+		done, err := m.Down(ctx, arg(1))
+		for _, mg := range done {
+			log.Printf("⬇️ reverted %d_%s", mg.Version, mg.Name)
+		}
+		if err != nil {
+			log.Fatalf("❌ migrate down failed: %v", err)
+		}
+	case "status":
+		s, err := m.Status(ctx)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Printf("current: %d", s.Current)
+		if s.Dirty {
+			fmt.Print(" (dirty)")
+		}
+		fmt.Printf("\nlatest:  %d\n\n", s.Latest())
+		for _, mg := range s.Applied {
+			fmt.Printf("  applied  %d_%s\n", mg.Version, mg.Name)
+		}
+		for _, mg := range s.Pending {
+			fmt.Printf("  pending  %d_%s\n", mg.Version, mg.Name)
+		}
+	case "force":
+		if len(args) < 2 {
+			log.Fatalln("❌ force requires a version")
+		}
+		v, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			log.Fatalf("❌ invalid version %q", args[1])
+		}
+		if err := m.Force(ctx, v); err != nil {
+			log.Fatalf("❌ migrate force failed: %v", err)
+		}
+		log.Printf("✅ Schema version set to %d", v)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,44 @@
+-- reverse: create "group_vehicles" table
+DROP TABLE "group_vehicles";
+-- reverse: create index "group_parent_id" to table: "groups"
+DROP INDEX "group_parent_id";
+-- reverse: create index "group_tenant_id_name" to table: "groups"
+DROP INDEX "group_tenant_id_name";
+-- reverse: create "groups" table
+DROP TABLE "groups";
+-- reverse: create index "driverassignment_driver_id_current" to table: "driver_assignments"
+DROP INDEX "driverassignment_driver_id_current";
+-- reverse: create index "driverassignment_vehicle_id_current" to table: "driver_assignments"
+DROP INDEX "driverassignment_vehicle_id_current";
+-- reverse: create index "driverassignment_driver_id_started_at" to table: "driver_assignments"
+DROP INDEX "driverassignment_driver_id_started_at";
+-- reverse: create index "driverassignment_vehicle_id_started_at" to table: "driver_assignments"
+DROP INDEX "driverassignment_vehicle_id_started_at";
+-- reverse: create "driver_assignments" table
+DROP TABLE "driver_assignments";
+-- reverse: create index "driverprofile_tenant_id_license_number" to table: "drivers"
+DROP INDEX "driverprofile_tenant_id_license_number";
+-- reverse: create "drivers" table
+DROP TABLE "drivers";
+-- reverse: create index "devices_credential_hash_key" to table: "devices"
+DROP INDEX "devices_credential_hash_key";
+-- reverse: create index "devices_prefix_key" to table: "devices"
+DROP INDEX "devices_prefix_key";
+-- reverse: create "devices" table
+DROP TABLE "devices";
+-- reverse: create index "vehiclehistory_vehicle_id_created_at" to table: "vehicle_histories"
+DROP INDEX "vehiclehistory_vehicle_id_created_at";
+-- reverse: create "vehicle_histories" table
+DROP TABLE "vehicle_histories";
+-- reverse: create index "vehicle_last_heartbeat" to table: "vehicles"
+DROP INDEX "vehicle_last_heartbeat";
+-- reverse: create index "vehicle_created_at" to table: "vehicles"
+DROP INDEX "vehicle_created_at";
+-- reverse: create index "vehicle_tenant_id" to table: "vehicles"
+DROP INDEX "vehicle_tenant_id";
+-- reverse: create index "vehicle_status" to table: "vehicles"
+DROP INDEX "vehicle_status";
+-- reverse: create index "vehicles_vin_key" to table: "vehicles"
+DROP INDEX "vehicles_vin_key";
+-- reverse: create "vehicles" table
+DROP TABLE "vehicles";
@@ -0,0 +1,44 @@
+-- create "vehicles" table
+CREATE TABLE "vehicles" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "deleted_at" timestamptz NULL, "vin" character varying NOT NULL, "license_plate" character varying NOT NULL, "status" character varying NOT NULL DEFAULT 'Offline', "last_heartbeat" timestamptz NULL, "location" jsonb NULL, "telemetry" jsonb NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "tenant_id" bigint NULL, "tags" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "vehicles_vin_key" to table: "vehicles"
+CREATE UNIQUE INDEX "vehicles_vin_key" ON "vehicles" ("vin");
+-- create index "vehicle_status" to table: "vehicles"
+CREATE INDEX "vehicle_status" ON "vehicles" ("status");
+-- create index "vehicle_tenant_id" to table: "vehicles"
+CREATE INDEX "vehicle_tenant_id" ON "vehicles" ("tenant_id");
+-- create index "vehicle_created_at" to table: "vehicles"
+CREATE INDEX "vehicle_created_at" ON "vehicles" ("created_at");
+-- create index "vehicle_last_heartbeat" to table: "vehicles"
+CREATE INDEX "vehicle_last_heartbeat" ON "vehicles" ("last_heartbeat");
+-- create "vehicle_histories" table
+CREATE TABLE "vehicle_histories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "vin" character varying NOT NULL, "tenant_id" bigint NULL, "action" character varying NOT NULL, "actor" character varying NOT NULL, "changes" jsonb NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehiclehistory_vehicle_id_created_at" to table: "vehicle_histories"
+CREATE INDEX "vehiclehistory_vehicle_id_created_at" ON "vehicle_histories" ("vehicle_id", "created_at");
+-- create "devices" table
+CREATE TABLE "devices" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "prefix" character varying NOT NULL, "credential_hash" character varying NOT NULL, "name" character varying NULL, "last_seen_at" timestamptz NULL, "revoked_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "vehicle_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "devices_vehicles_devices" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE NO ACTION);
+-- create index "devices_prefix_key" to table: "devices"
+CREATE UNIQUE INDEX "devices_prefix_key" ON "devices" ("prefix");
+-- create index "devices_credential_hash_key" to table: "devices"
+CREATE UNIQUE INDEX "devices_credential_hash_key" ON "devices" ("credential_hash");
+-- create "drivers" table
+CREATE TABLE "drivers" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "license_number" character varying NOT NULL, "license_expiry" timestamptz NULL, "phone" character varying NULL, "tenant_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "driverprofile_tenant_id_license_number" to table: "drivers"
+CREATE UNIQUE INDEX "driverprofile_tenant_id_license_number" ON "drivers" ("tenant_id", "license_number");
+-- create "driver_assignments" table
+CREATE TABLE "driver_assignments" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "started_at" timestamptz NOT NULL, "ended_at" timestamptz NULL, "assigned_by" character varying NOT NULL, "created_at" timestamptz NOT NULL, "driver_id" bigint NOT NULL, "vehicle_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "driver_assignments_drivers_assignments" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE NO ACTION, CONSTRAINT "driver_assignments_vehicles_driver_assignments" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE NO ACTION);
+-- create index "driverassignment_vehicle_id_started_at" to table: "driver_assignments"
+CREATE INDEX "driverassignment_vehicle_id_started_at" ON "driver_assignments" ("vehicle_id", "started_at");
+-- create index "driverassignment_driver_id_started_at" to table: "driver_assignments"
+CREATE INDEX "driverassignment_driver_id_started_at" ON "driver_assignments" ("driver_id", "started_at");
+-- create index "driverassignment_vehicle_id_current" to table: "driver_assignments"
+CREATE UNIQUE INDEX "driverassignment_vehicle_id_current" ON "driver_assignments" ("vehicle_id") WHERE ended_at IS NULL;
+-- create index "driverassignment_driver_id_current" to table: "driver_assignments"
+CREATE UNIQUE INDEX "driverassignment_driver_id_current" ON "driver_assignments" ("driver_id") WHERE ended_at IS NULL;
+-- create "groups" table
+CREATE TABLE "groups" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "name" character varying NOT NULL, "description" character varying NULL, "tenant_id" bigint NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "parent_id" bigint NULL, PRIMARY KEY ("id"), CONSTRAINT "groups_groups_children" FOREIGN KEY ("parent_id") REFERENCES "groups" ("id") ON DELETE SET NULL);
+-- create index "group_tenant_id_name" to table: "groups"
+CREATE UNIQUE INDEX "group_tenant_id_name" ON "groups" ("tenant_id", "name");
+-- create index "group_parent_id" to table: "groups"
+CREATE INDEX "group_parent_id" ON "groups" ("parent_id");
+-- create "group_vehicles" table
+CREATE TABLE "group_vehicles" ("group_id" bigint NOT NULL, "vehicle_id" bigint NOT NULL, PRIMARY KEY ("group_id", "vehicle_id"), CONSTRAINT "group_vehicles_group_id" FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE CASCADE, CONSTRAINT "group_vehicles_vehicle_id" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE);
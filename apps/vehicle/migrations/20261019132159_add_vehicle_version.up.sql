@@ -0,0 +1,2 @@
+-- modify "vehicles" table
+ALTER TABLE "vehicles" ADD COLUMN "version" bigint NOT NULL DEFAULT 1;
@@ -0,0 +1,4 @@
+-- reverse: create index "vehicleevent_created_at" to table: "vehicle_events"
+DROP INDEX "vehicleevent_created_at";
+-- reverse: create "vehicle_events" table
+DROP TABLE "vehicle_events";
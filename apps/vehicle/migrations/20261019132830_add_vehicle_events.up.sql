@@ -0,0 +1,4 @@
+-- create "vehicle_events" table
+CREATE TABLE "vehicle_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "vehicle_id" bigint NOT NULL, "vin" character varying NOT NULL, "tenant_id" bigint NULL, "type" character varying NOT NULL, "snapshot" jsonb NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "vehicleevent_created_at" to table: "vehicle_events"
+CREATE INDEX "vehicleevent_created_at" ON "vehicle_events" ("created_at");
@@ -0,0 +1,6 @@
+-- reverse: create index "vehicle_attributes" to table: "vehicles"
+DROP INDEX "vehicle_attributes";
+-- reverse: create index "vehicle_tags" to table: "vehicles"
+DROP INDEX "vehicle_tags";
+-- reverse: modify "vehicles" table
+ALTER TABLE "vehicles" DROP COLUMN "attributes", DROP COLUMN "fuel_type", DROP COLUMN "year", DROP COLUMN "model", DROP COLUMN "make";
@@ -0,0 +1,6 @@
+-- modify "vehicles" table
+ALTER TABLE "vehicles" ADD COLUMN "make" character varying NULL, ADD COLUMN "model" character varying NULL, ADD COLUMN "year" bigint NULL, ADD COLUMN "fuel_type" character varying NULL, ADD COLUMN "attributes" jsonb NULL;
+-- create index "vehicle_tags" to table: "vehicles"
+CREATE INDEX "vehicle_tags" ON "vehicles" USING GIN ("tags");
+-- create index "vehicle_attributes" to table: "vehicles"
+CREATE INDEX "vehicle_attributes" ON "vehicles" USING GIN ("attributes");
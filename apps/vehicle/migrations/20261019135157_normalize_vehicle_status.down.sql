@@ -0,0 +1,2 @@
+-- reverse: modify "vehicles" table
+ALTER TABLE "vehicles" ALTER COLUMN "status" SET DEFAULT 'Offline';
@@ -0,0 +1,4 @@
+-- modify "vehicles" table
+ALTER TABLE "vehicles" ALTER COLUMN "status" SET DEFAULT 'offline';
+-- normalize existing status values so that filters can match them exactly
+UPDATE "vehicles" SET "status" = lower("status") WHERE "status" <> lower("status");
@@ -0,0 +1,2 @@
+-- reverse: modify "vehicles" table
+ALTER TABLE "vehicles" DROP COLUMN "capacity_kg";
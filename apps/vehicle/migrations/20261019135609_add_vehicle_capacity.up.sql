@@ -0,0 +1,2 @@
+-- modify "vehicles" table
+ALTER TABLE "vehicles" ADD COLUMN "capacity_kg" bigint NULL;
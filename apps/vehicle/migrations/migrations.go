@@ -0,0 +1,350 @@
+// Package migrations 车辆服务的版本化数据库迁移
+//
+// 迁移文件由 ent schema 通过 Atlas 生成 (见 ent/migrate/main.go)，格式与 golang-migrate 相同:
+// <version>_<name>.up.sql / .down.sql，版本记录在 schema_migrations 表中。
+// 生成后的文件需要评审，可以手工修改 (例如把 DROP + ADD 改成 RENAME COLUMN)，修改后用
+// atlas migrate hash 重新计算 atlas.sum。
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+//go:embed *.sql atlas.sum
+var files embed.FS
+
+// lockKey pg_advisory_lock 的 key，多个副本同时执行迁移时串行
+const lockKey = 0x63686579615f7668 // "cheya_vh"
+
+// ErrOutdated 数据库的 schema 落后于当前版本
+var ErrOutdated = errors.New("database schema is outdated")
+
+// Migration 一个版本的迁移
+type Migration struct {
+	Version uint64
+	Name    string
+	up      string
+	down    string
+}
+
+// Status 数据库当前的迁移状态
+type Status struct {
+	Current uint64 // 0 表示还没有执行过迁移
+	Dirty   bool   // 上次迁移中断，需要手工修复后 force
+	Applied []Migration
+	Pending []Migration
+}
+
+// Latest 当前代码期望的 schema 版本
+func (s *Status) Latest() uint64 {
+	if len(s.Pending) > 0 {
+		return s.Pending[len(s.Pending)-1].Version
+	}
+	return s.Current
+}
+
+// Migrator 在 PostgreSQL 上执行迁移
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New 加载内嵌的迁移文件并校验 atlas.sum，文件被改动但没有重新计算时返回错误
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// load 读取 fsys 中的迁移文件，按版本号排序
+func load(fsys fs.FS) ([]Migration, error) {
+	//1.校验 atlas.sum
+	dir := &migrate.MemDir{}
+	names, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range append(names, migrate.HashFileName) {
+		b, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile(name, b); err != nil {
+			return nil, err
+		}
+	}
+	if err := migrate.Validate(dir); err != nil {
+		return nil, fmt.Errorf("validate migration files: %w", err)
+	}
+
+	//2.按版本号配对 up / down
+	byVersion := map[uint64]*Migration{}
+	for _, name := range names {
+		base, kind, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), ".")
+		if !ok || (kind != "up" && kind != "down") {
+			return nil, fmt.Errorf("unexpected migration file %q", name)
+		}
+		version, title, _ := strings.Cut(base, "_")
+		v, err := strconv.ParseUint(version, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected migration file %q", name)
+		}
+		m, ok := byVersion[v]
+		if !ok {
+			m = &Migration{Version: v, Name: title}
+			byVersion[v] = m
+		}
+		b, _ := fs.ReadFile(fsys, name)
+		if kind == "up" {
+			m.up = string(b)
+		} else {
+			m.down = string(b)
+		}
+	}
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, fmt.Errorf("migration %d has no up file", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// withLock 在持有 advisory lock 的连接上执行
+func (m *Migrator) withLock(ctx context.Context, fn func(*sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockKey)
+	if _, err := conn.ExecContext(ctx,
+		`CREATE TABLE IF NOT EXISTS schema_migrations (version bigint NOT NULL PRIMARY KEY, dirty boolean NOT NULL)`,
+	); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// version 当前版本，schema_migrations 为空时返回 0
+func version(ctx context.Context, q querier) (uint64, bool, error) {
+	var (
+		v     uint64
+		dirty bool
+	)
+	err := q.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&v, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	return v, dirty, err
+}
+
+// setVersion schema_migrations 只保存一行，version 为 0 时清空
+func setVersion(ctx context.Context, tx *sql.Tx, v uint64, dirty bool) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schema_migrations"); err != nil {
+		return err
+	}
+	if v == 0 {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, "INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)", v, dirty)
+	return err
+}
+
+// status 在已经持有锁的连接上读取状态
+func (m *Migrator) status(ctx context.Context, q querier) (*Status, error) {
+	current, dirty, err := version(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	s := &Status{Current: current, Dirty: dirty}
+	for _, mg := range m.migrations {
+		if mg.Version <= current {
+			s.Applied = append(s.Applied, mg)
+		} else {
+			s.Pending = append(s.Pending, mg)
+		}
+	}
+	return s, nil
+}
+
+// apply 在一个事务中执行 SQL 并更新版本，PostgreSQL 的 DDL 可以回滚，失败时不会留下半完成的迁移
+func apply(ctx context.Context, conn *sql.Conn, stmts string, to uint64) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, stmts); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := setVersion(ctx, tx, to, false); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// Up 依次执行 n 个待执行的迁移，n <= 0 表示全部
+func (m *Migrator) Up(ctx context.Context, n int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		s, err := m.status(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if s.Dirty {
+			return fmt.Errorf("schema version %d is dirty, fix it manually and run migrate force", s.Current)
+		}
+		pending := s.Pending
+		if n > 0 && n < len(pending) {
+			pending = pending[:n]
+		}
+		for _, mg := range pending {
+			if err := apply(ctx, conn, mg.up, mg.Version); err != nil {
+				return fmt.Errorf("migration %d_%s: %w", mg.Version, mg.Name, err)
+			}
+			done = append(done, mg)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// revert 一步回滚: 执行 Migration 的 down 文件后版本变为 to
+type revert struct {
+	Migration
+	to uint64
+}
+
+// downPlan 计算回滚 n 个迁移的步骤，n <= 0 时回滚 1 个
+// 执行前检查所有步骤，缺少 down 文件时一个都不回滚
+func downPlan(s *Status, n int) ([]revert, error) {
+	if n <= 0 {
+		n = 1
+	}
+	if s.Current != 0 && (len(s.Applied) == 0 || s.Applied[len(s.Applied)-1].Version != s.Current) {
+		return nil, fmt.Errorf("schema version %d has no migration file", s.Current)
+	}
+	var plan []revert
+	for i := len(s.Applied) - 1; i >= 0 && len(plan) < n; i-- {
+		mg := s.Applied[i]
+		if mg.down == "" {
+			return nil, fmt.Errorf("migration %d_%s has no down file", mg.Version, mg.Name)
+		}
+		var prev uint64
+		if i > 0 {
+			prev = s.Applied[i-1].Version
+		}
+		plan = append(plan, revert{Migration: mg, to: prev})
+	}
+	return plan, nil
+}
+
+// Down 依次回滚 n 个已执行的迁移，n <= 0 时回滚 1 个
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		s, err := m.status(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if s.Dirty {
+			return fmt.Errorf("schema version %d is dirty, fix it manually and run migrate force", s.Current)
+		}
+		plan, err := downPlan(s, n)
+		if err != nil {
+			return err
+		}
+		for _, r := range plan {
+			if err := apply(ctx, conn, r.down, r.to); err != nil {
+				return fmt.Errorf("revert %d_%s: %w", r.Version, r.Name, err)
+			}
+			done = append(done, r.Migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Force 只修改版本记录不执行 SQL，用于清除 dirty 标记，
+// 或者把自动迁移创建的已有数据库标记为基线版本
+func (m *Migrator) Force(ctx context.Context, v uint64) error {
+	if v != 0 && !m.known(v) {
+		return fmt.Errorf("unknown migration version %d", v)
+	}
+	return m.withLock(ctx, func(conn *sql.Conn) error {
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if err := setVersion(ctx, tx, v, false); err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	})
+}
+
+func (m *Migrator) known(v uint64) bool {
+	for _, mg := range m.migrations {
+		if mg.Version == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Status 数据库当前的迁移状态
+func (m *Migrator) Status(ctx context.Context) (*Status, error) {
+	var s *Status
+	err := m.withLock(ctx, func(conn *sql.Conn) (err error) {
+		s, err = m.status(ctx, conn)
+		return err
+	})
+	return s, err
+}
+
+// Check 启动时检查 schema 是否是最新版本，不获取锁也不创建表
+// 落后或 dirty 时返回 ErrOutdated；数据库比代码新 (滚动发布时新版本先执行了迁移) 时不报错
+func (m *Migrator) Check(ctx context.Context) (*Status, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, "SELECT to_regclass('schema_migrations') IS NOT NULL").Scan(&exists); err != nil {
+		return nil, err
+	}
+	s := &Status{Pending: m.migrations}
+	if exists {
+		var err error
+		if s, err = m.status(ctx, m.db); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case s.Dirty:
+		return s, fmt.Errorf("%w: version %d is dirty", ErrOutdated, s.Current)
+	case len(s.Pending) > 0:
+		return s, fmt.Errorf("%w: at version %d, expected %d", ErrOutdated, s.Current, s.Latest())
+	}
+	return s, nil
+}
@@ -0,0 +1,208 @@
+package migrations
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"ariga.io/atlas/sql/migrate"
+)
+
+// migrationFS 生成带有正确 atlas.sum 的迁移目录
+func migrationFS(t *testing.T, files map[string]string) fstest.MapFS {
+	t.Helper()
+	dir := &migrate.MemDir{}
+	fsys := fstest.MapFS{}
+	for name, content := range files {
+		if err := dir.WriteFile(name, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	sum, err := dir.Checksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := sum.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys[migrate.HashFileName] = &fstest.MapFile{Data: b}
+	return fsys
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatalf("load embedded migrations: %v", err)
+	}
+	ups, err := fs.Glob(files, "*.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) == 0 || len(migrations) != len(ups) {
+		t.Fatalf("loaded %d migrations, want %d", len(migrations), len(ups))
+	}
+	for i, mg := range migrations {
+		if i > 0 && mg.Version <= migrations[i-1].Version {
+			t.Errorf("migration %d is not sorted after %d", mg.Version, migrations[i-1].Version)
+		}
+		if mg.Name == "" || strings.TrimSpace(mg.up) == "" || strings.TrimSpace(mg.down) == "" {
+			t.Errorf("migration %d_%s is missing its name, up or down file", mg.Version, mg.Name)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fsys := migrationFS(t, map[string]string{
+		"2_add_b.up.sql":    "ALTER TABLE a ADD COLUMN b int;",
+		"2_add_b.down.sql":  "ALTER TABLE a DROP COLUMN b;",
+		"10_add_c.up.sql":   "ALTER TABLE a ADD COLUMN c int;",
+		"1_init.up.sql":     "CREATE TABLE a (id int);",
+		"1_init.down.sql":   "DROP TABLE a;",
+		"10_add_c.down.sql": "ALTER TABLE a DROP COLUMN c;",
+	})
+	migrations, err := load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Migration{
+		{Version: 1, Name: "init", up: "CREATE TABLE a (id int);", down: "DROP TABLE a;"},
+		{Version: 2, Name: "add_b", up: "ALTER TABLE a ADD COLUMN b int;", down: "ALTER TABLE a DROP COLUMN b;"},
+		{Version: 10, Name: "add_c", up: "ALTER TABLE a ADD COLUMN c int;", down: "ALTER TABLE a DROP COLUMN c;"},
+	}
+	if len(migrations) != len(want) {
+		t.Fatalf("loaded %d migrations, want %d", len(migrations), len(want))
+	}
+	for i := range want {
+		if migrations[i] != want[i] {
+			t.Errorf("migration %d = %+v, want %+v", i, migrations[i], want[i])
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		fsys    func(t *testing.T) fstest.MapFS
+		wantErr string
+	}{
+		{
+			name: "modified without rehash",
+			fsys: func(t *testing.T) fstest.MapFS {
+				fsys := migrationFS(t, map[string]string{"1_init.up.sql": "CREATE TABLE a (id int);"})
+				fsys["1_init.up.sql"] = &fstest.MapFile{Data: []byte("CREATE TABLE a (id bigint);")}
+				return fsys
+			},
+			wantErr: "validate migration files",
+		},
+		{
+			name: "missing atlas.sum",
+			fsys: func(t *testing.T) fstest.MapFS {
+				return fstest.MapFS{"1_init.up.sql": &fstest.MapFile{Data: []byte("CREATE TABLE a (id int);")}}
+			},
+			wantErr: "atlas.sum",
+		},
+		{
+			name: "down without up",
+			fsys: func(t *testing.T) fstest.MapFS {
+				return migrationFS(t, map[string]string{"1_init.down.sql": "DROP TABLE a;"})
+			},
+			wantErr: "has no up file",
+		},
+		{
+			name: "unexpected file name",
+			fsys: func(t *testing.T) fstest.MapFS {
+				return migrationFS(t, map[string]string{"init.up.sql": "CREATE TABLE a (id int);"})
+			},
+			wantErr: "unexpected migration file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := load(tt.fsys(t))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDownPlan(t *testing.T) {
+	m1 := Migration{Version: 1, Name: "init", up: "up1", down: "down1"}
+	m2 := Migration{Version: 2, Name: "add_b", up: "up2", down: "down2"}
+	m3 := Migration{Version: 3, Name: "add_c", up: "up3", down: "down3"}
+	noDown := Migration{Version: 2, Name: "add_b", up: "up2"}
+
+	tests := []struct {
+		name    string
+		status  Status
+		n       int
+		want    []revert
+		wantErr string
+	}{
+		{
+			name:   "default one step",
+			status: Status{Current: 3, Applied: []Migration{m1, m2, m3}},
+			want:   []revert{{m3, 2}},
+		},
+		{
+			name:   "two steps",
+			status: Status{Current: 3, Applied: []Migration{m1, m2, m3}},
+			n:      2,
+			want:   []revert{{m3, 2}, {m2, 1}},
+		},
+		{
+			name:   "more than applied",
+			status: Status{Current: 2, Applied: []Migration{m1, m2}, Pending: []Migration{m3}},
+			n:      5,
+			want:   []revert{{m2, 1}, {m1, 0}},
+		},
+		{
+			name:   "nothing applied",
+			status: Status{Pending: []Migration{m1, m2}},
+		},
+		{
+			name:    "no down file",
+			status:  Status{Current: 3, Applied: []Migration{m1, noDown, m3}},
+			n:       2,
+			wantErr: "migration 2_add_b has no down file",
+		},
+		{
+			name:    "unknown current version",
+			status:  Status{Current: 5, Applied: []Migration{m1, m2}},
+			wantErr: "schema version 5 has no migration file",
+		},
+		{
+			name:    "unknown version before first migration",
+			status:  Status{Current: 7, Pending: []Migration{{Version: 10, Name: "init", up: "up", down: "down"}}},
+			wantErr: "schema version 7 has no migration file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := downPlan(&tt.status, tt.n)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("downPlan() error = %v, want %q", err, tt.wantErr)
+				}
+				if plan != nil {
+					t.Errorf("downPlan() returned steps with an wantErr: %+v", plan)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(plan) != len(tt.want) {
+				t.Fatalf("downPlan() = %+v, want %+v", plan, tt.want)
+			}
+			for i := range tt.want {
+				if plan[i] != tt.want[i] {
+					t.Errorf("step %d = %+v, want %+v", i, plan[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/hook"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+)
+
+// DefaultCacheTTL GetVehicle 缓存的默认过期时间，车辆变更时会主动删除
+const DefaultCacheTTL = 5 * time.Minute
+
+// WithCacheTTL 修改 GetVehicle 的缓存时间，小于等于 0 时不使用缓存
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(s *VehicleServer) { s.cacheTTL = ttl }
+}
+
+// loadVehicle 缓存未命中时查询数据库，不按租户过滤，由 GetVehicle 检查
+func (s *VehicleServer) loadVehicle(ctx context.Context, vin string) (*vehiclev1.Vehicle, error) {
+	v, err := s.client.Vehicle.Query().Where(vehicle.Vin(vin)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toProtoVehicle(v), nil
+}
+
+// cacheHook 车辆变更的事务提交后删除 GetVehicle 的缓存
+// 在提交前删除的话，并发的查询可能在提交前读到旧数据并回填
+func (s *VehicleServer) cacheHook(next ent.Mutator) ent.Mutator {
+	return hook.VehicleFunc(func(ctx context.Context, m *ent.VehicleMutation) (ent.Value, error) {
+		if _, softDelete := m.DeletedAt(); softDelete && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return next.Mutate(ctx, m)
+		}
+
+		//1.批量修改和删除在执行前查出受影响的 VIN，新增和单条修改从返回值中取
+		var vins []string
+		if !m.Op().Is(ent.OpCreate | ent.OpUpdateOne) {
+			all := schema.SkipSoftDelete(ctx)
+			ids, err := m.IDs(all)
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) > 0 {
+				if vins, err = m.Client().Vehicle.Query().Where(vehicle.IDIn(ids...)).Select(vehicle.FieldVin).Strings(all); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		//2.执行变更
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := value.(*ent.Vehicle); ok {
+			vins = append(vins, v.Vin)
+		}
+		if len(vins) == 0 {
+			return value, nil
+		}
+
+		//3.提交后删除缓存，不在事务中时立即删除
+		invalidate := func() {
+			if err := s.cache.Delete(context.WithoutCancel(ctx), vins...); err != nil {
+				log.Printf("⚠️ vehicle cache invalidate %v failed: %v", vins, err)
+			}
+		}
+		tx, err := m.Tx()
+		if err != nil {
+			invalidate()
+			return value, nil
+		}
+		tx.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				if err := next.Commit(ctx, tx); err != nil {
+					return err
+				}
+				invalidate()
+				return nil
+			})
+		})
+		return value, nil
+	})
+}
+
+// RunCacheInvalidation 处理其它程序发布的缓存失效消息，ctx 取消时退出
+func (s *VehicleServer) RunCacheInvalidation(ctx context.Context) {
+	if s.cache != nil {
+		s.cache.Subscribe(ctx)
+	}
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/device"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/token"
+	vinpkg "github.com/xuewentao/cheya/pkg/vin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// deviceTouchInterval last_seen_at 的最小更新间隔
+const deviceTouchInterval = time.Minute
+
+// newDeviceCredential 生成 cd_<前缀>_<密钥>
+func newDeviceCredential() (credential, prefix string, err error) {
+	id := make([]byte, 5)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	prefix = token.DeviceCredentialPrefix + strings.ToLower(enc.EncodeToString(id))
+	return prefix + "_" + enc.EncodeToString(secret), prefix, nil
+}
+
+func hashCredential(c string) string {
+	sum := sha256.Sum256([]byte(c))
+	return hex.EncodeToString(sum[:])
+}
+
+func toProtoDevice(d *ent.Device, vin string) *vehiclev1.Device {
+	pb := &vehiclev1.Device{
+		Id:        fmt.Sprintf("%d", d.ID),
+		Prefix:    d.Prefix,
+		Vin:       vin,
+		Name:      d.Name,
+		CreatedAt: timestamppb.New(d.CreatedAt),
+	}
+	if d.LastSeenAt != nil {
+		pb.LastSeenAt = timestamppb.New(*d.LastSeenAt)
+	}
+	if d.RevokedAt != nil {
+		pb.RevokedAt = timestamppb.New(*d.RevokedAt)
+	}
+	return pb
+}
+
+// scopedVehicle 按 VIN 查询调用者租户内的车辆，VIN 与存储时一样规范化后再比较
+func (s *VehicleServer) scopedVehicle(ctx context.Context, p *grpcauth.Principal, vin string) (*ent.Vehicle, error) {
+	scope, err := tenantScope(p)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.client.Vehicle.Query().
+		Where(vehicle.Vin(vinpkg.Normalize(vin))).
+		Where(scope...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "vehicle not found:%s ", vin)
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	return v, nil
+}
+
+// ProvisionDevice 为车辆开通车载终端
+func (s *VehicleServer) ProvisionDevice(ctx context.Context, req *vehiclev1.ProvisionDeviceRequest) (*vehiclev1.ProvisionDeviceResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, prefix, err := newDeviceCredential()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "generate credential: %v", err)
+	}
+	d, err := s.client.Device.Create().
+		SetPrefix(prefix).
+		SetCredentialHash(hashCredential(credential)).
+		SetName(req.Name).
+		SetVehicle(v).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create device: %v", err)
+	}
+	log.Printf("📟 Device %s provisioned for %s by %s", d.Prefix, v.Vin, p.Username)
+	return &vehiclev1.ProvisionDeviceResponse{
+		Device:     toProtoDevice(d, v.Vin),
+		Credential: credential,
+	}, nil
+}
+
+// ListDevices 列出车辆的车载终端
+func (s *VehicleServer) ListDevices(ctx context.Context, req *vehiclev1.ListDevicesRequest) (*vehiclev1.ListDevicesResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := v.QueryDevices().
+		Order(ent.Desc(device.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	resp := &vehiclev1.ListDevicesResponse{Devices: make([]*vehiclev1.Device, len(devices))}
+	for i, d := range devices {
+		resp.Devices[i] = toProtoDevice(d, v.Vin)
+	}
+	return resp, nil
+}
+
+// RevokeDevice 停用车载终端
+func (s *VehicleServer) RevokeDevice(ctx context.Context, req *vehiclev1.RevokeDeviceRequest) (*vehiclev1.RevokeDeviceResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := tenantScope(p)
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %s", req.Id)
+	}
+	n, err := s.client.Device.Update().
+		Where(device.ID(id), device.RevokedAtIsNil(), device.HasVehicleWith(scope...)).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		return nil, status.Errorf(codes.NotFound, "device not found or already revoked: %s", req.Id)
+	}
+	log.Printf("🚫 Device %s revoked by %s", req.Id, p.Username)
+	return &vehiclev1.RevokeDeviceResponse{Id: req.Id}, nil
+}
+
+// AuthenticateDevice 校验设备凭证
+// 凭证本身就是调用者的身份，不需要用户 token；无效凭证返回 active=false
+func (s *VehicleServer) AuthenticateDevice(ctx context.Context, req *vehiclev1.AuthenticateDeviceRequest) (*vehiclev1.AuthenticateDeviceResponse, error) {
+	if !token.IsDeviceCredential(req.Credential) {
+		return &vehiclev1.AuthenticateDeviceResponse{Active: false}, nil
+	}
+	d, err := s.client.Device.Query().
+		Where(device.CredentialHash(hashCredential(req.Credential)), device.RevokedAtIsNil()).
+		WithVehicle().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &vehiclev1.AuthenticateDeviceResponse{Active: false}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	//绑定的车辆已删除，查询时被过滤掉
+	v := d.Edges.Vehicle
+	if v == nil {
+		return &vehiclev1.AuthenticateDeviceResponse{Active: false}, nil
+	}
+	now := time.Now()
+	if d.LastSeenAt == nil || now.Sub(*d.LastSeenAt) > deviceTouchInterval {
+		if err := s.client.Device.UpdateOne(d).SetLastSeenAt(now).Exec(ctx); err != nil {
+			log.Printf("⚠️ update device %s last_seen_at failed: %v", d.Prefix, err)
+		}
+	}
+	return &vehiclev1.AuthenticateDeviceResponse{
+		Active:   true,
+		DeviceId: fmt.Sprintf("%d", d.ID),
+		Vin:      v.Vin,
+		TenantId: formatTenantID(v.TenantID),
+	}, nil
+}
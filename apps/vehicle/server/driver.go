@@ -0,0 +1,539 @@
+package server
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverprofile"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultDriverPageSize = 50
+	maxDriverPageSize     = 200
+	maxAssignmentResults  = 1000            // ListDriverAssignments 最多返回的记录数
+	assignmentClockSkew   = 1 * time.Minute // 允许调用方时钟略快于服务端
+	driverLicenseConflict = "驾驶证号在组织内已存在"
+)
+
+var (
+	// 驾驶证号: 身份证号或外籍驾驶证号，只校验字符和长度
+	licenseNumberPattern = regexp.MustCompile(`^[0-9A-Z]{6,32}$`)
+	phonePattern         = regexp.MustCompile(`^\+?[0-9-]{5,20}$`)
+)
+
+// driverScope 返回调用者可见司机的查询条件，平台管理员不按租户过滤
+func driverScope(p *grpcauth.Principal) ([]predicate.DriverProfile, error) {
+	if p.IsPlatformAdmin() {
+		return nil, nil
+	}
+	tenantID, err := parseTenantID(p.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "caller does not belong to an organization")
+	}
+	return []predicate.DriverProfile{driverprofile.TenantID(tenantID)}, nil
+}
+
+// scopedDriver 按 ID 查询调用者租户内的司机，其他租户的司机同样返回 NotFound
+func (s *VehicleServer) scopedDriver(ctx context.Context, p *grpcauth.Principal, id string) (*ent.DriverProfile, error) {
+	scope, err := driverScope(p)
+	if err != nil {
+		return nil, err
+	}
+	did, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid driver id: %q", id)
+	}
+	d, err := s.client.DriverProfile.Query().
+		Where(driverprofile.ID(did)).
+		Where(scope...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "driver not found: %s", id)
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	return d, nil
+}
+
+func toProtoDriver(d *ent.DriverProfile) *vehiclev1.Driver {
+	pb := &vehiclev1.Driver{
+		Id:            strconv.Itoa(d.ID),
+		Name:          d.Name,
+		LicenseNumber: d.LicenseNumber,
+		Phone:         d.Phone,
+		TenantId:      formatTenantID(d.TenantID),
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+		UpdatedAt:     timestamppb.New(d.UpdatedAt),
+	}
+	if d.LicenseExpiry != nil {
+		pb.LicenseExpiry = timestamppb.New(*d.LicenseExpiry)
+	}
+	return pb
+}
+
+// toProtoAssignment 需要预先加载 driver 和 vehicle
+func toProtoAssignment(a *ent.DriverAssignment) *vehiclev1.DriverAssignment {
+	pb := &vehiclev1.DriverAssignment{
+		Id:         strconv.Itoa(a.ID),
+		DriverId:   strconv.Itoa(a.DriverID),
+		StartTime:  timestamppb.New(a.StartedAt),
+		AssignedBy: a.AssignedBy,
+	}
+	if a.EndedAt != nil {
+		pb.EndTime = timestamppb.New(*a.EndedAt)
+	}
+	if d := a.Edges.Driver; d != nil {
+		pb.DriverName = d.Name
+	}
+	if v := a.Edges.Vehicle; v != nil {
+		pb.Vin = v.Vin
+	}
+	return pb
+}
+
+func validateDriverName(errs *grpcerr.FieldErrors, name string) string {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		errs.Add("name", "姓名不能为空")
+	case len([]rune(name)) > 64:
+		errs.Add("name", "姓名不能超过 64 个字符")
+	}
+	return name
+}
+
+func validateLicenseNumber(errs *grpcerr.FieldErrors, n string) string {
+	n = strings.ToUpper(strings.Join(strings.Fields(n), ""))
+	if !licenseNumberPattern.MatchString(n) {
+		errs.Add("license_number", "驾驶证号应为 6-32 位数字或字母")
+	}
+	return n
+}
+
+func validatePhone(errs *grpcerr.FieldErrors, phone string) string {
+	phone = strings.Join(strings.Fields(phone), "")
+	if phone != "" && !phonePattern.MatchString(phone) {
+		errs.Add("phone", "联系电话格式不正确")
+	}
+	return phone
+}
+
+// CreateDriver 登记司机
+func (s *VehicleServer) CreateDriver(ctx context.Context, req *vehiclev1.CreateDriverRequest) (*vehiclev1.CreateDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	_, tid, err := targetTenant(p, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	var errs grpcerr.FieldErrors
+	create := s.client.DriverProfile.Create().
+		SetName(validateDriverName(&errs, req.Name)).
+		SetLicenseNumber(validateLicenseNumber(&errs, req.LicenseNumber)).
+		SetPhone(validatePhone(&errs, req.Phone)).
+		SetTenantID(tid)
+	if req.LicenseExpiry != nil {
+		create.SetLicenseExpiry(req.LicenseExpiry.AsTime())
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	d, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, driverLicenseConflict)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create driver: %v", err)
+	}
+	log.Printf("🧑‍✈️ Driver %d %q created by %s", d.ID, d.Name, p.Username)
+	return &vehiclev1.CreateDriverResponse{Driver: toProtoDriver(d)}, nil
+}
+
+// GetDriver 查询司机
+func (s *VehicleServer) GetDriver(ctx context.Context, req *vehiclev1.GetDriverRequest) (*vehiclev1.GetDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.scopedDriver(ctx, p, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &vehiclev1.GetDriverResponse{Driver: toProtoDriver(d)}, nil
+}
+
+// ListDrivers 列出司机，按 ID 游标分页
+func (s *VehicleServer) ListDrivers(ctx context.Context, req *vehiclev1.ListDriversRequest) (*vehiclev1.ListDriversResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := driverScope(p)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.过滤条件
+	query := s.client.DriverProfile.Query().Where(scope...)
+	if q := strings.TrimSpace(req.Query); q != "" {
+		query.Where(driverprofile.Or(
+			driverprofile.NameContainsFold(q),
+			driverprofile.LicenseNumberContainsFold(q),
+		))
+	}
+
+	//2.分页: page_token 是上一页最后一条记录的 id
+	limit := int(req.PageSize)
+	switch {
+	case limit < 1:
+		limit = defaultDriverPageSize
+	case limit > maxDriverPageSize:
+		limit = maxDriverPageSize
+	}
+	if req.PageToken != "" {
+		after, err := strconv.Atoi(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		query.Where(driverprofile.IDGT(after))
+	}
+	drivers, err := query.
+		Order(ent.Asc(driverprofile.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	resp := &vehiclev1.ListDriversResponse{}
+	if len(drivers) > limit {
+		drivers = drivers[:limit]
+		resp.NextPageToken = strconv.Itoa(drivers[limit-1].ID)
+	}
+	for _, d := range drivers {
+		resp.Drivers = append(resp.Drivers, toProtoDriver(d))
+	}
+	return resp, nil
+}
+
+// UpdateDriver 修改司机信息
+func (s *VehicleServer) UpdateDriver(ctx context.Context, req *vehiclev1.UpdateDriverRequest) (*vehiclev1.UpdateDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.scopedDriver(ctx, p, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.要更新的字段，没有 update_mask 时只更新非空的字段
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		for path, set := range map[string]bool{
+			"name":           req.Name != "",
+			"license_number": req.LicenseNumber != "",
+			"license_expiry": req.LicenseExpiry != nil,
+			"phone":          req.Phone != "",
+		} {
+			if set {
+				paths = append(paths, path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	//2.校验并写入
+	var errs grpcerr.FieldErrors
+	update := d.Update()
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.SetName(validateDriverName(&errs, req.Name))
+		case "license_number":
+			update.SetLicenseNumber(validateLicenseNumber(&errs, req.LicenseNumber))
+		case "license_expiry":
+			if req.LicenseExpiry == nil {
+				update.ClearLicenseExpiry()
+			} else {
+				update.SetLicenseExpiry(req.LicenseExpiry.AsTime())
+			}
+		case "phone":
+			update.SetPhone(validatePhone(&errs, req.Phone))
+		default:
+			errs.Add("update_mask", "不支持修改字段 "+path)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	d, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, driverLicenseConflict)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update driver: %v", err)
+	}
+	return &vehiclev1.UpdateDriverResponse{Driver: toProtoDriver(d)}, nil
+}
+
+// assignmentTime 请求中的时间，默认为当前时间，不能晚于当前时间
+func assignmentTime(field string, ts *timestamppb.Timestamp) (time.Time, error) {
+	now := time.Now()
+	if ts == nil {
+		return now, nil
+	}
+	t := ts.AsTime()
+	if t.After(now.Add(assignmentClockSkew)) {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s cannot be in the future", field)
+	}
+	return t, nil
+}
+
+// AssignDriver 司机开始驾驶车辆
+// 车辆或司机正在进行的驾驶记录在 start_time 结束；同一司机重复分配同一辆车时返回当前记录
+func (s *VehicleServer) AssignDriver(ctx context.Context, req *vehiclev1.AssignDriverRequest) (*vehiclev1.AssignDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" || req.DriverId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin and driver_id are required")
+	}
+	start, err := assignmentTime("start_time", req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.车辆和司机必须属于同一租户，驾驶证在开始时间仍有效
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.scopedDriver(ctx, p, req.DriverId)
+	if err != nil {
+		return nil, err
+	}
+	if d.TenantID != v.TenantID {
+		return nil, status.Errorf(codes.InvalidArgument, "司机与车辆不属于同一组织")
+	}
+	if d.LicenseExpiry != nil && d.LicenseExpiry.Before(start) {
+		return nil, status.Errorf(codes.FailedPrecondition, "驾驶证已于 %s 过期", d.LicenseExpiry.Format(time.DateOnly))
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	involved := driverassignment.Or(driverassignment.VehicleID(v.ID), driverassignment.DriverID(d.ID))
+
+	//2.不能与已经结束的记录重叠
+	overlap, err := tx.DriverAssignment.Query().
+		Where(involved, driverassignment.EndedAtGT(start)).
+		Exist(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if overlap {
+		tx.Rollback()
+		return nil, status.Errorf(codes.FailedPrecondition, "start_time 与已有驾驶记录重叠")
+	}
+
+	//3.结束车辆和司机正在进行的记录 (交接班)
+	open, err := tx.DriverAssignment.Query().
+		Where(involved, driverassignment.EndedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	for _, a := range open {
+		if a.VehicleID == v.ID && a.DriverID == d.ID {
+			tx.Rollback()
+			a.Edges.Driver, a.Edges.Vehicle = d, v
+			return &vehiclev1.AssignDriverResponse{Assignment: toProtoAssignment(a)}, nil
+		}
+		if !a.StartedAt.Before(start) {
+			tx.Rollback()
+			return nil, status.Errorf(codes.FailedPrecondition, "start_time 早于当前驾驶记录的开始时间")
+		}
+		if err := tx.DriverAssignment.UpdateOne(a).SetEndedAt(start).Exec(ctx); err != nil {
+			tx.Rollback()
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+	}
+
+	//4.新的驾驶记录，并发分配由部分唯一索引拦截
+	a, err := tx.DriverAssignment.Create().
+		SetDriverID(d.ID).
+		SetVehicleID(v.ID).
+		SetStartedAt(start).
+		SetAssignedBy(historyActor(ctx)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.Aborted, "车辆或司机的驾驶记录被同时修改，请重试")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to assign driver: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	log.Printf("🚚 Driver %d assigned to %s by %s", d.ID, v.Vin, p.Username)
+	a.Edges.Driver, a.Edges.Vehicle = d, v
+	return &vehiclev1.AssignDriverResponse{Assignment: toProtoAssignment(a)}, nil
+}
+
+// UnassignDriver 结束车辆当前的驾驶记录
+func (s *VehicleServer) UnassignDriver(ctx context.Context, req *vehiclev1.UnassignDriverRequest) (*vehiclev1.UnassignDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	end, err := assignmentTime("end_time", req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	a, err := v.QueryDriverAssignments().
+		Where(driverassignment.EndedAtIsNil()).
+		WithDriver().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "vehicle %s has no driver", req.Vin)
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if !end.After(a.StartedAt) {
+		return nil, status.Errorf(codes.InvalidArgument, "end_time must be after the start of the assignment")
+	}
+	//只结束仍在进行的记录，避免覆盖并发的交接
+	n, err := s.client.DriverAssignment.Update().
+		Where(driverassignment.ID(a.ID), driverassignment.EndedAtIsNil()).
+		SetEndedAt(end).
+		Save(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if n == 0 {
+		return nil, status.Errorf(codes.Aborted, "驾驶记录已被修改，请重试")
+	}
+	a.EndedAt, a.Edges.Vehicle = &end, v
+	log.Printf("🅿️ Driver %d unassigned from %s by %s", a.DriverID, v.Vin, p.Username)
+	return &vehiclev1.UnassignDriverResponse{Assignment: toProtoAssignment(a)}, nil
+}
+
+// GetCurrentDriver 车辆当前的司机
+func (s *VehicleServer) GetCurrentDriver(ctx context.Context, req *vehiclev1.GetCurrentDriverRequest) (*vehiclev1.GetCurrentDriverResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	a, err := v.QueryDriverAssignments().
+		Where(driverassignment.EndedAtIsNil()).
+		WithDriver().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &vehiclev1.GetCurrentDriverResponse{}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	a.Edges.Vehicle = v
+	return &vehiclev1.GetCurrentDriverResponse{
+		Driver:     toProtoDriver(a.Edges.Driver),
+		Assignment: toProtoAssignment(a),
+	}, nil
+}
+
+// ListDriverAssignments 按司机或车辆查询与 [start_time, end_time) 有交集的驾驶记录
+func (s *VehicleServer) ListDriverAssignments(ctx context.Context, req *vehiclev1.ListDriverAssignmentsRequest) (*vehiclev1.ListDriverAssignmentsResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	if req.DriverId == "" && req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "driver_id or vin is required")
+	}
+	if req.StartTime != nil && req.EndTime != nil && !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Errorf(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	//1.司机 / 车辆，已删除的车辆同样可以查询历史记录
+	all := schema.SkipSoftDelete(ctx)
+	query := s.client.DriverAssignment.Query()
+	if req.DriverId != "" {
+		d, err := s.scopedDriver(ctx, p, req.DriverId)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(driverassignment.DriverID(d.ID))
+	}
+	if req.Vin != "" {
+		v, err := s.scopedVehicle(all, p, req.Vin)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(driverassignment.VehicleID(v.ID))
+	}
+
+	//2.时间段有交集: started_at < end_time AND (ended_at IS NULL OR ended_at > start_time)
+	if req.EndTime != nil {
+		query.Where(driverassignment.StartedAtLT(req.EndTime.AsTime()))
+	}
+	if req.StartTime != nil {
+		query.Where(driverassignment.Or(
+			driverassignment.EndedAtIsNil(),
+			driverassignment.EndedAtGT(req.StartTime.AsTime()),
+		))
+	}
+	assignments, err := query.
+		WithDriver().
+		WithVehicle().
+		Order(ent.Asc(driverassignment.FieldStartedAt), ent.Asc(driverassignment.FieldID)).
+		Limit(maxAssignmentResults).
+		All(all)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	resp := &vehiclev1.ListDriverAssignmentsResponse{}
+	for _, a := range assignments {
+		resp.Assignments = append(resp.Assignments, toProtoAssignment(a))
+	}
+	return resp, nil
+}
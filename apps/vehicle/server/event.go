@@ -0,0 +1,392 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/hook"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicleevent"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	watchPollInterval = time.Second
+	watchBatchSize    = 500
+
+	// eventGapTimeout 事件 id 在插入时分配，事务提交的顺序不一定和 id 顺序一致，
+	// 较小的 id 可能晚一点才可见；等待超过这个时间仍然没有出现，认为事务已经回滚 (序列值被跳过)
+	eventGapTimeout = 30 * time.Second
+
+	// EventRetention 事件保留时间，更早的 resume_token 失效
+	EventRetention = 7 * 24 * time.Hour
+)
+
+// eventHook 在 mutation 所在的事务中写入 vehicle_events (transactional outbox)
+// 软删除会把删除改写为设置 deleted_at 的更新并再次经过 hook，这次更新由外层的删除记录
+func eventHook(next ent.Mutator) ent.Mutator {
+	return hook.VehicleFunc(func(ctx context.Context, m *ent.VehicleMutation) (ent.Value, error) {
+		if _, softDelete := m.DeletedAt(); softDelete && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return next.Mutate(ctx, m)
+		}
+
+		//软删除会修改同一个 mutation 的 Op，执行之后不能再用 m.Op() 判断
+		op := m.Op()
+
+		//1.变更的车辆，删除需要保存删除前的数据
+		all := schema.SkipSoftDelete(ctx)
+		var (
+			ids    []int
+			before []*ent.Vehicle
+			err    error
+		)
+		if !op.Is(ent.OpCreate) {
+			if ids, err = m.IDs(all); err != nil {
+				return nil, err
+			}
+			if len(ids) == 0 {
+				return next.Mutate(ctx, m)
+			}
+			if op.Is(ent.OpDelete | ent.OpDeleteOne) {
+				if before, err = m.Client().Vehicle.Query().Where(vehicle.IDIn(ids...)).All(all); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		//2.执行变更
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		//3.生成事件，数据取变更后的值
+		var vehicles []*ent.Vehicle
+		typ := vehicleevent.TypeUpdated
+		switch {
+		case op.Is(ent.OpCreate):
+			if v, ok := value.(*ent.Vehicle); ok {
+				vehicles = append(vehicles, v)
+			}
+			typ = vehicleevent.TypeCreated
+		case op.Is(ent.OpDelete | ent.OpDeleteOne):
+			vehicles = before
+			typ = vehicleevent.TypeDeleted
+		default:
+			if m.DeletedAtCleared() {
+				typ = vehicleevent.TypeCreated
+			}
+			if vehicles, err = m.Client().Vehicle.Query().Where(vehicle.IDIn(ids...)).All(all); err != nil {
+				return nil, err
+			}
+		}
+		events := make([]*ent.VehicleEventCreate, 0, len(vehicles))
+		for _, v := range vehicles {
+			snapshot, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, m.Client().VehicleEvent.Create().
+				SetVehicleID(v.ID).
+				SetVin(v.Vin).
+				SetTenantID(v.TenantID).
+				SetType(typ).
+				SetSnapshot(snapshot))
+		}
+		if len(events) > 0 {
+			if err := m.Client().VehicleEvent.CreateBulk(events...).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	})
+}
+
+// eventGap 读取位置之前还没有出现的事件 id 区间 [From, To]
+type eventGap struct {
+	From  int       `json:"f"`
+	To    int       `json:"t"`
+	since time.Time //开始等待的时间，不编码到 token，重连后重新计时
+}
+
+// watchCursor WatchVehicles 的读取位置，编码后作为 resume_token
+// ID 及之前的事件除 Gaps 以外都已推送
+type watchCursor struct {
+	ID   int        `json:"id"`
+	Gaps []eventGap `json:"gaps,omitempty"`
+}
+
+func encodeWatchCursor(c *watchCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeWatchCursor(token string) (*watchCursor, error) {
+	invalid := status.Errorf(codes.InvalidArgument, "invalid resume_token")
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var c watchCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID < 0 {
+		return nil, invalid
+	}
+	now := time.Now()
+	for i := range c.Gaps {
+		if c.Gaps[i].From > c.Gaps[i].To || c.Gaps[i].To > c.ID {
+			return nil, invalid
+		}
+		c.Gaps[i].since = now
+	}
+	return &c, nil
+}
+
+// predicate 读取新事件和仍在等待的空缺
+func (c *watchCursor) predicate() predicate.VehicleEvent {
+	ps := []predicate.VehicleEvent{vehicleevent.IDGT(c.ID)}
+	for _, g := range c.Gaps {
+		ps = append(ps, vehicleevent.And(vehicleevent.IDGTE(g.From), vehicleevent.IDLTE(g.To)))
+	}
+	return vehicleevent.Or(ps...)
+}
+
+// advance 记录读到的事件 id，返回 false 表示已经处理过
+func (c *watchCursor) advance(id int, now time.Time) bool {
+	if id > c.ID {
+		if id > c.ID+1 {
+			c.Gaps = append(c.Gaps, eventGap{From: c.ID + 1, To: id - 1, since: now})
+		}
+		c.ID = id
+		return true
+	}
+	for i, g := range c.Gaps {
+		if id < g.From || id > g.To {
+			continue
+		}
+		//从区间中去掉这个 id
+		var split []eventGap
+		if id > g.From {
+			split = append(split, eventGap{From: g.From, To: id - 1, since: g.since})
+		}
+		if id < g.To {
+			split = append(split, eventGap{From: id + 1, To: g.To, since: g.since})
+		}
+		c.Gaps = append(c.Gaps[:i], append(split, c.Gaps[i+1:]...)...)
+		return true
+	}
+	return false
+}
+
+// expire 丢弃等待超时的空缺
+func (c *watchCursor) expire(now time.Time) {
+	gaps := c.Gaps[:0]
+	for _, g := range c.Gaps {
+		if now.Sub(g.since) < eventGapTimeout {
+			gaps = append(gaps, g)
+		}
+	}
+	c.Gaps = gaps
+}
+
+// toProtoEventType 事件类型转换为 proto
+func toProtoEventType(t vehicleevent.Type) vehiclev1.VehicleEventType {
+	switch t {
+	case vehicleevent.TypeCreated:
+		return vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_CREATED
+	case vehicleevent.TypeUpdated:
+		return vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_UPDATED
+	case vehicleevent.TypeDeleted:
+		return vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_DELETED
+	default:
+		return vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+// WatchVehicles 推送车辆变更
+// 不带 resume_token 时在同一个快照中读取全部车辆和当前的事件位置，全量列表之后的事件从该位置开始推送
+func (s *VehicleServer) WatchVehicles(req *vehiclev1.WatchVehiclesRequest, stream vehiclev1.VehicleService_WatchVehiclesServer) error {
+	ctx := stream.Context()
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return err
+	}
+	scope, err := tenantScope(p)
+	if err != nil {
+		return err
+	}
+	//平台管理员接收全部租户的事件
+	tenantID := 0
+	if !p.IsPlatformAdmin() {
+		tenantID, _ = parseTenantID(p.TenantID)
+	}
+
+	//1.确定读取位置
+	var cursor *watchCursor
+	if req.ResumeToken != "" {
+		if cursor, err = decodeWatchCursor(req.ResumeToken); err != nil {
+			return err
+		}
+		oldest, err := s.client.VehicleEvent.Query().Order(ent.Asc(vehicleevent.FieldID)).FirstID(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return status.Errorf(codes.Internal, "database error %v", err)
+		}
+		if oldest > cursor.ID+1 {
+			return status.Errorf(codes.OutOfRange, "resume_token has expired, watch again without it")
+		}
+	} else {
+		if cursor, err = s.sendSnapshot(ctx, stream, scope); err != nil {
+			return err
+		}
+	}
+	log.Printf("👀 Watching vehicle events from %d for tenant %q", cursor.ID, p.TenantID)
+
+	//2.轮询 outbox，按 id 顺序推送调用者租户的事件
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := s.sendEvents(ctx, stream, cursor, tenantID)
+			if err != nil {
+				return err
+			}
+			if n < watchBatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendSnapshot 在可重复读事务中推送当前全部车辆，返回全量列表对应的事件位置
+func (s *VehicleServer) sendSnapshot(ctx context.Context, stream vehiclev1.VehicleService_WatchVehiclesServer, scope []predicate.Vehicle) (*watchCursor, error) {
+	tx, err := s.client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	defer tx.Rollback()
+
+	//1.事件位置: 快照中最大的 id，最近一段时间内没有出现的 id 可能属于还没提交的事务，作为空缺等待
+	cursor := &watchCursor{}
+	recent, err := tx.VehicleEvent.Query().
+		Where(vehicleevent.CreatedAtGT(time.Now().Add(-eventGapTimeout))).
+		Order(ent.Asc(vehicleevent.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if len(recent) > 0 {
+		cursor.ID = recent[0]
+		now := time.Now()
+		for _, id := range recent[1:] {
+			cursor.advance(id, now)
+		}
+	} else if cursor.ID, err = tx.VehicleEvent.Query().Order(ent.Desc(vehicleevent.FieldID)).FirstID(ctx); err != nil && !ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	//2.按 id 分批推送车辆
+	after := 0
+	for {
+		vehicles, err := tx.Vehicle.Query().
+			Where(scope...).
+			Where(vehicle.IDGT(after)).
+			Order(ent.Asc(vehicle.FieldID)).
+			Limit(watchBatchSize).
+			All(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+		for _, v := range vehicles {
+			if err := stream.Send(&vehiclev1.WatchVehiclesResponse{
+				Type:    vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_SNAPSHOT,
+				Vehicle: toProtoVehicle(v),
+			}); err != nil {
+				return nil, err
+			}
+			after = v.ID
+		}
+		if len(vehicles) < watchBatchSize {
+			break
+		}
+	}
+	if err := stream.Send(&vehiclev1.WatchVehiclesResponse{
+		Type:        vehiclev1.VehicleEventType_VEHICLE_EVENT_TYPE_SYNCED,
+		ResumeToken: encodeWatchCursor(cursor),
+	}); err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+// sendEvents 读取一批事件并推送，tenantID 为 0 表示不按租户过滤，返回读到的事件数
+// 其它租户的事件同样用于推进读取位置，否则无法区分空缺和其它租户的事件
+func (s *VehicleServer) sendEvents(ctx context.Context, stream vehiclev1.VehicleService_WatchVehiclesServer, cursor *watchCursor, tenantID int) (int, error) {
+	events, err := s.client.VehicleEvent.Query().
+		Where(cursor.predicate()).
+		Order(ent.Asc(vehicleevent.FieldID)).
+		Limit(watchBatchSize).
+		All(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, nil
+		}
+		return 0, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	now := time.Now()
+	for _, e := range events {
+		if !cursor.advance(e.ID, now) || (tenantID != 0 && e.TenantID != tenantID) {
+			continue
+		}
+		var v ent.Vehicle
+		if err := json.Unmarshal(e.Snapshot, &v); err != nil {
+			log.Printf("⚠️ vehicle event %d has invalid snapshot: %v", e.ID, err)
+			continue
+		}
+		if err := stream.Send(&vehiclev1.WatchVehiclesResponse{
+			Type:        toProtoEventType(e.Type),
+			Vehicle:     toProtoVehicle(&v),
+			ResumeToken: encodeWatchCursor(cursor),
+			EventTime:   timestamppb.New(e.CreatedAt),
+		}); err != nil {
+			return 0, err
+		}
+	}
+	cursor.expire(now)
+	return len(events), nil
+}
+
+// RunEventPruner 每小时删除超过保留时间的事件，ctx 取消时退出
+func (s *VehicleServer) RunEventPruner(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		n, err := s.client.VehicleEvent.Delete().
+			Where(vehicleevent.CreatedAtLT(time.Now().Add(-EventRetention))).
+			Exec(ctx)
+		if err != nil {
+			log.Printf("⚠️ prune vehicle events failed: %v", err)
+		} else if n > 0 {
+			log.Printf("🧹 Pruned %d vehicle events", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,514 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/group"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/vin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxGroupDepth       = 8    // 分组最多嵌套层数
+	maxGroupMembership  = 1000 // 一次最多添加 / 移除的车辆数
+	maxGroupNameLength  = 64
+	groupNameConstraint = "分组名称在组织内已存在"
+)
+
+// groupScope 返回调用者可见分组的查询条件，平台管理员不按租户过滤
+func groupScope(p *grpcauth.Principal) ([]predicate.Group, error) {
+	if p.IsPlatformAdmin() {
+		return nil, nil
+	}
+	tenantID, err := parseTenantID(p.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "caller does not belong to an organization")
+	}
+	return []predicate.Group{group.TenantID(tenantID)}, nil
+}
+
+// scopedGroup 按 ID 查询调用者租户内的分组，其他租户的分组同样返回 NotFound
+func (s *VehicleServer) scopedGroup(ctx context.Context, p *grpcauth.Principal, id string) (*ent.Group, error) {
+	scope, err := groupScope(p)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group id: %q", id)
+	}
+	g, err := s.client.Group.Query().
+		Where(group.ID(gid)).
+		Where(scope...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "group not found: %s", id)
+		}
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	return g, nil
+}
+
+// groupSubtree 返回分组及全部下级分组的 ID，以及子树的层数 (只有自己时为 1)
+func (s *VehicleServer) groupSubtree(ctx context.Context, root int) ([]int, int, error) {
+	ids, frontier, depth := []int{root}, []int{root}, 1
+	for ; depth <= maxGroupDepth; depth++ {
+		children, err := s.client.Group.Query().
+			Where(group.ParentIDIn(frontier...)).
+			IDs(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(children) == 0 {
+			return ids, depth, nil
+		}
+		ids = append(ids, children...)
+		frontier = children
+	}
+	return ids, depth, nil
+}
+
+// groupDepth 分组所在的层数，顶级分组为 1
+func (s *VehicleServer) groupDepth(ctx context.Context, g *ent.Group) (int, error) {
+	depth := 1
+	for parent := g.ParentID; parent != nil && depth <= maxGroupDepth; depth++ {
+		p, err := s.client.Group.Get(ctx, *parent)
+		if err != nil {
+			return 0, err
+		}
+		parent = p.ParentID
+	}
+	return depth, nil
+}
+
+// checkParent 校验上级分组: 调用者可见、租户相同、不能形成环、嵌套层数不超过上限
+// g 为空表示新建分组
+func (s *VehicleServer) checkParent(ctx context.Context, p *grpcauth.Principal, g *ent.Group, parentID string) (*ent.Group, error) {
+	parent, err := s.scopedGroup(ctx, p, parentID)
+	if err != nil {
+		return nil, err
+	}
+	height := 1
+	if g != nil {
+		if parent.TenantID != g.TenantID {
+			return nil, status.Errorf(codes.InvalidArgument, "上级分组必须属于同一组织")
+		}
+		subtree, h, err := s.groupSubtree(ctx, g.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+		for _, id := range subtree {
+			if id == parent.ID {
+				return nil, status.Errorf(codes.InvalidArgument, "不能把分组移动到自己或下级分组下")
+			}
+		}
+		height = h
+	}
+	depth, err := s.groupDepth(ctx, parent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if depth+height > maxGroupDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "分组最多嵌套 %d 层", maxGroupDepth)
+	}
+	return parent, nil
+}
+
+// groupVehicleCounts 统计分组的直属成员车辆数，已删除的车辆不计入
+// SELECT groups.id, COUNT(vehicles.id) FROM groups
+// LEFT JOIN group_vehicles ... LEFT JOIN vehicles ON ... AND vehicles.deleted_at IS NULL GROUP BY groups.id
+func (s *VehicleServer) groupVehicleCounts(ctx context.Context, ids []int) (map[int]int, error) {
+	var rows []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+	err := s.client.Group.Query().
+		Where(group.IDIn(ids...)).
+		GroupBy(group.FieldID).
+		Aggregate(func(sel *sql.Selector) string {
+			members := sql.Table(group.VehiclesTable)
+			vehicles := sql.Table(vehicle.Table)
+			sel.LeftJoin(members).
+				On(sel.C(group.FieldID), members.C(group.VehiclesPrimaryKey[0])).
+				LeftJoin(vehicles).
+				OnP(sql.And(
+					sql.ColumnsEQ(members.C(group.VehiclesPrimaryKey[1]), vehicles.C(vehicle.FieldID)),
+					sql.IsNull(vehicles.C(vehicle.FieldDeletedAt)),
+				))
+			return sql.As(sql.Count(vehicles.C(vehicle.FieldID)), "count")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[int]int, len(rows))
+	for _, r := range rows {
+		counts[r.ID] = r.Count
+	}
+	return counts, nil
+}
+
+func toProtoGroup(g *ent.Group, vehicleCount int) *vehiclev1.Group {
+	pb := &vehiclev1.Group{
+		Id:           strconv.Itoa(g.ID),
+		Name:         g.Name,
+		Description:  g.Description,
+		TenantId:     formatTenantID(g.TenantID),
+		VehicleCount: int32(vehicleCount),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+		UpdatedAt:    timestamppb.New(g.UpdatedAt),
+	}
+	if g.ParentID != nil {
+		pb.ParentId = strconv.Itoa(*g.ParentID)
+	}
+	return pb
+}
+
+// toProtoGroups 转换为 proto 并填充成员车辆数
+func (s *VehicleServer) toProtoGroups(ctx context.Context, groups []*ent.Group) ([]*vehiclev1.Group, error) {
+	ids := make([]int, len(groups))
+	for i, g := range groups {
+		ids[i] = g.ID
+	}
+	counts, err := s.groupVehicleCounts(ctx, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	pbs := make([]*vehiclev1.Group, len(groups))
+	for i, g := range groups {
+		pbs[i] = toProtoGroup(g, counts[g.ID])
+	}
+	return pbs, nil
+}
+
+func validateGroupName(errs *grpcerr.FieldErrors, name string) string {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		errs.Add("name", "分组名称不能为空")
+	case len([]rune(name)) > maxGroupNameLength:
+		errs.Add("name", fmt.Sprintf("分组名称不能超过 %d 个字符", maxGroupNameLength))
+	}
+	return name
+}
+
+// CreateGroup 新建分组
+func (s *VehicleServer) CreateGroup(ctx context.Context, req *vehiclev1.CreateGroupRequest) (*vehiclev1.CreateGroupResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	var errs grpcerr.FieldErrors
+	name := validateGroupName(&errs, req.Name)
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	//1.有上级分组时租户与上级分组相同，否则为调用者的租户
+	create := s.client.Group.Create().
+		SetName(name).
+		SetDescription(strings.TrimSpace(req.Description))
+	if req.ParentId != "" {
+		parent, err := s.checkParent(ctx, p, nil, req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		create.SetParentID(parent.ID).SetTenantID(parent.TenantID)
+	} else {
+		_, tid, err := targetTenant(p, req.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		create.SetTenantID(tid)
+	}
+
+	//2.写入
+	g, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, groupNameConstraint)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	log.Printf("🗂️ Group %d %q created by %s", g.ID, g.Name, p.Username)
+	return &vehiclev1.CreateGroupResponse{Group: toProtoGroup(g, 0)}, nil
+}
+
+// GetGroup 查询分组
+func (s *VehicleServer) GetGroup(ctx context.Context, req *vehiclev1.GetGroupRequest) (*vehiclev1.GetGroupResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.scopedGroup(ctx, p, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	pbs, err := s.toProtoGroups(ctx, []*ent.Group{g})
+	if err != nil {
+		return nil, err
+	}
+	return &vehiclev1.GetGroupResponse{Group: pbs[0]}, nil
+}
+
+// ListGroups 列出分组，按名称排序
+func (s *VehicleServer) ListGroups(ctx context.Context, req *vehiclev1.ListGroupsRequest) (*vehiclev1.ListGroupsResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := groupScope(p)
+	if err != nil {
+		return nil, err
+	}
+	query := s.client.Group.Query().Where(scope...)
+	if req.ParentId != "" {
+		parent, err := s.scopedGroup(ctx, p, req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(group.ParentID(parent.ID))
+	}
+	groups, err := query.Order(ent.Asc(group.FieldName), ent.Asc(group.FieldID)).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	pbs, err := s.toProtoGroups(ctx, groups)
+	if err != nil {
+		return nil, err
+	}
+	return &vehiclev1.ListGroupsResponse{Groups: pbs}, nil
+}
+
+// UpdateGroup 修改分组名称、描述或上级分组
+func (s *VehicleServer) UpdateGroup(ctx context.Context, req *vehiclev1.UpdateGroupRequest) (*vehiclev1.UpdateGroupResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.scopedGroup(ctx, p, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.要更新的字段，没有 update_mask 时只更新非空的字段
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		for path, value := range map[string]string{"name": req.Name, "description": req.Description, "parent_id": req.ParentId} {
+			if value != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	//2.校验
+	var errs grpcerr.FieldErrors
+	update := g.Update()
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.SetName(validateGroupName(&errs, req.Name))
+		case "description":
+			update.SetDescription(strings.TrimSpace(req.Description))
+		case "parent_id":
+			if req.ParentId == "" {
+				update.ClearParentID()
+				continue
+			}
+			parent, err := s.checkParent(ctx, p, g, req.ParentId)
+			if err != nil {
+				return nil, err
+			}
+			update.SetParentID(parent.ID)
+		default:
+			errs.Add("update_mask", "不支持修改字段 "+path)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	//3.写入
+	g, err = update.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, status.Errorf(codes.AlreadyExists, groupNameConstraint)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	pbs, err := s.toProtoGroups(ctx, []*ent.Group{g})
+	if err != nil {
+		return nil, err
+	}
+	return &vehiclev1.UpdateGroupResponse{Group: pbs[0]}, nil
+}
+
+// DeleteGroup 删除分组，有子分组时需要先移动或删除子分组
+func (s *VehicleServer) DeleteGroup(ctx context.Context, req *vehiclev1.DeleteGroupRequest) (*vehiclev1.DeleteGroupResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.scopedGroup(ctx, p, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	hasChildren, err := g.QueryChildren().Exist(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if hasChildren {
+		return nil, status.Errorf(codes.FailedPrecondition, "分组下还有子分组，不能删除")
+	}
+	//成员关系由 group_vehicles 的外键级联删除
+	if err := s.client.Group.DeleteOne(g).Exec(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	log.Printf("🗑️ Group %d %q deleted by %s", g.ID, g.Name, p.Username)
+	return &vehiclev1.DeleteGroupResponse{}, nil
+}
+
+// groupMemberVINs 规范化并去重 VIN
+func groupMemberVINs(vins []string) ([]string, error) {
+	out := make([]string, 0, len(vins))
+	seen := make(map[string]bool, len(vins))
+	for _, v := range vins {
+		v = vin.Normalize(v)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		out = append(out, v)
+	}
+	switch {
+	case len(out) == 0:
+		return nil, status.Errorf(codes.InvalidArgument, "vins is required")
+	case len(out) > maxGroupMembership:
+		return nil, status.Errorf(codes.InvalidArgument, "一次最多操作 %d 辆车", maxGroupMembership)
+	}
+	return out, nil
+}
+
+// AddGroupVehicles 把车辆加入分组，车辆必须与分组属于同一租户
+func (s *VehicleServer) AddGroupVehicles(ctx context.Context, req *vehiclev1.AddGroupVehiclesRequest) (*vehiclev1.AddGroupVehiclesResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	vins, err := groupMemberVINs(req.Vins)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.scopedGroup(ctx, p, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.查询车辆，不存在或不属于同一租户的 VIN 一并返回
+	vehicles, err := s.client.Vehicle.Query().
+		Where(vehicle.VinIn(vins...), vehicle.TenantID(g.TenantID)).
+		Select(vehicle.FieldID, vehicle.FieldVin).
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if len(vehicles) < len(vins) {
+		found := make(map[string]bool, len(vehicles))
+		for _, v := range vehicles {
+			found[v.Vin] = true
+		}
+		var missing []string
+		for _, v := range vins {
+			if !found[v] {
+				missing = append(missing, v)
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "vehicles not found: %s", strings.Join(missing, ", "))
+	}
+
+	//2.跳过已经是成员的车辆
+	ids := make([]int, len(vehicles))
+	for i, v := range vehicles {
+		ids[i] = v.ID
+	}
+	members, err := g.QueryVehicles().Where(vehicle.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	isMember := make(map[int]bool, len(members))
+	for _, id := range members {
+		isMember[id] = true
+	}
+	var add []int
+	for _, id := range ids {
+		if !isMember[id] {
+			add = append(add, id)
+		}
+	}
+	if len(add) > 0 {
+		if err := g.Update().AddVehicleIDs(add...).Exec(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add vehicles: %v", err)
+		}
+	}
+	return &vehiclev1.AddGroupVehiclesResponse{Added: int32(len(add))}, nil
+}
+
+// RemoveGroupVehicles 把车辆移出分组，不是成员的 VIN 忽略
+func (s *VehicleServer) RemoveGroupVehicles(ctx context.Context, req *vehiclev1.RemoveGroupVehiclesRequest) (*vehiclev1.RemoveGroupVehiclesResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	vins, err := groupMemberVINs(req.Vins)
+	if err != nil {
+		return nil, err
+	}
+	g, err := s.scopedGroup(ctx, p, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	//已删除的车辆同样可以移出
+	ids, err := g.QueryVehicles().Where(vehicle.VinIn(vins...)).IDs(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if len(ids) > 0 {
+		if err := g.Update().RemoveVehicleIDs(ids...).Exec(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to remove vehicles: %v", err)
+		}
+	}
+	return &vehiclev1.RemoveGroupVehiclesResponse{Removed: int32(len(ids))}, nil
+}
+
+// groupFilter ListVehicles 的分组过滤条件
+func (s *VehicleServer) groupFilter(ctx context.Context, p *grpcauth.Principal, req *vehiclev1.ListVehiclesRequest) (predicate.Vehicle, error) {
+	g, err := s.scopedGroup(ctx, p, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ids := []int{g.ID}
+	if req.IncludeSubgroups {
+		if ids, _, err = s.groupSubtree(ctx, g.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "database error %v", err)
+		}
+	}
+	return vehicle.HasGroupsWith(group.IDIn(ids...)), nil
+}
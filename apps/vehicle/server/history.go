@@ -0,0 +1,249 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/hook"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+)
+
+// historyIgnoredFields 不记录变更的字段: 遥测上报的运行数据和自动维护的时间、版本号
+var historyIgnoredFields = map[string]bool{
+	vehicle.FieldStatus:        true,
+	vehicle.FieldLastHeartbeat: true,
+	vehicle.FieldLocation:      true,
+	vehicle.FieldTelemetry:     true,
+	vehicle.FieldUpdatedAt:     true,
+	vehicle.FieldDeletedAt:     true,
+	vehicle.FieldVersion:       true,
+}
+
+// historyActor 变更的操作人
+func historyActor(ctx context.Context) string {
+	p, ok := grpcauth.FromContext(ctx)
+	switch {
+	case !ok:
+		return "system"
+	case p.Role == grpcauth.RoleDevice:
+		return "device:" + p.VIN
+	case p.Username != "":
+		return p.Username
+	default:
+		return "user:" + p.UserID
+	}
+}
+
+// jsonValue 把值转换为 JSON 表示，新旧值用同样的方式转换后才能比较
+func jsonValue(v any) any {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// jsonFields 实体各字段的 JSON 值，未设置的字段不在结果中
+func jsonFields(v *ent.Vehicle) map[string]any {
+	m, _ := jsonValue(v).(map[string]any)
+	return m
+}
+
+// historyHook 在 mutation 所在的事务中写入车辆变更记录
+// 软删除会把删除改写为设置 deleted_at 的更新并再次经过 hook，这次更新由外层的删除记录，不重复记录
+func historyHook(next ent.Mutator) ent.Mutator {
+	return hook.VehicleFunc(func(ctx context.Context, m *ent.VehicleMutation) (ent.Value, error) {
+		if _, softDelete := m.DeletedAt(); softDelete && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+			return next.Mutate(ctx, m)
+		}
+
+		//软删除会修改同一个 mutation 的 Op，执行之后不能再用 m.Op() 判断
+		op := m.Op()
+
+		//1.变更前的数据，包括已删除的行 (恢复操作)
+		var before []*ent.Vehicle
+		if !op.Is(ent.OpCreate) {
+			all := schema.SkipSoftDelete(ctx)
+			ids, err := m.IDs(all)
+			if err != nil {
+				return nil, err
+			}
+			if len(ids) > 0 {
+				if before, err = m.Client().Vehicle.Query().Where(vehicle.IDIn(ids...)).All(all); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		//2.执行变更
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return nil, err
+		}
+
+		//3.生成变更记录
+		actor := historyActor(ctx)
+		var records []*ent.VehicleHistoryCreate
+		add := func(v *ent.Vehicle, action vehiclehistory.Action, changes []schematype.FieldChange) {
+			records = append(records, m.Client().VehicleHistory.Create().
+				SetVehicleID(v.ID).
+				SetVin(v.Vin).
+				SetTenantID(v.TenantID).
+				SetAction(action).
+				SetActor(actor).
+				SetChanges(changes))
+		}
+		switch {
+		case op.Is(ent.OpCreate):
+			v, ok := value.(*ent.Vehicle)
+			if !ok {
+				break
+			}
+			var changes []schematype.FieldChange
+			for _, f := range m.Fields() {
+				if historyIgnoredFields[f] {
+					continue
+				}
+				nv, _ := m.Field(f)
+				changes = append(changes, schematype.FieldChange{Field: f, New: jsonValue(nv)})
+			}
+			add(v, vehiclehistory.ActionCreate, changes)
+		case op.Is(ent.OpDelete | ent.OpDeleteOne):
+			for _, v := range before {
+				add(v, vehiclehistory.ActionDelete, nil)
+			}
+		default:
+			action := vehiclehistory.ActionUpdate
+			if m.DeletedAtCleared() {
+				action = vehiclehistory.ActionRestore
+			}
+			for _, v := range before {
+				changes := diffFields(m, jsonFields(v))
+				if len(changes) == 0 && action == vehiclehistory.ActionUpdate {
+					continue
+				}
+				add(v, action, changes)
+			}
+		}
+		if len(records) > 0 {
+			if err := m.Client().VehicleHistory.CreateBulk(records...).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return value, nil
+	})
+}
+
+// diffFields 对比更新前的值，只返回真正变化的字段
+func diffFields(m *ent.VehicleMutation, old map[string]any) []schematype.FieldChange {
+	var changes []schematype.FieldChange
+	for _, f := range m.Fields() {
+		if historyIgnoredFields[f] {
+			continue
+		}
+		v, _ := m.Field(f)
+		nv := jsonValue(v)
+		if !reflect.DeepEqual(old[f], nv) {
+			changes = append(changes, schematype.FieldChange{Field: f, Old: old[f], New: nv})
+		}
+	}
+	for _, f := range m.ClearedFields() {
+		if historyIgnoredFields[f] || old[f] == nil {
+			continue
+		}
+		changes = append(changes, schematype.FieldChange{Field: f, Old: old[f]})
+	}
+	return changes
+}
+
+// toProtoHistory 变更记录转换为 proto
+func toProtoHistory(h *ent.VehicleHistory) *vehiclev1.VehicleHistoryEntry {
+	pb := &vehiclev1.VehicleHistoryEntry{
+		Id:         strconv.Itoa(h.ID),
+		Action:     string(h.Action),
+		Actor:      h.Actor,
+		CreateTime: timestamppb.New(h.CreatedAt),
+	}
+	for _, c := range h.Changes {
+		fc := &vehiclev1.FieldChange{Field: c.Field}
+		if c.Old != nil {
+			fc.OldValue, _ = structpb.NewValue(c.Old)
+		}
+		if c.New != nil {
+			fc.NewValue, _ = structpb.NewValue(c.New)
+		}
+		pb.Changes = append(pb.Changes, fc)
+	}
+	return pb
+}
+
+// GetVehicleHistory 按时间倒序返回车辆的变更记录，已删除的车辆同样可以查询
+func (s *VehicleServer) GetVehicleHistory(ctx context.Context, req *vehiclev1.GetVehicleHistoryRequest) (*vehiclev1.GetVehicleHistoryResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	v, err := s.scopedVehicle(schema.SkipSoftDelete(ctx), p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+
+	//1.分页: page_token 是上一页最后一条记录的 id
+	limit := int(req.PageSize)
+	switch {
+	case limit < 1:
+		limit = defaultHistoryPageSize
+	case limit > maxHistoryPageSize:
+		limit = maxHistoryPageSize
+	}
+	query := s.client.VehicleHistory.Query().Where(vehiclehistory.VehicleID(v.ID))
+	if req.PageToken != "" {
+		before, err := strconv.Atoi(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		query.Where(vehiclehistory.IDLT(before))
+	}
+	records, err := query.
+		Order(ent.Desc(vehiclehistory.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+
+	//2.多查的一行用于判断是否还有下一页
+	resp := &vehiclev1.GetVehicleHistoryResponse{}
+	if len(records) > limit {
+		records = records[:limit]
+		resp.NextPageToken = strconv.Itoa(records[limit-1].ID)
+	}
+	for _, h := range records {
+		resp.Entries = append(resp.Entries, toProtoHistory(h))
+	}
+	return resp, nil
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schematype"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehiclehistory"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestHistoryActor(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"no principal", context.Background(), "system"},
+		{"user", asUser("alice", grpcauth.RoleOperator), "alice"},
+		{"api key", grpcauth.NewContext(context.Background(), &grpcauth.Principal{UserID: "apikey:3", Role: grpcauth.RoleOperator}), "user:apikey:3"},
+		{"device", grpcauth.NewContext(context.Background(), &grpcauth.Principal{Role: grpcauth.RoleDevice, VIN: "LTEST000000000001"}), "device:LTEST000000000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := historyActor(tt.ctx); got != tt.want {
+				t.Errorf("historyActor = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistoryHook(t *testing.T) {
+	s := newTestServer(t)
+	ctx := asUser("alice", grpcauth.RoleAdmin)
+	v := createVehicle(t, s, "LTEST000000000001", nil)
+
+	//1.修改车牌和标签，记录操作人和前后的值
+	_, err := s.UpdateVehicle(asUser("bob", grpcauth.RoleOperator), &vehiclev1.UpdateVehicleRequest{
+		Vin:          v.Vin,
+		LicensePlate: "京B12345",
+		Tags:         []string{"cold-chain", " cold-chain "},
+	})
+	if err != nil {
+		t.Fatalf("UpdateVehicle: %v", err)
+	}
+	//2.值没有变化的更新不产生记录
+	if _, err := s.UpdateVehicle(ctx, &vehiclev1.UpdateVehicleRequest{Vin: v.Vin, LicensePlate: "京B12345"}); err != nil {
+		t.Fatalf("UpdateVehicle: %v", err)
+	}
+	//3.遥测上报的运行数据不记录
+	s.client.Vehicle.UpdateOne(v).SetStatus("online").SetLastHeartbeat(time.Now()).ExecX(context.Background())
+	//4.清除字段只记录旧值
+	if _, err := s.UpdateVehicle(ctx, &vehiclev1.UpdateVehicleRequest{
+		Vin:        v.Vin,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	}); err != nil {
+		t.Fatalf("UpdateVehicle: %v", err)
+	}
+	//5.删除和恢复
+	if _, err := s.DeleteVehicle(ctx, &vehiclev1.DeleteVehicleRequest{Vin: v.Vin}); err != nil {
+		t.Fatalf("DeleteVehicle: %v", err)
+	}
+	if _, err := s.RestoreVehicle(ctx, &vehiclev1.RestoreVehicleRequest{Vin: v.Vin}); err != nil {
+		t.Fatalf("RestoreVehicle: %v", err)
+	}
+
+	type record struct {
+		action  vehiclehistory.Action
+		actor   string
+		changes []schematype.FieldChange
+	}
+	want := []record{
+		{vehiclehistory.ActionCreate, "system", nil},
+		{vehiclehistory.ActionUpdate, "bob", []schematype.FieldChange{
+			{Field: "license_plate", Old: "京A00001", New: "京B12345"},
+			{Field: "tags", New: []any{"cold-chain"}},
+		}},
+		{vehiclehistory.ActionUpdate, "alice", []schematype.FieldChange{
+			{Field: "tags", Old: []any{"cold-chain"}, New: []any{}},
+		}},
+		{vehiclehistory.ActionDelete, "alice", nil},
+		{vehiclehistory.ActionRestore, "alice", nil},
+	}
+	rows := s.client.VehicleHistory.Query().
+		Where(vehiclehistory.VehicleID(v.ID)).
+		Order(vehiclehistory.ByID()).
+		AllX(context.Background())
+	if len(rows) != len(want) {
+		t.Fatalf("got %d history rows, want %d: %+v", len(rows), len(want), rows)
+	}
+	for i, h := range rows {
+		if h.Action != want[i].action || h.Actor != want[i].actor || h.Vin != v.Vin || h.TenantID != testTenantID {
+			t.Errorf("row %d = %s by %s, want %s by %s", i, h.Action, h.Actor, want[i].action, want[i].actor)
+		}
+		if h.Action == vehiclehistory.ActionCreate {
+			continue
+		}
+		if !reflect.DeepEqual(h.Changes, want[i].changes) {
+			t.Errorf("row %d changes = %+v, want %+v", i, h.Changes, want[i].changes)
+		}
+	}
+
+	//创建记录包含录入的字段
+	created := map[string]any{}
+	for _, c := range rows[0].Changes {
+		created[c.Field] = c.New
+	}
+	if created["vin"] != v.Vin || created["license_plate"] != "京A00001" {
+		t.Errorf("create changes = %+v", rows[0].Changes)
+	}
+	if _, ok := created["status"]; ok {
+		t.Errorf("create record includes ignored field status: %+v", rows[0].Changes)
+	}
+
+	//GetVehicleHistory 按时间倒序返回
+	resp, err := s.GetVehicleHistory(ctx, &vehiclev1.GetVehicleHistoryRequest{Vin: v.Vin, PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetVehicleHistory: %v", err)
+	}
+	if len(resp.Entries) != 2 || resp.Entries[0].Action != "restore" || resp.Entries[1].Action != "delete" || resp.NextPageToken == "" {
+		t.Errorf("first page = %+v", resp)
+	}
+}
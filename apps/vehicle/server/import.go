@@ -0,0 +1,445 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	maxImportBytes  = 10 << 20 // 10MB
+	maxImportRows   = 10000
+	importBatchSize = 500 // 每条 INSERT 的行数，避免超过 Postgres 参数个数上限
+)
+
+// importColumns 表头到字段的映射，忽略大小写，其它列忽略 (导出的文件可以直接修改后导入)
+var importColumns = map[string]string{
+	"vin":           vehicle.FieldVin,
+	"车架号":           vehicle.FieldVin,
+	"license_plate": vehicle.FieldLicensePlate,
+	"plate":         vehicle.FieldLicensePlate,
+	"车牌号":           vehicle.FieldLicensePlate,
+	"车牌":            vehicle.FieldLicensePlate,
+	"tags":          vehicle.FieldTags,
+	"标签":            vehicle.FieldTags,
+	"make":          vehicle.FieldMake,
+	"品牌":            vehicle.FieldMake,
+	"model":         vehicle.FieldModel,
+	"车型":            vehicle.FieldModel,
+	"year":          vehicle.FieldYear,
+	"年款":            vehicle.FieldYear,
+	"fuel_type":     vehicle.FieldFuelType,
+	"燃料类型":          vehicle.FieldFuelType,
+	"capacity_kg":   vehicle.FieldCapacityKg,
+	"额定载质量":         vehicle.FieldCapacityKg,
+}
+
+// importOptional 可选列，文件中没有的列更新时不修改，单元格为空时清除
+var importOptional = []string{
+	vehicle.FieldTags, vehicle.FieldMake, vehicle.FieldModel, vehicle.FieldYear, vehicle.FieldFuelType, vehicle.FieldCapacityKg,
+}
+
+// importRow 校验通过的一行
+type importRow struct {
+	line     int
+	vin      string
+	plate    string
+	tags     []string
+	make     string
+	model    string
+	year     int
+	fuelType vehicle.FuelType
+	capacity int
+	columns  map[string]bool // 文件中有的可选列
+}
+
+// changed 与已有车辆相比是否有变化，只比较文件中有的列
+func (row importRow) changed(v *ent.Vehicle) bool {
+	switch {
+	case v.DeletedAt != nil || v.LicensePlate != row.plate:
+		return true
+	case row.columns[vehicle.FieldTags] && !slices.Equal(v.Tags, row.tags):
+		return true
+	case row.columns[vehicle.FieldMake] && v.Make != row.make:
+		return true
+	case row.columns[vehicle.FieldModel] && v.Model != row.model:
+		return true
+	case row.columns[vehicle.FieldYear] && v.Year != row.year:
+		return true
+	case row.columns[vehicle.FieldFuelType] && v.FuelType != row.fuelType:
+		return true
+	case row.columns[vehicle.FieldCapacityKg] && v.CapacityKg != row.capacity:
+		return true
+	}
+	return false
+}
+
+// parseImportInt 解析数字单元格，空单元格为 0
+func parseImportInt(errs *grpcerr.FieldErrors, field, cell, name string) int32 {
+	cell = strings.TrimSpace(cell)
+	if cell == "" {
+		return 0
+	}
+	n, err := strconv.ParseInt(cell, 10, 32)
+	if err != nil {
+		errs.Add(field, name+"必须是整数")
+		return 0
+	}
+	return int32(n)
+}
+
+// parseImportFuelType 燃料类型: ice / ev / hybrid，忽略大小写
+func parseImportFuelType(errs *grpcerr.FieldErrors, field, cell string) vehicle.FuelType {
+	ft := vehicle.FuelType(strings.ToLower(strings.TrimSpace(cell)))
+	if ft == "" {
+		return ""
+	}
+	if vehicle.FuelTypeValidator(ft) != nil {
+		errs.Add(field, "燃料类型只能是 ice、ev 或 hybrid")
+		return ""
+	}
+	return ft
+}
+
+// splitTags 单元格中的多个标签用分号或竖线分隔 (逗号是 CSV 的列分隔符)
+func splitTags(cell string) []string {
+	return normalizeTags(strings.FieldsFunc(cell, func(r rune) bool {
+		return r == ';' || r == '；' || r == '|'
+	}))
+}
+
+// decodeCSV 去掉 UTF-8 BOM；不是合法 UTF-8 时按 Excel 中文版默认的 GB18030 解码
+func decodeCSV(b []byte) ([]byte, error) {
+	b = bytes.TrimPrefix(b, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(b) {
+		return b, nil
+	}
+	return simplifiedchinese.GB18030.NewDecoder().Bytes(b)
+}
+
+// parseImportCSV 解析并逐行校验，返回校验通过的行和每行的错误
+// 文件本身无法解析 (缺少必填列、引号不匹配等) 时返回 InvalidArgument
+func (s *VehicleServer) parseImportCSV(data []byte) ([]importRow, []*vehiclev1.ImportRowError, int, error) {
+	data, err := decodeCSV(data)
+	if err != nil {
+		return nil, nil, 0, status.Errorf(codes.InvalidArgument, "文件编码无法识别，请保存为 UTF-8 CSV")
+	}
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	//1.表头
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, 0, status.Errorf(codes.InvalidArgument, "文件为空或不是 CSV 格式")
+	}
+	cols := map[string]int{}
+	for i, h := range header {
+		if f, ok := importColumns[strings.ToLower(strings.TrimSpace(h))]; ok {
+			if _, dup := cols[f]; !dup {
+				cols[f] = i
+			}
+		}
+	}
+	for _, f := range []string{vehicle.FieldVin, vehicle.FieldLicensePlate} {
+		if _, ok := cols[f]; !ok {
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "缺少 %s 列", f)
+		}
+	}
+	columns := map[string]bool{}
+	for _, f := range importOptional {
+		_, columns[f] = cols[f]
+	}
+	cell := func(rec []string, f string) string {
+		if i, ok := cols[f]; ok && i < len(rec) {
+			return rec[i]
+		}
+		return ""
+	}
+
+	//2.逐行校验
+	var (
+		rows    []importRow
+		rowErrs []*vehiclev1.ImportRowError
+		total   int
+		seen    = map[string]int{}
+	)
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "CSV 格式错误: %v", err)
+		}
+		//Excel 经常在末尾留下只有逗号的空行
+		if strings.TrimSpace(strings.Join(rec, "")) == "" {
+			continue
+		}
+		total++
+		if total > maxImportRows {
+			return nil, nil, 0, status.Errorf(codes.InvalidArgument, "一次最多导入 %d 行", maxImportRows)
+		}
+		line, _ := r.FieldPos(0)
+
+		var errs grpcerr.FieldErrors
+		row := importRow{
+			line:     line,
+			vin:      s.validateVIN(&errs, vehicle.FieldVin, cell(rec, vehicle.FieldVin)),
+			plate:    validatePlate(&errs, vehicle.FieldLicensePlate, cell(rec, vehicle.FieldLicensePlate)),
+			tags:     splitTags(cell(rec, vehicle.FieldTags)),
+			make:     validateSpec(&errs, vehicle.FieldMake, cell(rec, vehicle.FieldMake)),
+			model:    validateSpec(&errs, vehicle.FieldModel, cell(rec, vehicle.FieldModel)),
+			fuelType: parseImportFuelType(&errs, vehicle.FieldFuelType, cell(rec, vehicle.FieldFuelType)),
+			columns:  columns,
+		}
+		row.year = validateYear(&errs, vehicle.FieldYear, parseImportInt(&errs, vehicle.FieldYear, cell(rec, vehicle.FieldYear), "年款"))
+		row.capacity = validateCapacity(&errs, vehicle.FieldCapacityKg, parseImportInt(&errs, vehicle.FieldCapacityKg, cell(rec, vehicle.FieldCapacityKg), "额定载质量"))
+		if first, ok := seen[row.vin]; ok && row.vin != "" {
+			errs.Add(vehicle.FieldVin, fmt.Sprintf("与第 %d 行的 VIN 重复", first))
+		} else {
+			seen[row.vin] = line
+		}
+		for _, v := range errs {
+			rowErrs = append(rowErrs, &vehiclev1.ImportRowError{
+				Row:     int32(line),
+				Vin:     row.vin,
+				Field:   v.Field,
+				Message: v.Description,
+			})
+		}
+		if len(errs) == 0 {
+			rows = append(rows, row)
+		}
+	}
+	return rows, rowErrs, total, nil
+}
+
+// ImportVehicles 批量导入车辆
+// 按 VIN 新增或更新 (已删除的车辆会被恢复)，全部行在一个事务中写入；
+// 任意一行有错误时不写入，试运行在事务中执行后回滚，数据库约束同样会被检查
+func (s *VehicleServer) ImportVehicles(stream vehiclev1.VehicleService_ImportVehiclesServer) error {
+	ctx := stream.Context()
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return err
+	}
+
+	//1.接收选项和文件内容
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	opts := first.GetOptions()
+	if opts == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must be options")
+	}
+	tenantID, tid, err := targetTenant(p, opts.TenantId)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(data)+len(req.GetChunk()) > maxImportBytes {
+			return status.Errorf(codes.InvalidArgument, "文件不能超过 %dMB", maxImportBytes>>20)
+		}
+		data = append(data, req.GetChunk()...)
+	}
+
+	//2.解析并校验
+	rows, rowErrs, total, err := s.parseImportCSV(data)
+	if err != nil {
+		return err
+	}
+	resp := &vehiclev1.ImportVehiclesResponse{
+		TotalRows: int32(total),
+		Errors:    rowErrs,
+		DryRun:    opts.DryRun,
+	}
+
+	//3.按 VIN 查询已有车辆，包括已删除的
+	all := schema.SkipSoftDelete(ctx)
+	existing := make(map[string]*ent.Vehicle, len(rows))
+	for batch := range slices.Chunk(rows, importBatchSize) {
+		vins := make([]string, len(batch))
+		for i, row := range batch {
+			vins[i] = row.vin
+		}
+		found, err := s.client.Vehicle.Query().Where(vehicle.VinIn(vins...)).All(all)
+		if err != nil {
+			return status.Errorf(codes.Internal, "database error %v", err)
+		}
+		for _, v := range found {
+			existing[v.Vin] = v
+		}
+	}
+
+	//4.区分新增、更新和未变化的行
+	var creates, updates []importRow
+	for _, row := range rows {
+		v, ok := existing[row.vin]
+		switch {
+		case !ok:
+			creates = append(creates, row)
+		case v.TenantID != tid:
+			resp.Errors = append(resp.Errors, &vehiclev1.ImportRowError{
+				Row: int32(row.line), Vin: row.vin, Field: vehicle.FieldVin, Message: "VIN 已被其他组织的车辆使用",
+			})
+		case row.changed(v):
+			updates = append(updates, row)
+		default:
+			resp.Unchanged++
+		}
+	}
+	if len(resp.Errors) > 0 {
+		slices.SortStableFunc(resp.Errors, func(a, b *vehiclev1.ImportRowError) int { return int(a.Row - b.Row) })
+		return stream.SendAndClose(resp)
+	}
+
+	//5.在一个事务中写入，变更记录由 historyHook 写入同一事务
+	if err := s.applyImport(all, tid, creates, updates, existing, opts.DryRun); err != nil {
+		return err
+	}
+	resp.Created, resp.Updated = int32(len(creates)), int32(len(updates))
+	if opts.DryRun {
+		return stream.SendAndClose(resp)
+	}
+	resp.Committed = true
+
+	//6.更新租户索引，失败时由下次启动的 SyncTenantIndex 补齐
+	pipe := s.rdb.Pipeline()
+	for _, rows := range [][]importRow{creates, updates} {
+		for _, row := range rows {
+			pipe.HSet(ctx, tenant.IndexKey, row.vin, tenantID)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("⚠️ update tenant index after import failed: %v", err)
+	}
+	log.Printf("📥 Imported vehicles by %s: %d created, %d updated, %d unchanged", p.Username, resp.Created, resp.Updated, resp.Unchanged)
+	return stream.SendAndClose(resp)
+}
+
+// applyImport 在事务中新增和更新车辆，dryRun 时最后回滚
+func (s *VehicleServer) applyImport(ctx context.Context, tid int, creates, updates []importRow, existing map[string]*ent.Vehicle, dryRun bool) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "database error %v", err)
+	}
+	for batch := range slices.Chunk(creates, importBatchSize) {
+		builders := make([]*ent.VehicleCreate, len(batch))
+		for i, row := range batch {
+			create := tx.Vehicle.Create().
+				SetVin(row.vin).
+				SetLicensePlate(row.plate).
+				SetStatus("offline").
+				SetTenantID(tid)
+			if len(row.tags) > 0 {
+				create.SetTags(row.tags)
+			}
+			if row.make != "" {
+				create.SetMake(row.make)
+			}
+			if row.model != "" {
+				create.SetModel(row.model)
+			}
+			if row.year != 0 {
+				create.SetYear(row.year)
+			}
+			if row.fuelType != "" {
+				create.SetFuelType(row.fuelType)
+			}
+			if row.capacity != 0 {
+				create.SetCapacityKg(row.capacity)
+			}
+			builders[i] = create
+		}
+		if err := tx.Vehicle.CreateBulk(builders...).Exec(ctx); err != nil {
+			tx.Rollback()
+			return status.Errorf(codes.Internal, "failed to create vehicles: %v", err)
+		}
+	}
+	for _, row := range updates {
+		v := existing[row.vin]
+		update := tx.Vehicle.UpdateOneID(v.ID).SetLicensePlate(row.plate)
+		if row.columns[vehicle.FieldTags] {
+			update.SetTags(row.tags)
+		}
+		if row.columns[vehicle.FieldMake] {
+			if row.make != "" {
+				update.SetMake(row.make)
+			} else {
+				update.ClearMake()
+			}
+		}
+		if row.columns[vehicle.FieldModel] {
+			if row.model != "" {
+				update.SetModel(row.model)
+			} else {
+				update.ClearModel()
+			}
+		}
+		if row.columns[vehicle.FieldYear] {
+			if row.year != 0 {
+				update.SetYear(row.year)
+			} else {
+				update.ClearYear()
+			}
+		}
+		if row.columns[vehicle.FieldFuelType] {
+			if row.fuelType != "" {
+				update.SetFuelType(row.fuelType)
+			} else {
+				update.ClearFuelType()
+			}
+		}
+		if row.columns[vehicle.FieldCapacityKg] {
+			if row.capacity != 0 {
+				update.SetCapacityKg(row.capacity)
+			} else {
+				update.ClearCapacityKg()
+			}
+		}
+		if v.DeletedAt != nil {
+			update.ClearDeletedAt()
+		}
+		if err := update.Exec(ctx); err != nil {
+			tx.Rollback()
+			return status.Errorf(codes.Internal, "failed to update vehicle %s: %v", row.vin, err)
+		}
+	}
+	if dryRun {
+		tx.Rollback()
+		return nil
+	}
+	if err := tx.Commit(); err != nil {
+		return status.Errorf(codes.Internal, "database error %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 500
+)
+
+// sortField 可排序的列
+// value 取出一行在该列上的值，作为游标保存；nil 表示 NULL
+type sortField struct {
+	name     string
+	nullable bool
+	isTime   bool
+	value    func(v *ent.Vehicle) *string
+}
+
+func timeValue(t time.Time) *string {
+	s := t.Format(time.RFC3339Nano)
+	return &s
+}
+
+var sortFields = map[string]sortField{
+	vehicle.FieldCreatedAt: {name: vehicle.FieldCreatedAt, isTime: true, value: func(v *ent.Vehicle) *string { return timeValue(v.CreatedAt) }},
+	vehicle.FieldUpdatedAt: {name: vehicle.FieldUpdatedAt, isTime: true, value: func(v *ent.Vehicle) *string { return timeValue(v.UpdatedAt) }},
+	vehicle.FieldLastHeartbeat: {name: vehicle.FieldLastHeartbeat, isTime: true, nullable: true, value: func(v *ent.Vehicle) *string {
+		if v.LastHeartbeat == nil {
+			return nil
+		}
+		return timeValue(*v.LastHeartbeat)
+	}},
+	vehicle.FieldVin:          {name: vehicle.FieldVin, value: func(v *ent.Vehicle) *string { return &v.Vin }},
+	vehicle.FieldLicensePlate: {name: vehicle.FieldLicensePlate, value: func(v *ent.Vehicle) *string { return &v.LicensePlate }},
+	vehicle.FieldStatus:       {name: vehicle.FieldStatus, value: func(v *ent.Vehicle) *string { return &v.Status }},
+}
+
+// listOrder 排序方式，相同值之间按 id 排序保证顺序稳定
+type listOrder struct {
+	field sortField
+	desc  bool
+}
+
+// parseOrderBy 解析 "<字段> [asc|desc]"，默认 created_at desc
+func parseOrderBy(orderBy string) (listOrder, error) {
+	parts := strings.Fields(strings.ToLower(orderBy))
+	if len(parts) == 0 {
+		return listOrder{field: sortFields[vehicle.FieldCreatedAt], desc: true}, nil
+	}
+	f, ok := sortFields[parts[0]]
+	if !ok || len(parts) > 2 {
+		return listOrder{}, status.Errorf(codes.InvalidArgument, "unsupported order_by %q", orderBy)
+	}
+	o := listOrder{field: f}
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "asc":
+		case "desc":
+			o.desc = true
+		default:
+			return listOrder{}, status.Errorf(codes.InvalidArgument, "unsupported order_by %q", orderBy)
+		}
+	}
+	return o, nil
+}
+
+// terms 排序子句，NULL 无论升降序都排在最后
+func (o listOrder) terms() []vehicle.OrderOption {
+	opts := []sql.OrderTermOption{sql.OrderNullsLast()}
+	idOpts := []sql.OrderTermOption{}
+	if o.desc {
+		opts = append(opts, sql.OrderDesc())
+		idOpts = append(idOpts, sql.OrderDesc())
+	}
+	return []vehicle.OrderOption{
+		sql.OrderByField(o.field.name, opts...).ToFunc(),
+		sql.OrderByField(vehicle.FieldID, idOpts...).ToFunc(),
+	}
+}
+
+// pageCursor 游标: 上一页最后一行的排序值和 id
+// Filter 是过滤条件和排序的指纹，防止换了条件后继续使用旧游标
+type pageCursor struct {
+	Filter string  `json:"f"`
+	Value  *string `json:"v,omitempty"`
+	ID     int     `json:"id"`
+}
+
+// listFingerprint 对去掉分页参数后的请求取哈希
+func listFingerprint(req *vehiclev1.ListVehiclesRequest) string {
+	filter := proto.Clone(req).(*vehiclev1.ListVehiclesRequest)
+	filter.Page, filter.PageSize, filter.PageToken, filter.IncludeTotal = 0, 0, "", false
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodeCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(token, fingerprint string) (*pageCursor, error) {
+	invalid := status.Errorf(codes.InvalidArgument, "invalid page_token")
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, invalid
+	}
+	if c.Filter != fingerprint {
+		return nil, status.Errorf(codes.InvalidArgument, "page_token does not match the current filter or order_by, start again from the first page")
+	}
+	return &c, nil
+}
+
+// after 游标之后的行: (col, id) 严格排在游标之后，NULL 排在最后
+func (o listOrder) after(c *pageCursor) (predicate.Vehicle, error) {
+	cmp, idCmp := sql.FieldGT, sql.FieldGT(vehicle.FieldID, c.ID)
+	if o.desc {
+		cmp, idCmp = sql.FieldLT, sql.FieldLT(vehicle.FieldID, c.ID)
+	}
+	col := o.field.name
+	if c.Value == nil {
+		//上一页停在 NULL 区间，只剩下 NULL 中 id 更靠后的行
+		return vehicle.And(sql.FieldIsNull(col), idCmp), nil
+	}
+	var v any = *c.Value
+	if o.field.isTime {
+		t, err := time.Parse(time.RFC3339Nano, *c.Value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		v = t
+	}
+	p := vehicle.Or(cmp(col, v), vehicle.And(sql.FieldEQ(col, v), idCmp))
+	if o.field.nullable {
+		p = vehicle.Or(p, sql.FieldIsNull(col))
+	}
+	return p, nil
+}
+
+// statusValues 数据库中存储的状态值
+var statusValues = map[vehiclev1.VehicleStatus]string{
+	vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE:  "online",
+	vehiclev1.VehicleStatus_VEHICLE_STATUS_OFFLINE: "offline",
+}
+
+// listFilters 把请求中的过滤条件转换为查询条件
+func listFilters(req *vehiclev1.ListVehiclesRequest) ([]predicate.Vehicle, error) {
+	var ps []predicate.Vehicle
+	if req.Status != vehiclev1.VehicleStatus_VEHICLE_STATUS_UNSPECIFIED {
+		s, ok := statusValues[req.Status]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported status %v", req.Status)
+		}
+		ps = append(ps, vehicle.StatusEQ(s))
+	}
+	if req.LicensePlatePrefix != "" {
+		ps = append(ps, vehicle.LicensePlateHasPrefix(req.LicensePlatePrefix))
+	}
+	if req.VinPrefix != "" {
+		ps = append(ps, vehicle.VinHasPrefix(strings.ToUpper(req.VinPrefix)))
+	}
+	if req.HeartbeatAfter != nil {
+		ps = append(ps, vehicle.LastHeartbeatGTE(req.HeartbeatAfter.AsTime()))
+	}
+	if req.HeartbeatBefore != nil {
+		ps = append(ps, vehicle.LastHeartbeatLT(req.HeartbeatBefore.AsTime()))
+	}
+	for _, tag := range req.Tags {
+		if tag == "" {
+			continue
+		}
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(s.C(vehicle.FieldTags), tag))
+		})
+	}
+	if req.Make != "" {
+		ps = append(ps, vehicle.MakeEqualFold(strings.TrimSpace(req.Make)))
+	}
+	if req.Model != "" {
+		ps = append(ps, vehicle.ModelEqualFold(strings.TrimSpace(req.Model)))
+	}
+	if req.YearMin != 0 && req.YearMax != 0 && req.YearMin > req.YearMax {
+		return nil, status.Errorf(codes.InvalidArgument, "year_min must not be greater than year_max")
+	}
+	if req.YearMin != 0 {
+		ps = append(ps, vehicle.YearGTE(int(req.YearMin)))
+	}
+	if req.YearMax != 0 {
+		ps = append(ps, vehicle.YearLTE(int(req.YearMax)))
+	}
+	if req.CapacityKgMin != 0 && req.CapacityKgMax != 0 && req.CapacityKgMin > req.CapacityKgMax {
+		return nil, status.Errorf(codes.InvalidArgument, "capacity_kg_min must not be greater than capacity_kg_max")
+	}
+	if req.CapacityKgMin != 0 {
+		ps = append(ps, vehicle.CapacityKgGTE(int(req.CapacityKgMin)))
+	}
+	if req.CapacityKgMax != 0 {
+		ps = append(ps, vehicle.CapacityKgLTE(int(req.CapacityKgMax)))
+	}
+	if req.FuelType != vehiclev1.FuelType_FUEL_TYPE_UNSPECIFIED {
+		var errs grpcerr.FieldErrors
+		ft := fuelTypeValue(&errs, "fuel_type", req.FuelType)
+		if err := errs.Err(); err != nil {
+			return nil, err
+		}
+		ps = append(ps, vehicle.FuelTypeEQ(ft))
+	}
+	//全部属性一次 @> 查询，可以使用 GIN 索引
+	if len(req.Attributes) > 0 {
+		ps = append(ps, func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(s.C(vehicle.FieldAttributes), req.Attributes))
+		})
+	}
+	return ps, nil
+}
+
+// pageSize 每页数量，默认 10，最大 500
+func pageSize(n int32) int {
+	switch {
+	case n < 1:
+		return defaultPageSize
+	case n > maxPageSize:
+		return maxPageSize
+	default:
+		return int(n)
+	}
+}
+
+// nextPageToken 根据本页最后一行生成下一页的游标
+func (o listOrder) nextPageToken(last *ent.Vehicle, fingerprint string) string {
+	return encodeCursor(pageCursor{Filter: fingerprint, Value: o.field.value(last), ID: last.ID})
+}
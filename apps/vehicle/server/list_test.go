@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	value := "2026-03-01T08:00:00Z"
+	tests := []struct {
+		name   string
+		cursor pageCursor
+	}{
+		{"value", pageCursor{Filter: "abc", Value: &value, ID: 12}},
+		{"null value", pageCursor{Filter: "abc", ID: 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCursor(encodeCursor(tt.cursor), "abc")
+			if err != nil {
+				t.Fatalf("decodeCursor: %v", err)
+			}
+			if got.ID != tt.cursor.ID || (got.Value == nil) != (tt.cursor.Value == nil) ||
+				(got.Value != nil && *got.Value != *tt.cursor.Value) {
+				t.Errorf("got %+v, want %+v", got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		message string
+	}{
+		{"not base64", "!!!", "invalid page_token"},
+		{"not json", "bm90LWpzb24", "invalid page_token"},
+		{"other filter", encodeCursor(pageCursor{Filter: "old", ID: 1}), "does not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodeCursor(tt.token, "new")
+			wantCode(t, err, codes.InvalidArgument)
+			if !strings.Contains(status.Convert(err).Message(), tt.message) {
+				t.Errorf("message = %q, want %q", status.Convert(err).Message(), tt.message)
+			}
+		})
+	}
+}
+
+func TestListFingerprint(t *testing.T) {
+	base := &vehiclev1.ListVehiclesRequest{Status: vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE, OrderBy: "vin"}
+	fp := listFingerprint(base)
+
+	//分页参数不影响指纹
+	paged := &vehiclev1.ListVehiclesRequest{
+		Status:       vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE,
+		OrderBy:      "vin",
+		Page:         3,
+		PageSize:     50,
+		PageToken:    "token",
+		IncludeTotal: true,
+	}
+	if got := listFingerprint(paged); got != fp {
+		t.Errorf("paging changed the fingerprint: %s != %s", got, fp)
+	}
+	//过滤条件和排序影响指纹
+	for _, req := range []*vehiclev1.ListVehiclesRequest{
+		{Status: vehiclev1.VehicleStatus_VEHICLE_STATUS_OFFLINE, OrderBy: "vin"},
+		{Status: vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE, OrderBy: "vin desc"},
+		{Status: vehiclev1.VehicleStatus_VEHICLE_STATUS_ONLINE, OrderBy: "vin", Tags: []string{"cold-chain"}},
+	} {
+		if listFingerprint(req) == fp {
+			t.Errorf("fingerprint of %v did not change", req)
+		}
+	}
+	//请求本身不被修改
+	if paged.PageToken != "token" || paged.Page != 3 {
+		t.Errorf("listFingerprint modified the request: %v", paged)
+	}
+}
+
+// TestListVehiclesCursorNullsLast 按可为空的 last_heartbeat 翻页，NULL 排在最后，
+// 逐页取完的结果与一次查询的顺序一致，不重复也不遗漏
+func TestListVehiclesCursorNullsLast(t *testing.T) {
+	s := newTestServer(t)
+	base := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	heartbeats := []*time.Time{nil, ptr(base), nil, ptr(base.Add(time.Minute)), ptr(base), nil, ptr(base.Add(-time.Hour))}
+	for i, hb := range heartbeats {
+		createVehicle(t, s, fmt.Sprintf("LTEST%012d", i), hb)
+	}
+	//其他租户的车辆不可见
+	s.client.Vehicle.Create().SetVin("LOTHER00000000001").SetLicensePlate("沪A00001").SetTenantID(testTenantID + 1).SaveX(context.Background())
+
+	for _, orderBy := range []string{"last_heartbeat", "last_heartbeat desc"} {
+		t.Run(orderBy, func(t *testing.T) {
+			ctx := asUser("alice", grpcauth.RoleViewer)
+			order, err := parseOrderBy(orderBy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			all := s.client.Vehicle.Query().Where(vehicle.TenantID(testTenantID)).Order(order.terms()...).AllX(ctx)
+			var want []string
+			for _, v := range all {
+				want = append(want, v.Vin)
+			}
+			//NULL 无论升降序都在最后
+			for _, v := range all[len(all)-3:] {
+				if v.LastHeartbeat != nil {
+					t.Fatalf("order %q: rows with heartbeat after NULL: %v", orderBy, want)
+				}
+			}
+
+			var got []string
+			req := &vehiclev1.ListVehiclesRequest{OrderBy: orderBy, PageSize: 2}
+			for page := 0; ; page++ {
+				if page > len(heartbeats) {
+					t.Fatal("pagination did not terminate")
+				}
+				resp, err := s.ListVehicles(ctx, req)
+				if err != nil {
+					t.Fatalf("ListVehicles: %v", err)
+				}
+				for _, v := range resp.Vehicles {
+					got = append(got, v.Vin)
+				}
+				if resp.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.NextPageToken
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("paged = %v\nwant    %v", got, want)
+			}
+		})
+	}
+}
+
+func TestListVehiclesCursorFilterChanged(t *testing.T) {
+	s := newTestServer(t)
+	for i := range 3 {
+		createVehicle(t, s, fmt.Sprintf("LTEST%012d", i), nil)
+	}
+	ctx := asUser("alice", grpcauth.RoleViewer)
+	resp, err := s.ListVehicles(ctx, &vehiclev1.ListVehiclesRequest{OrderBy: "vin", PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.ListVehicles(ctx, &vehiclev1.ListVehiclesRequest{OrderBy: "vin desc", PageSize: 1, PageToken: resp.NextPageToken})
+	wantCode(t, err, codes.InvalidArgument)
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,43 @@
+package server
+
+import (
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/token"
+)
+
+// MethodScopes 各方法需要的 API key scope，传给 grpcauth.WithMethodScopes
+// 分组和司机属于车辆管理，使用 vehicles:* 权限
+var MethodScopes = map[string]string{
+	//车辆、分组、司机的查询
+	vehiclev1.VehicleService_GetVehicle_FullMethodName:            token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_ListVehicles_FullMethodName:          token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_GetVehicleHistory_FullMethodName:     token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_WatchVehicles_FullMethodName:         token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_GetGroup_FullMethodName:              token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_ListGroups_FullMethodName:            token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_GetDriver_FullMethodName:             token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_ListDrivers_FullMethodName:           token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_GetCurrentDriver_FullMethodName:      token.ScopeVehiclesRead,
+	vehiclev1.VehicleService_ListDriverAssignments_FullMethodName: token.ScopeVehiclesRead,
+
+	//车辆、分组、司机的修改
+	vehiclev1.VehicleService_CreateVehicle_FullMethodName:       token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_UpdateVehicle_FullMethodName:       token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_DeleteVehicle_FullMethodName:       token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_RestoreVehicle_FullMethodName:      token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_ImportVehicles_FullMethodName:      token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_CreateGroup_FullMethodName:         token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_UpdateGroup_FullMethodName:         token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_DeleteGroup_FullMethodName:         token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_AddGroupVehicles_FullMethodName:    token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_RemoveGroupVehicles_FullMethodName: token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_CreateDriver_FullMethodName:        token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_UpdateDriver_FullMethodName:        token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_AssignDriver_FullMethodName:        token.ScopeVehiclesWrite,
+	vehiclev1.VehicleService_UnassignDriver_FullMethodName:      token.ScopeVehiclesWrite,
+
+	//车载终端
+	vehiclev1.VehicleService_ListDevices_FullMethodName:     token.ScopeDevicesRead,
+	vehiclev1.VehicleService_ProvisionDevice_FullMethodName: token.ScopeDevicesWrite,
+	vehiclev1.VehicleService_RevokeDevice_FullMethodName:    token.ScopeDevicesWrite,
+}
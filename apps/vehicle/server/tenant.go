@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/xuewentao/cheya/apps/vehicle/ent/predicate"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tenantScope 返回调用者可见车辆的查询条件，平台管理员不按租户过滤
+func tenantScope(p *grpcauth.Principal) ([]predicate.Vehicle, error) {
+	if p.IsPlatformAdmin() {
+		return nil, nil
+	}
+	tenantID, err := parseTenantID(p.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "caller does not belong to an organization")
+	}
+	return []predicate.Vehicle{vehicle.TenantID(tenantID)}, nil
+}
+
+// tenantVisible 车辆是否在调用者可见的租户内，与 tenantScope 的条件一致
+func tenantVisible(p *grpcauth.Principal, tenantID string) bool {
+	if p.IsPlatformAdmin() {
+		return true
+	}
+	id, err := parseTenantID(p.TenantID)
+	return err == nil && formatTenantID(id) == tenantID
+}
+
+// targetTenant 新车辆所属的租户: 调用者的租户，平台管理员可以指定租户
+func targetTenant(p *grpcauth.Principal, requested string) (string, int, error) {
+	tenantID := p.TenantID
+	if p.IsPlatformAdmin() && requested != "" {
+		tenantID = requested
+	}
+	tid, err := parseTenantID(tenantID)
+	if err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "tenant_id is required")
+	}
+	return tenantID, tid, nil
+}
+
+func parseTenantID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid tenant_id: %q", s)
+	}
+	return id, nil
+}
+
+func formatTenantID(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// SyncTenantIndex 启动时把全部车辆的租户写入 Redis 索引
+// 补齐索引功能上线前创建的车辆，以及写索引失败的记录
+func (s *VehicleServer) SyncTenantIndex(ctx context.Context) error {
+	vehicles, err := s.client.Vehicle.Query().
+		Where(vehicle.TenantIDNotNil()).
+		Select(vehicle.FieldVin, vehicle.FieldTenantID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	pipe := s.rdb.Pipeline()
+	for _, v := range vehicles {
+		pipe.HSet(ctx, tenant.IndexKey, v.Vin, formatTenantID(v.TenantID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	log.Printf("🏢 Synced tenant index for %d vehicles", len(vehicles))
+	return nil
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/driverassignment"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// normalizeTags 去掉空白和重复的标签，保持原有顺序
+func normalizeTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t = strings.TrimSpace(t); t != "" && !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// checkVersion expected 大于 0 时必须与车辆当前的版本号相同
+func checkVersion(v *ent.Vehicle, expected int64) error {
+	if expected > 0 && v.Version != expected {
+		return versionMismatch(v.Vin, v.Version)
+	}
+	return nil
+}
+
+// versionMismatch 版本号不一致的错误，附带 PreconditionFailure 详情，网关据此返回 412
+// current 为 0 表示车辆已被删除或不知道当前版本号
+func versionMismatch(vin string, current int64) error {
+	msg := fmt.Sprintf("vehicle %s has been modified, reload and try again", vin)
+	if current > 0 {
+		msg = fmt.Sprintf("vehicle %s has been modified (version %d), reload and try again", vin, current)
+	}
+	st := status.New(codes.FailedPrecondition, msg)
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{{
+			Type:        "VERSION",
+			Subject:     "vehicles/" + vin,
+			Description: msg,
+		}},
+	})
+	if err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// conditionalSave 检查版本号到写入之间被其他请求修改时，带版本号条件的更新匹配不到行
+func conditionalSave(ctx context.Context, tx *ent.Tx, update *ent.VehicleUpdateOne, vin string, expected int64) (*ent.Vehicle, error) {
+	if expected > 0 {
+		update.Where(vehicle.Version(expected))
+	}
+	v, err := update.Save(ctx)
+	if ent.IsNotFound(err) && expected > 0 {
+		current, qerr := tx.Vehicle.Query().Where(vehicle.Vin(vin)).Only(schema.SkipSoftDelete(ctx))
+		if qerr == nil {
+			return nil, versionMismatch(vin, current.Version)
+		}
+	}
+	return v, err
+}
+
+// UpdateVehicle 修改车辆信息，变更记录由 historyHook 在同一事务中写入
+// 传 expected_version 时为条件更新，版本号不一致返回 FailedPrecondition
+func (s *VehicleServer) UpdateVehicle(ctx context.Context, req *vehiclev1.UpdateVehicleRequest) (*vehiclev1.UpdateVehicleResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+
+	//1.要更新的字段，没有 update_mask 时只更新非空的字段
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.LicensePlate != "" {
+			paths = append(paths, vehicle.FieldLicensePlate)
+		}
+		if len(req.Tags) > 0 {
+			paths = append(paths, vehicle.FieldTags)
+		}
+		if req.Make != "" {
+			paths = append(paths, vehicle.FieldMake)
+		}
+		if req.Model != "" {
+			paths = append(paths, vehicle.FieldModel)
+		}
+		if req.Year != 0 {
+			paths = append(paths, vehicle.FieldYear)
+		}
+		if req.FuelType != vehiclev1.FuelType_FUEL_TYPE_UNSPECIFIED {
+			paths = append(paths, vehicle.FieldFuelType)
+		}
+		if len(req.Attributes) > 0 {
+			paths = append(paths, vehicle.FieldAttributes)
+		}
+		if req.CapacityKg != 0 {
+			paths = append(paths, vehicle.FieldCapacityKg)
+		}
+	}
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(v, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	//2.校验并写入
+	var errs grpcerr.FieldErrors
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	update := tx.Vehicle.UpdateOneID(v.ID)
+	for _, path := range paths {
+		switch path {
+		case vehicle.FieldLicensePlate:
+			update.SetLicensePlate(validatePlate(&errs, "license_plate", req.LicensePlate))
+		case vehicle.FieldTags:
+			update.SetTags(normalizeTags(req.Tags))
+		case vehicle.FieldMake:
+			if v := validateSpec(&errs, path, req.Make); v != "" {
+				update.SetMake(v)
+			} else {
+				update.ClearMake()
+			}
+		case vehicle.FieldModel:
+			if v := validateSpec(&errs, path, req.Model); v != "" {
+				update.SetModel(v)
+			} else {
+				update.ClearModel()
+			}
+		case vehicle.FieldYear:
+			if v := validateYear(&errs, path, req.Year); v != 0 {
+				update.SetYear(v)
+			} else {
+				update.ClearYear()
+			}
+		case vehicle.FieldFuelType:
+			if v := fuelTypeValue(&errs, path, req.FuelType); v != "" {
+				update.SetFuelType(v)
+			} else {
+				update.ClearFuelType()
+			}
+		case vehicle.FieldAttributes:
+			if v := validateAttributes(&errs, path, req.Attributes); len(v) > 0 {
+				update.SetAttributes(v)
+			} else {
+				update.ClearAttributes()
+			}
+		case vehicle.FieldCapacityKg:
+			if v := validateCapacity(&errs, path, req.CapacityKg); v != 0 {
+				update.SetCapacityKg(v)
+			} else {
+				update.ClearCapacityKg()
+			}
+		default:
+			errs.Add("update_mask", "不支持修改字段 "+path)
+		}
+	}
+	if err := errs.Err(); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	v, err = conditionalSave(ctx, tx, update, v.Vin, req.ExpectedVersion)
+	if err != nil {
+		tx.Rollback()
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update vehicle: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	log.Printf("✏️ Vehicle %s updated by %s: %v", v.Vin, p.Username, paths)
+	return &vehiclev1.UpdateVehicleResponse{Vehicle: toProtoVehicle(v)}, nil
+}
+
+// DeleteVehicle 软删除车辆
+// 车辆从查询中隐藏，绑定的设备无法再通过认证，正在进行的驾驶记录结束；租户索引同时删除，网关不再转发该车的遥测和指令
+func (s *VehicleServer) DeleteVehicle(ctx context.Context, req *vehiclev1.DeleteVehicleRequest) (*vehiclev1.DeleteVehicleResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	v, err := s.scopedVehicle(ctx, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVersion(v, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	del := tx.Vehicle.DeleteOneID(v.ID)
+	if req.ExpectedVersion > 0 {
+		del.Where(vehicle.Version(req.ExpectedVersion))
+	}
+	if err := del.Exec(ctx); err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			//检查之后被删除或修改
+			return nil, versionMismatch(v.Vin, 0)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete vehicle: %v", err)
+	}
+	//结束正在进行的驾驶记录
+	if err := tx.DriverAssignment.Update().
+		Where(driverassignment.VehicleID(v.ID), driverassignment.EndedAtIsNil()).
+		SetEndedAt(time.Now()).
+		Exec(ctx); err != nil {
+		tx.Rollback()
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if err := tenant.SetVehicle(ctx, s.rdb, v.Vin, ""); err != nil {
+		log.Printf("⚠️ update tenant index for %s failed: %v", v.Vin, err)
+	}
+	log.Printf("🗑️ Vehicle %s deleted by %s", v.Vin, p.Username)
+	return &vehiclev1.DeleteVehicleResponse{}, nil
+}
+
+// RestoreVehicle 恢复已删除的车辆
+func (s *VehicleServer) RestoreVehicle(ctx context.Context, req *vehiclev1.RestoreVehicleRequest) (*vehiclev1.RestoreVehicleResponse, error) {
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req.Vin == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vin is required")
+	}
+	all := schema.SkipSoftDelete(ctx)
+	v, err := s.scopedVehicle(all, p, req.Vin)
+	if err != nil {
+		return nil, err
+	}
+	if v.DeletedAt == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "vehicle %s is not deleted", req.Vin)
+	}
+	if err := checkVersion(v, req.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	v, err = conditionalSave(all, tx, tx.Vehicle.UpdateOneID(v.ID).ClearDeletedAt(), v.Vin, req.ExpectedVersion)
+	if err != nil {
+		tx.Rollback()
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to restore vehicle: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	if err := tenant.SetVehicle(ctx, s.rdb, v.Vin, formatTenantID(v.TenantID)); err != nil {
+		log.Printf("⚠️ update tenant index for %s failed: %v", v.Vin, err)
+	}
+	log.Printf("♻️ Vehicle %s restored by %s", v.Vin, p.Username)
+	return &vehiclev1.RestoreVehicleResponse{Vehicle: toProtoVehicle(v)}, nil
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// wantVersionMismatch 断言 FailedPrecondition 并附带 VERSION 类型的 PreconditionFailure
+func wantVersionMismatch(t *testing.T, err error, vin, message string) {
+	t.Helper()
+	wantCode(t, err, codes.FailedPrecondition)
+	st := status.Convert(err)
+	if !strings.Contains(st.Message(), message) {
+		t.Errorf("message = %q, want %q", st.Message(), message)
+	}
+	for _, d := range st.Details() {
+		if pf, ok := d.(*errdetails.PreconditionFailure); ok {
+			if len(pf.Violations) != 1 || pf.Violations[0].Type != "VERSION" || pf.Violations[0].Subject != "vehicles/"+vin {
+				t.Errorf("violations = %v", pf.Violations)
+			}
+			return
+		}
+	}
+	t.Errorf("no PreconditionFailure detail in %v", st.Details())
+}
+
+func TestVersionMismatch(t *testing.T) {
+	wantVersionMismatch(t, versionMismatch("LTEST000000000001", 4), "LTEST000000000001", "(version 4)")
+	wantVersionMismatch(t, versionMismatch("LTEST000000000001", 0), "LTEST000000000001", "has been modified, reload")
+}
+
+func TestConditionalSave(t *testing.T) {
+	s := newTestServer(t)
+	ctx := context.Background()
+	v := createVehicle(t, s, "LTEST000000000001", nil)
+
+	save := func(expected int64, plate string) error {
+		t.Helper()
+		tx, err := s.client.Tx(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = conditionalSave(ctx, tx, tx.Vehicle.UpdateOneID(v.ID).SetLicensePlate(plate), v.Vin, expected)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		return tx.Commit()
+	}
+
+	//1.版本号一致时更新成功，版本号加一
+	if err := save(1, "京B00001"); err != nil {
+		t.Fatalf("conditionalSave: %v", err)
+	}
+	if got := s.client.Vehicle.GetX(ctx, v.ID); got.Version != 2 || got.LicensePlate != "京B00001" {
+		t.Fatalf("after save: version %d plate %s", got.Version, got.LicensePlate)
+	}
+
+	//2.检查版本号之后被其他请求修改: 条件更新匹配不到行，返回当前版本号
+	err := save(1, "京C00001")
+	wantVersionMismatch(t, err, v.Vin, "(version 2)")
+	if got := s.client.Vehicle.GetX(ctx, v.ID); got.LicensePlate != "京B00001" {
+		t.Errorf("stale update was written: plate %s", got.LicensePlate)
+	}
+
+	//3.不传版本号时无条件更新
+	if err := save(0, "京D00001"); err != nil {
+		t.Fatalf("unconditional save: %v", err)
+	}
+}
+
+func TestUpdateVehicleExpectedVersion(t *testing.T) {
+	s := newTestServer(t)
+	ctx := asUser("alice", grpcauth.RoleOperator)
+	v := createVehicle(t, s, "LTEST000000000001", nil)
+
+	resp, err := s.UpdateVehicle(ctx, &vehiclev1.UpdateVehicleRequest{Vin: v.Vin, Make: "BYD", ExpectedVersion: 1})
+	if err != nil {
+		t.Fatalf("UpdateVehicle: %v", err)
+	}
+	if resp.Vehicle.Version != 2 {
+		t.Errorf("version = %d, want 2", resp.Vehicle.Version)
+	}
+
+	//用旧版本号再次更新
+	_, err = s.UpdateVehicle(ctx, &vehiclev1.UpdateVehicleRequest{Vin: v.Vin, Make: "Geely", ExpectedVersion: 1})
+	wantVersionMismatch(t, err, v.Vin, "(version 2)")
+
+	//删除后恢复同样检查版本号
+	admin := asUser("root", grpcauth.RoleAdmin)
+	if _, err := s.DeleteVehicle(admin, &vehiclev1.DeleteVehicleRequest{Vin: v.Vin, ExpectedVersion: 2}); err != nil {
+		t.Fatalf("DeleteVehicle: %v", err)
+	}
+	_, err = s.RestoreVehicle(admin, &vehiclev1.RestoreVehicleRequest{Vin: v.Vin, ExpectedVersion: 2})
+	wantVersionMismatch(t, err, v.Vin, "(version 3)")
+	if _, err := s.RestoreVehicle(admin, &vehiclev1.RestoreVehicleRequest{Vin: v.Vin, ExpectedVersion: 3}); err != nil {
+		t.Fatalf("RestoreVehicle: %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/plate"
+	"github.com/xuewentao/cheya/pkg/vin"
+)
+
+// validateVIN 规范化并校验 VIN，返回规范化后的值
+// lenientVIN 开启时跳过校验位，方便录入测试车辆
+func (s *VehicleServer) validateVIN(errs *grpcerr.FieldErrors, field, v string) string {
+	v = vin.Normalize(v)
+	err := vin.Validate(v, s.lenientVIN)
+	switch {
+	case err == nil:
+	case v == "":
+		errs.Add(field, "VIN 不能为空")
+	case errors.Is(err, vin.ErrCheckDigit):
+		errs.Add(field, "VIN 校验位错误，请核对车架号")
+	default:
+		errs.Add(field, err.Error())
+	}
+	return v
+}
+
+// validatePlate 规范化并校验车牌号，返回规范化后的值
+func validatePlate(errs *grpcerr.FieldErrors, field, p string) string {
+	p = plate.Normalize(p)
+	switch {
+	case p == "":
+		errs.Add(field, "车牌号不能为空")
+	case plate.Validate(p) != nil:
+		errs.Add(field, "车牌号格式不正确，例如 沪A12345 或新能源 沪AD12345")
+	}
+	return p
+}
+
+const (
+	maxSpecLength     = 64     // 品牌、车型
+	maxAttributes     = 32     // 每辆车的自定义属性个数
+	maxAttributeKey   = 64     // 属性名长度
+	maxAttributeValue = 256    // 属性值长度
+	maxCapacityKg     = 200000 // 额定载质量上限，大件运输的牵引车也不会超过
+	firstVehicleYear  = 1886
+)
+
+// validateSpec 校验品牌 / 车型，去掉首尾空白，可以为空
+func validateSpec(errs *grpcerr.FieldErrors, field, v string) string {
+	v = strings.TrimSpace(v)
+	if utf8.RuneCountInString(v) > maxSpecLength {
+		errs.Add(field, fmt.Sprintf("%s 不能超过 %d 个字符", field, maxSpecLength))
+	}
+	return v
+}
+
+// validateYear 年款不能晚于明年，0 表示未填写
+func validateYear(errs *grpcerr.FieldErrors, field string, year int32) int {
+	if year != 0 && (year < firstVehicleYear || int(year) > time.Now().Year()+1) {
+		errs.Add(field, fmt.Sprintf("年款应在 %d 到 %d 之间", firstVehicleYear, time.Now().Year()+1))
+	}
+	return int(year)
+}
+
+// validateCapacity 额定载质量 (kg)，0 表示未填写
+func validateCapacity(errs *grpcerr.FieldErrors, field string, kg int32) int {
+	if kg < 0 || kg > maxCapacityKg {
+		errs.Add(field, fmt.Sprintf("额定载质量应在 0 到 %d kg 之间", maxCapacityKg))
+	}
+	return int(kg)
+}
+
+// validateAttributes 规范化自定义属性: 属性名去掉首尾空白且不能为空，值为空的属性删除
+func validateAttributes(errs *grpcerr.FieldErrors, field string, attrs map[string]string) map[string]string {
+	if len(attrs) > maxAttributes {
+		errs.Add(field, fmt.Sprintf("自定义属性不能超过 %d 个", maxAttributes))
+		return nil
+	}
+	out := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		switch {
+		case k == "":
+			errs.Add(field, "属性名不能为空")
+		case utf8.RuneCountInString(k) > maxAttributeKey:
+			errs.Add(field+"."+k, fmt.Sprintf("属性名不能超过 %d 个字符", maxAttributeKey))
+		case utf8.RuneCountInString(v) > maxAttributeValue:
+			errs.Add(field+"."+k, fmt.Sprintf("属性值不能超过 %d 个字符", maxAttributeValue))
+		case v != "":
+			out[k] = v
+		}
+	}
+	return out
+}
@@ -4,56 +4,164 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
 	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/schema"
 	"github.com/xuewentao/cheya/apps/vehicle/ent/vehicle"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"github.com/xuewentao/cheya/pkg/grpcerr"
+	"github.com/xuewentao/cheya/pkg/tenant"
+	"github.com/xuewentao/cheya/pkg/vehiclecache"
+	vinpkg "github.com/xuewentao/cheya/pkg/vin"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // vehicleServer 是对 Service 接口的具体实现
 type VehicleServer struct {
 	vehiclev1.UnimplementedVehicleServiceServer
-	client ent.Client //hold database client
+	client ent.Client          //hold database client
+	rdb    *redis.Client       //车辆租户索引
+	cache  *vehiclecache.Cache //GetVehicle 的读穿缓存，为 nil 时不使用
+
+	lenientVIN bool          //不检查 VIN 校验位
+	cacheTTL   time.Duration //GetVehicle 缓存时间
+}
+
+// Option 可选配置
+type Option func(*VehicleServer)
+
+// WithLenientVIN 录入车辆时不检查 VIN 校验位，用于测试环境
+func WithLenientVIN() Option {
+	return func(s *VehicleServer) { s.lenientVIN = true }
 }
 
 // NewVehicleServer 是构造函数
 // 接收 ent.client
-func NewVehicleServer(client ent.Client) *VehicleServer {
-	return &VehicleServer{
-		client: client,
+func NewVehicleServer(client ent.Client, rdb *redis.Client, opts ...Option) *VehicleServer {
+	s := &VehicleServer{
+		client:   client,
+		rdb:      rdb,
+		cacheTTL: DefaultCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	//车辆的每次变更写入 vehicle_histories，并作为事件写入 vehicle_events 供 WatchVehicles 推送
+	client.Vehicle.Use(historyHook, eventHook)
+	//变更提交后删除 GetVehicle 的缓存
+	if s.cacheTTL > 0 && rdb != nil {
+		s.cache = vehiclecache.New(rdb, s.cacheTTL)
+		client.Vehicle.Use(s.cacheHook)
+	}
+	return s
 }
 
 // GetVehicle 实现.proto 中定义的 rpc GetVehicle
 func (s *VehicleServer) GetVehicle(ctx context.Context, req *vehiclev1.GetVehicleRequest) (*vehiclev1.GetVehicleResponse, error) {
+	//所有已登录角色都可以查询
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	//没有租户的调用者在这里返回 PermissionDenied
+	if _, err := tenantScope(p); err != nil {
+		return nil, err
+	}
 	//校验参数
 	if req.VehicleId == "" {
 		return nil, errors.New("vehicle_id is requied")
 	}
-	//数据库查询
+	//先查缓存，未命中时查询数据库并回填；VIN 与存储时一样规范化，小写的 VIN 也能查到
 	//SELECT * FROM vehicles WHERE vin = ? LIMIT 1
-	v, err := s.client.Vehicle.Query().
-		Where(vehicle.Vin(req.VehicleId)).
-		Only(ctx)
-	//错误处理
+	vehicleID := vinpkg.Normalize(req.VehicleId)
+	var v *vehiclev1.Vehicle
+	if s.cache != nil {
+		v, err = s.cache.Get(ctx, vehicleID, s.loadVehicle)
+	} else {
+		v, err = s.loadVehicle(ctx, vehicleID)
+	}
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return nil, status.Errorf(codes.NotFound, "vehicle not found:%s ", req.VehicleId)
-		}
 		return nil, status.Errorf(codes.Internal, "database error %v", err)
 	}
+	//其他租户的车辆同样返回 NotFound，不暴露是否存在
+	if v == nil || !tenantVisible(p, v.TenantId) {
+		return nil, status.Errorf(codes.NotFound, "vehicle not found:%s ", req.VehicleId)
+	}
 	return &vehiclev1.GetVehicleResponse{
-		Vehicle: &vehiclev1.Vehicle{
-			Id:           fmt.Sprintf("%d", v.ID),
-			Vin:          v.Vin,
-			LicensePlate: v.LicensePlate,
-			Status:       mapStatusToProto(v.Status),
-		},
+		Vehicle: v,
 	}, nil
 }
+
+// toProtoVehicle 数据库实体转换为 proto，Get 和 List 共用
+func toProtoVehicle(v *ent.Vehicle) *vehiclev1.Vehicle {
+	pv := &vehiclev1.Vehicle{
+		Id:           fmt.Sprintf("%d", v.ID),
+		Vin:          v.Vin,
+		LicensePlate: v.LicensePlate,
+		Status:       mapStatusToProto(v.Status),
+		TenantId:     formatTenantID(v.TenantID),
+		Tags:         v.Tags,
+		Version:      v.Version,
+		Make:         v.Make,
+		Model:        v.Model,
+		Year:         int32(v.Year),
+		FuelType:     fuelTypes[v.FuelType],
+		Attributes:   v.Attributes,
+		CapacityKg:   int32(v.CapacityKg),
+		CreatedAt:    timestamppb.New(v.CreatedAt),
+		UpdatedAt:    timestamppb.New(v.UpdatedAt),
+	}
+	if v.Location != nil {
+		pv.Location = &vehiclev1.Location{
+			Latitude:  v.Location.Latitude,
+			Longitude: v.Location.Longitude,
+			Address:   v.Location.Address,
+		}
+	}
+	if v.LastHeartbeat != nil {
+		pv.LastHeartbeat = timestamppb.New(*v.LastHeartbeat)
+	}
+	if len(v.Telemetry) > 0 {
+		//JSONB 反序列化出来的都是 JSON 类型，转换失败说明数据有问题，不影响其它字段
+		t, err := structpb.NewStruct(v.Telemetry)
+		if err != nil {
+			log.Printf("⚠️ vehicle %d has invalid telemetry: %v", v.ID, err)
+		} else {
+			pv.Telemetry = t
+		}
+	}
+	return pv
+}
+
+// fuelTypes 数据库中的燃料类型对应的 proto 枚举，未填写时为 UNSPECIFIED
+var fuelTypes = map[vehicle.FuelType]vehiclev1.FuelType{
+	vehicle.FuelTypeIce:    vehiclev1.FuelType_FUEL_TYPE_ICE,
+	vehicle.FuelTypeEv:     vehiclev1.FuelType_FUEL_TYPE_EV,
+	vehicle.FuelTypeHybrid: vehiclev1.FuelType_FUEL_TYPE_HYBRID,
+}
+
+// fuelTypeValue proto 燃料类型转换为数据库值，UNSPECIFIED 返回空字符串
+func fuelTypeValue(errs *grpcerr.FieldErrors, field string, ft vehiclev1.FuelType) vehicle.FuelType {
+	if ft == vehiclev1.FuelType_FUEL_TYPE_UNSPECIFIED {
+		return ""
+	}
+	for v, pb := range fuelTypes {
+		if pb == ft {
+			return v
+		}
+	}
+	errs.Add(field, fmt.Sprintf("不支持的燃料类型 %v", ft))
+	return ""
+}
+
 func mapStatusToProto(s string) vehiclev1.VehicleStatus {
 	switch strings.ToLower(s) {
 	case "online":
@@ -66,69 +174,165 @@ func mapStatusToProto(s string) vehiclev1.VehicleStatus {
 }
 
 func (s *VehicleServer) CreateVehicle(ctx context.Context, req *vehiclev1.CreateVehicleRequest) (*vehiclev1.CreateVehicleReponse, error) {
-	//1.简单校验
-	if req.Vin == "" || req.LicensePlate == "" {
-		return nil, status.Errorf(codes.InvalidArgument, "vin or license are not require")
-	}
-	//2.使用 Ent 插入 db
-	// SQL: INSERT INTO vehicles (vin, license_plate, status, ...) VALUES (...)
-	v, err := s.client.Vehicle.Create().
-		SetVin(req.Vin).
-		SetLicensePlate(req.LicensePlate).
+	//只有管理员和调度员可以录入车辆
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator)
+	if err != nil {
+		return nil, err
+	}
+	//1.校验并规范化 VIN 和车牌号
+	var errs grpcerr.FieldErrors
+	vin := s.validateVIN(&errs, "vin", req.Vin)
+	plate := validatePlate(&errs, "license_plate", req.LicensePlate)
+	vehicleMake := validateSpec(&errs, "make", req.Make)
+	model := validateSpec(&errs, "model", req.Model)
+	year := validateYear(&errs, "year", req.Year)
+	fuelType := fuelTypeValue(&errs, "fuel_type", req.FuelType)
+	capacity := validateCapacity(&errs, "capacity_kg", req.CapacityKg)
+	attributes := validateAttributes(&errs, "attributes", req.Attributes)
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+	//车辆归属调用者的租户，平台管理员可以指定租户
+	tenantID, tid, err := targetTenant(p, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	//2.使用 Ent 插入 db，变更记录在同一事务中写入
+	// SQL: INSERT INTO vehicles (vin, license_plate, status, tenant_id, ...) VALUES (...)
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	create := tx.Vehicle.Create().
+		SetVin(vin).
+		SetLicensePlate(plate).
 		SetStatus("offline").
-		Save(ctx)
+		SetTenantID(tid)
+	if vehicleMake != "" {
+		create.SetMake(vehicleMake)
+	}
+	if model != "" {
+		create.SetModel(model)
+	}
+	if year != 0 {
+		create.SetYear(year)
+	}
+	if fuelType != "" {
+		create.SetFuelType(fuelType)
+	}
+	if capacity != 0 {
+		create.SetCapacityKg(capacity)
+	}
+	if tags := normalizeTags(req.Tags); len(tags) > 0 {
+		create.SetTags(tags)
+	}
+	if len(attributes) > 0 {
+		create.SetAttributes(attributes)
+	}
+	v, err := create.Save(ctx)
 	//3.错误处理
 	if err != nil {
+		tx.Rollback()
 		if ent.IsConstraintError(err) {
-			return nil, status.Errorf(codes.AlreadyExists, "vehicle with vin %s is already exists", req.Vin)
+			//软删除的车辆仍然占用 VIN，提示恢复而不是重新录入
+			if deleted, _ := s.client.Vehicle.Query().
+				Where(vehicle.Vin(vin), vehicle.DeletedAtNotNil()).
+				Exist(schema.SkipSoftDelete(ctx)); deleted {
+				return nil, status.Errorf(codes.AlreadyExists, "vehicle with vin %s was deleted, restore it instead", vin)
+			}
+			return nil, status.Errorf(codes.AlreadyExists, "vehicle with vin %s is already exists", vin)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create vehicle: %v", err)
 	}
-	//4.返回结果
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "database error %v", err)
+	}
+	//4.更新租户索引，失败时由下次启动的 SyncTenantIndex 补齐
+	if err := tenant.SetVehicle(ctx, s.rdb, v.Vin, tenantID); err != nil {
+		log.Printf("⚠️ update tenant index for %s failed: %v", v.Vin, err)
+	}
+	//5.返回结果
 	return &vehiclev1.CreateVehicleReponse{
 		VehicleId: fmt.Sprintf("%d", v.ID),
 	}, nil
 
 }
 
+// ListVehicles 按条件查询车辆列表
+// 传 page_token 时使用游标分页，否则兼容旧的 page/page_size offset 分页；
+// 每页多查一行判断是否还有下一页，只有需要时才执行 COUNT
 func (s *VehicleServer) ListVehicles(ctx context.Context, req *vehiclev1.ListVehiclesRequest) (*vehiclev1.ListVehiclesResponse, error) {
-	//1.分页处理
-	page := req.Page
-	if page < 1 {
-		page = 1
-	}
-	pageSize := req.PageSize
-	if pageSize < 1 {
-		pageSize = 10
-	}
-	offset := (page - 1) * pageSize
-	//2.查询总数
-	total, err := s.client.Vehicle.Query().Count(ctx)
+	p, err := grpcauth.RequireRole(ctx, grpcauth.RoleAdmin, grpcauth.RoleOperator, grpcauth.RoleViewer)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := tenantScope(p)
+	if err != nil {
+		return nil, err
+	}
+	//1.过滤条件和排序
+	filters, err := listFilters(req)
+	if err != nil {
+		return nil, err
+	}
+	order, err := parseOrderBy(req.OrderBy)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to count: %v", err)
+		return nil, err
+	}
+	where := append(scope, filters...)
+	if req.GroupId != "" {
+		inGroup, err := s.groupFilter(ctx, p, req)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, inGroup)
+	}
+
+	//2.分页: 游标优先，其次 offset
+	size := pageSize(req.PageSize)
+	fingerprint := listFingerprint(req)
+	query := s.client.Vehicle.Query().
+		Where(where...).
+		Order(order.terms()...).
+		Limit(size + 1)
+	if req.PageToken != "" {
+		cursor, err := decodeCursor(req.PageToken, fingerprint)
+		if err != nil {
+			return nil, err
+		}
+		after, err := order.after(cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.Where(after)
+	} else if req.Page > 1 {
+		query.Offset((int(req.Page) - 1) * size)
 	}
+
 	//3.查询列表
-	vehicles, err := s.client.Vehicle.Query().
-		Limit(int(pageSize)).
-		Offset(int(offset)).
-		Order(ent.Desc("created_at")).
-		All(ctx)
+	vehicles, err := query.All(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list: %v", err)
 	}
+	resp := &vehiclev1.ListVehiclesResponse{}
+	if len(vehicles) > size {
+		vehicles = vehicles[:size]
+		resp.NextPageToken = order.nextPageToken(vehicles[size-1], fingerprint)
+	}
+
+	//4.总数: 只有显式要求时才查询，COUNT 需要扫描所有满足条件的行
+	if req.IncludeTotal {
+		total, err := s.client.Vehicle.Query().Where(where...).Count(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to count: %v", err)
+		}
+		resp.TotalCount = int32(total)
+	}
 
 	//转换数据
-	pbVehicles := make([]*vehiclev1.Vehicle, len(vehicles))
+	resp.Vehicles = make([]*vehiclev1.Vehicle, len(vehicles))
 	for i, v := range vehicles {
-		pbVehicles[i] = &vehiclev1.Vehicle{
-			Id:           fmt.Sprintf("%d", v.ID),
-			Vin:          v.Vin,
-			LicensePlate: v.LicensePlate,
-			Status:       mapStatusToProto(v.Status),
-		}
+		resp.Vehicles[i] = toProtoVehicle(v)
 	}
-	return &vehiclev1.ListVehiclesResponse{
-		Vehicles:   pbVehicles,
-		TotalCount: int32(total),
-	}, nil
+	return resp, nil
 }
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/redis/go-redis/v9"
+	"github.com/xuewentao/cheya/apps/vehicle/ent"
+	"github.com/xuewentao/cheya/apps/vehicle/ent/enttest"
+	"github.com/xuewentao/cheya/pkg/grpcauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testTenantID = 7
+
+// newTestServer 使用内存 SQLite 和 miniredis 创建 VehicleServer
+func newTestServer(t *testing.T) *VehicleServer {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { client.Close() })
+
+	rdb := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	return NewVehicleServer(*client, rdb, WithLenientVIN())
+}
+
+// asUser 以租户内的用户身份调用
+func asUser(username, role string) context.Context {
+	return grpcauth.NewContext(context.Background(), &grpcauth.Principal{
+		UserID:   "42",
+		Username: username,
+		Role:     role,
+		TenantID: fmt.Sprint(testTenantID),
+	})
+}
+
+// createVehicle 直接写库创建租户内的车辆，heartbeat 为 nil 表示还没有心跳
+func createVehicle(t *testing.T, s *VehicleServer, vin string, heartbeat *time.Time) *ent.Vehicle {
+	t.Helper()
+	return s.client.Vehicle.Create().
+		SetVin(vin).
+		SetLicensePlate("京A" + vin[len(vin)-5:]).
+		SetTenantID(testTenantID).
+		SetNillableLastHeartbeat(heartbeat).
+		SaveX(context.Background())
+}
+
+// wantCode 断言 gRPC 错误码
+func wantCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != code {
+		t.Fatalf("got %v (%v), want %v", got, err, code)
+	}
+}
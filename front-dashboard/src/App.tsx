@@ -1,18 +1,22 @@
 import { Routes, Route, Navigate } from 'react-router-dom'
 import LoginPage from './pages/LoginPage'
 import RegisterPage from './pages/RegisterPage'
+import OidcCallbackPage from './pages/OidcCallbackPage'
+import ResetPasswordPage from './pages/ResetPasswordPage'
 import SaaSLayout from './layouts/SaaSLayout'
 import DashboardOverviewPage from './pages/DashboardOverviewPage'
 import DashboardMapPage from './pages/DashboardMapPage'
 import DashboardListPage from './pages/DashboardListPage'
 import Dashboard from './components/Dashboard'
+import { clearTokens } from './api/auth'
 
 /**
  * 检查用户是否已登录
  */
 function isAuthenticated(): boolean {
   const token = localStorage.getItem('access_token')
-  const expiresAt = localStorage.getItem('token_expires_at')
+  // access token 过期后可以用 refresh token 续期，所以以 refresh token 的有效期为准
+  const expiresAt = localStorage.getItem('refresh_expires_at') || localStorage.getItem('token_expires_at')
   
   if (!token || !expiresAt) {
     return false
@@ -22,9 +26,7 @@ function isAuthenticated(): boolean {
   const now = Date.now()
   if (now > parseInt(expiresAt)) {
     // Token 已过期，清除存储
-    localStorage.removeItem('access_token')
-    localStorage.removeItem('username')
-    localStorage.removeItem('token_expires_at')
+    clearTokens()
     return false
   }
   
@@ -74,6 +76,19 @@ function App() {
         }
       />
       
+      {/* 单点登录回调页 - IdP 登录完成后跳回这里 */}
+      <Route
+        path="/login/oidc/callback"
+        element={
+          <PublicRoute>
+            <OidcCallbackPage />
+          </PublicRoute>
+        }
+      />
+      
+      {/* 找回密码 - 邮件中的链接也指向这里 */}
+      <Route path="/reset-password" element={<ResetPasswordPage />} />
+      
       {/* 注册页 - 已登录用户自动跳转 */}
       <Route
         path="/register"
@@ -0,0 +1,120 @@
+/**
+ * Auth API 接口
+ * 负责保存 token、自动刷新 access token 以及登出
+ */
+
+/** 登录 / 刷新接口返回的 token 信息 */
+export interface TokenResponse {
+  access_token: string
+  expires_in: number
+  refresh_token: string
+  refresh_expires_in: number
+  username?: string
+}
+
+/** 登录接口返回，开启两步验证时只有 mfa_token，需要再调用 /api/v1/auth/login/mfa */
+export interface LoginResponse extends Partial<TokenResponse> {
+  mfa_required?: boolean
+  mfa_token?: string
+  mfa_enrollment_required?: boolean
+  recovery_codes?: string[]
+}
+
+/**
+ * 保存 token 到 localStorage
+ */
+export function saveTokens(data: TokenResponse): void {
+  localStorage.setItem('access_token', data.access_token)
+  localStorage.setItem('token_expires_at', String(Date.now() + data.expires_in * 1000))
+  localStorage.setItem('refresh_token', data.refresh_token)
+  localStorage.setItem('refresh_expires_at', String(Date.now() + data.refresh_expires_in * 1000))
+  if (data.username) {
+    localStorage.setItem('username', data.username)
+  }
+}
+
+/**
+ * 清除本地保存的登录信息
+ */
+export function clearTokens(): void {
+  localStorage.removeItem('access_token')
+  localStorage.removeItem('username')
+  localStorage.removeItem('token_expires_at')
+  localStorage.removeItem('refresh_token')
+  localStorage.removeItem('refresh_expires_at')
+}
+
+// 同一时间只发起一次刷新请求
+let refreshing: Promise<boolean> | null = null
+
+/**
+ * 使用 refresh token 换取新的 access token
+ * @returns 是否刷新成功
+ */
+export function refreshTokens(): Promise<boolean> {
+  if (refreshing) {
+    return refreshing
+  }
+  const refreshToken = localStorage.getItem('refresh_token')
+  if (!refreshToken) {
+    return Promise.resolve(false)
+  }
+  refreshing = fetch('/api/v1/auth/refresh', {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json' },
+    body: JSON.stringify({ refresh_token: refreshToken }),
+  })
+    .then(async (response) => {
+      if (!response.ok) {
+        clearTokens()
+        return false
+      }
+      saveTokens(await response.json())
+      return true
+    })
+    .catch(() => false)
+    .finally(() => {
+      refreshing = null
+    })
+  return refreshing
+}
+
+/**
+ * 带 Authorization 头的 fetch，access token 过期时自动刷新并重试一次
+ */
+export async function authFetch(input: string, init: RequestInit = {}): Promise<Response> {
+  const send = () => {
+    const headers = new Headers(init.headers)
+    const token = localStorage.getItem('access_token')
+    if (token) {
+      headers.set('Authorization', `Bearer ${token}`)
+    }
+    return fetch(input, { ...init, headers })
+  }
+
+  const response = await send()
+  if (response.status !== 401) {
+    return response
+  }
+  if (!(await refreshTokens())) {
+    return response
+  }
+  return send()
+}
+
+/**
+ * 登出，吊销服务端会话
+ */
+export async function logout(): Promise<void> {
+  try {
+    await authFetch('/api/v1/auth/logout', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ refresh_token: localStorage.getItem('refresh_token') || '' }),
+    })
+  } catch (error) {
+    console.error('Failed to logout:', error)
+  } finally {
+    clearTokens()
+  }
+}
@@ -3,6 +3,8 @@
  * 用于获取车辆列表数据
  */
 
+import { authFetch } from './auth';
+
 /** 车辆状态枚举 */
 export enum VehicleStatus {
   UNSPECIFIED = 0,
@@ -10,12 +12,41 @@ export enum VehicleStatus {
   ONLINE = 2,
 }
 
-/** 车辆数据接口 */
+/** 燃料类型 */
+export enum FuelType {
+  UNSPECIFIED = 0,
+  ICE = 1,
+  EV = 2,
+  HYBRID = 3,
+}
+
+/** 车辆位置 */
+export interface VehicleLocation {
+  latitude: number;
+  longitude: number;
+  address?: string;
+}
+
+/** 车辆数据接口，时间为 RFC 3339 字符串 */
 export interface Vehicle {
   id: string;
   vin: string;
   license_plate: string;
   status: VehicleStatus;
+  tenant_id?: string;
+  tags?: string[];
+  version?: string; // int64 在 JSON 中是字符串，修改时作为 If-Match 带回
+  make?: string;
+  model?: string;
+  year?: number;
+  fuel_type?: FuelType;
+  capacity_kg?: number; // 额定载质量 (kg)
+  attributes?: Record<string, string>;
+  location?: VehicleLocation;
+  last_heartbeat?: string;
+  telemetry?: Record<string, unknown>;
+  created_at?: string;
+  updated_at?: string;
 }
 
 /** 车辆列表响应接口 */
@@ -24,6 +55,8 @@ export interface VehicleListResponse {
   data: {
     items: Vehicle[] | null;
     total: number;
+    /** 游标分页的下一页，为空表示没有更多 */
+    nextPageToken?: string;
   };
 }
 
@@ -38,8 +71,8 @@ export async function fetchVehicleList(
   pageSize: number = 10
 ): Promise<VehicleListResponse> {
   try {
-    const response = await fetch(
-      `http://localhost:8081/api/v1/vehicles?page=${page}&pageSize=${pageSize}`
+    const response = await authFetch(
+      `http://localhost:8081/api/v1/vehicles?page=${page}&pageSize=${pageSize}&includeTotal=true`
     );
 
     if (!response.ok) {
@@ -69,7 +102,7 @@ export async function controlVehicle(
   action: ControlAction
 ): Promise<void> {
   try {
-    const response = await fetch(
+    const response = await authFetch(
       `http://localhost:8081/api/v1/vehicles/${vin}/control`,
       {
         method: 'POST',
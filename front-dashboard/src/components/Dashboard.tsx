@@ -102,8 +102,9 @@ export default function Dashboard() {
 
   // WebSocket 连接
   useEffect(() => {
-    // 创建 WebSocket 连接
-    const ws = new WebSocket('ws://localhost:8081/ws');
+    // 创建 WebSocket 连接，浏览器无法设置请求头，token 通过 query 传递
+    const token = localStorage.getItem('access_token') || '';
+    const ws = new WebSocket(`ws://localhost:8081/ws?access_token=${encodeURIComponent(token)}`);
     wsRef.current = ws;
 
     ws.onopen = () => {
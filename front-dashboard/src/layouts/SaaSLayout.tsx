@@ -1,5 +1,6 @@
 import { Outlet, NavLink, useNavigate } from 'react-router-dom'
 import ThemeToggle from '../components/ThemeToggle'
+import { logout } from '../api/auth'
 
 const SaaSLayout = () => {
   const navigate = useNavigate()
@@ -8,12 +9,10 @@ const SaaSLayout = () => {
   const username = localStorage.getItem('username') || '用户'
 
   // 登出处理函数
-  const handleLogout = () => {
-    // 清除本地存储
-    localStorage.removeItem('access_token')
-    localStorage.removeItem('username')
-    localStorage.removeItem('token_expires_at')
-    
+  const handleLogout = async () => {
+    // 吊销服务端会话并清除本地存储
+    await logout()
+
     // 跳转到登录页
     navigate('/login')
   }
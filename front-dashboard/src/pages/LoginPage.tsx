@@ -1,12 +1,7 @@
 import { useState, FormEvent } from 'react'
 import { Link, useNavigate } from 'react-router-dom'
 import ThemeToggle from '../components/ThemeToggle'
-
-interface LoginResponse {
-  access_token: string
-  expires_in: number
-  username: string
-}
+import { saveTokens, LoginResponse, TokenResponse } from '../api/auth'
 
 const LoginPage = () => {
   const navigate = useNavigate()
@@ -16,6 +11,50 @@ const LoginPage = () => {
   })
   const [loading, setLoading] = useState(false)
   const [error, setError] = useState('')
+  // 两步验证: 密码校验通过后拿到 mfa_token，再提交验证码
+  const [mfa, setMfa] = useState<{ token: string; secret?: string; uri?: string } | null>(null)
+  const [code, setCode] = useState('')
+  const [useRecovery, setUseRecovery] = useState(false)
+  const [recoveryCodes, setRecoveryCodes] = useState<string[]>([])
+
+  // 登录成功，保存 token 后跳转；强制绑定时先展示恢复码
+  const finishLogin = (data: LoginResponse) => {
+    saveTokens(data as TokenResponse)
+    if (data.recovery_codes?.length) {
+      setRecoveryCodes(data.recovery_codes)
+      return
+    }
+    navigate('/')
+  }
+
+  const handleMfaSubmit = async (e: FormEvent<HTMLFormElement>) => {
+    e.preventDefault()
+    if (!mfa || !code) {
+      return
+    }
+    setError('')
+    setLoading(true)
+    try {
+      const response = await fetch('/api/v1/auth/login/mfa', {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify(
+          useRecovery ? { mfa_token: mfa.token, recovery_code: code } : { mfa_token: mfa.token, code },
+        ),
+      })
+      const data = await response.json()
+      if (!response.ok) {
+        setError(data.error || '验证码错误')
+        return
+      }
+      finishLogin(data)
+    } catch (err) {
+      setError('网络错误，请检查连接后重试')
+      console.error('两步验证错误:', err)
+    } finally {
+      setLoading(false)
+    }
+  }
 
   const handleSubmit = async (e: FormEvent<HTMLFormElement>) => {
     e.preventDefault()
@@ -48,14 +87,28 @@ const LoginPage = () => {
         return
       }
 
-      // 登录成功，保存 token
-      const loginData: LoginResponse = data
-      localStorage.setItem('access_token', loginData.access_token)
-      localStorage.setItem('username', loginData.username)
-      localStorage.setItem('token_expires_at', String(Date.now() + loginData.expires_in * 1000))
+      const login = data as LoginResponse
+      if (!login.mfa_required) {
+        finishLogin(login)
+        return
+      }
 
-      // 跳转到主页
-      navigate('/')
+      // 角色要求两步验证但尚未绑定，先获取密钥让用户绑定验证器
+      if (login.mfa_enrollment_required) {
+        const enroll = await fetch('/api/v1/auth/totp/enroll', {
+          method: 'POST',
+          headers: { 'Content-Type': 'application/json' },
+          body: JSON.stringify({ mfa_token: login.mfa_token }),
+        })
+        const enrollData = await enroll.json()
+        if (!enroll.ok) {
+          setError(enrollData.error || '获取两步验证密钥失败')
+          return
+        }
+        setMfa({ token: login.mfa_token!, secret: enrollData.secret, uri: enrollData.otpauth_uri })
+        return
+      }
+      setMfa({ token: login.mfa_token! })
     } catch (err) {
       setError('网络错误，请检查连接后重试')
       console.error('登录错误:', err)
@@ -91,51 +144,126 @@ const LoginPage = () => {
           <p>密码: <code className="bg-blue-100 dark:bg-blue-800 px-1 rounded">123456</code></p>
         </div>
 
-        <form onSubmit={handleSubmit} className="space-y-6">
-          <div>
-            <label htmlFor="username" className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
-              用户名
-            </label>
-            <input
-              type="text"
-              id="username"
-              name="username"
-              value={formData.username}
-              onChange={(e) => setFormData({ ...formData, username: e.target.value })}
-              className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
-              placeholder="请输入用户名"
-              required
-              disabled={loading}
-            />
+        {recoveryCodes.length > 0 ? (
+          <div className="space-y-4">
+            <p className="text-sm text-gray-700 dark:text-gray-300">
+              两步验证已开启。请妥善保存以下恢复码，丢失验证器时每个恢复码可代替验证码使用一次，离开本页后将无法再次查看。
+            </p>
+            <div className="grid grid-cols-2 gap-2 font-mono text-sm text-gray-900 dark:text-gray-100">
+              {recoveryCodes.map((c) => (
+                <code key={c} className="bg-gray-100 dark:bg-gray-700 px-2 py-1 rounded text-center">{c}</code>
+              ))}
+            </div>
+            <button
+              onClick={() => navigate('/')}
+              className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200"
+            >
+              我已保存，进入平台
+            </button>
           </div>
+        ) : mfa ? (
+          <form onSubmit={handleMfaSubmit} className="space-y-6">
+            {mfa.secret && (
+              <div className="p-3 bg-yellow-50 dark:bg-yellow-900/30 border border-yellow-200 dark:border-yellow-700 text-yellow-800 dark:text-yellow-300 rounded-lg text-sm break-all">
+                <p className="font-medium mb-1">当前角色必须开启两步验证，请在验证器 App 中添加账号：</p>
+                <p>密钥: <code className="bg-yellow-100 dark:bg-yellow-800 px-1 rounded">{mfa.secret}</code></p>
+                <p className="mt-1 text-xs">{mfa.uri}</p>
+              </div>
+            )}
+            <div>
+              <label htmlFor="code" className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
+                {useRecovery ? '恢复码' : '验证码'}
+              </label>
+              <input
+                type="text"
+                id="code"
+                name="code"
+                autoComplete="one-time-code"
+                value={code}
+                onChange={(e) => setCode(e.target.value)}
+                className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
+                placeholder={useRecovery ? '请输入恢复码' : '请输入验证器中的 6 位数字'}
+                required
+                disabled={loading}
+              />
+            </div>
+            <button
+              type="submit"
+              disabled={loading}
+              className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200 shadow-md hover:shadow-lg disabled:opacity-50 disabled:cursor-not-allowed"
+            >
+              {loading ? '验证中...' : '验证'}
+            </button>
+            {!mfa.secret && (
+              <button
+                type="button"
+                onClick={() => { setUseRecovery(!useRecovery); setCode('') }}
+                className="w-full text-sm text-blue-600 dark:text-blue-400 hover:underline"
+              >
+                {useRecovery ? '使用验证码' : '无法使用验证器？使用恢复码'}
+              </button>
+            )}
+          </form>
+        ) : (
+          <form onSubmit={handleSubmit} className="space-y-6">
+            <div>
+              <label htmlFor="username" className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
+                用户名
+              </label>
+              <input
+                type="text"
+                id="username"
+                name="username"
+                value={formData.username}
+                onChange={(e) => setFormData({ ...formData, username: e.target.value })}
+                className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
+                placeholder="请输入用户名"
+                required
+                disabled={loading}
+              />
+            </div>
+
+            <div>
+              <label htmlFor="password" className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
+                密码
+              </label>
+              <input
+                type="password"
+                id="password"
+                name="password"
+                value={formData.password}
+                onChange={(e) => setFormData({ ...formData, password: e.target.value })}
+                className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
+                placeholder="请输入密码"
+                required
+                disabled={loading}
+              />
+            </div>
 
-          <div>
-            <label htmlFor="password" className="block text-sm font-medium text-gray-700 dark:text-gray-300 mb-2">
-              密码
-            </label>
-            <input
-              type="password"
-              id="password"
-              name="password"
-              value={formData.password}
-              onChange={(e) => setFormData({ ...formData, password: e.target.value })}
-              className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
-              placeholder="请输入密码"
-              required
+            <button
+              type="submit"
               disabled={loading}
-            />
-          </div>
+              className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200 shadow-md hover:shadow-lg disabled:opacity-50 disabled:cursor-not-allowed"
+            >
+              {loading ? '登录中...' : '登录'}
+            </button>
+          </form>
+        )}
 
-          <button
-            type="submit"
-            disabled={loading}
-            className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200 shadow-md hover:shadow-lg disabled:opacity-50 disabled:cursor-not-allowed"
+        {!mfa && recoveryCodes.length === 0 && (
+          <a
+            href="/api/v1/auth/oidc/login"
+            className="mt-4 block w-full text-center border border-gray-300 dark:border-gray-600 text-gray-700 dark:text-gray-200 py-3 rounded-lg font-semibold hover:bg-gray-50 dark:hover:bg-gray-700 transition duration-200"
           >
-            {loading ? '登录中...' : '登录'}
-          </button>
-        </form>
+            使用企业账号登录 (SSO)
+          </a>
+        )}
 
         <p className="mt-6 text-center text-sm text-gray-600 dark:text-gray-400">
+          <Link to="/reset-password" className="text-blue-600 dark:text-blue-400 hover:underline">
+            忘记密码？
+          </Link>
+          <span className="mx-2">·</span>
           还没有账号？{' '}
           <Link to="/register" className="text-blue-600 dark:text-blue-400 hover:text-blue-700 dark:hover:text-blue-300 font-semibold hover:underline">
             立即注册
@@ -0,0 +1,68 @@
+import { useEffect, useRef, useState } from 'react'
+import { Link, useNavigate, useSearchParams } from 'react-router-dom'
+import { saveTokens, TokenResponse } from '../api/auth'
+
+/**
+ * 单点登录回调页
+ * IdP 跳回时带上 code 和 state，提交到网关换取 cheya 的 token
+ */
+const OidcCallbackPage = () => {
+  const navigate = useNavigate()
+  const [params] = useSearchParams()
+  const [error, setError] = useState('')
+  // 授权码只能使用一次，避免 StrictMode 下重复提交
+  const submitted = useRef(false)
+
+  useEffect(() => {
+    if (submitted.current) {
+      return
+    }
+    submitted.current = true
+
+    const idpError = params.get('error')
+    if (idpError) {
+      setError(params.get('error_description') || idpError)
+      return
+    }
+
+    fetch('/api/v1/auth/oidc/callback', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ code: params.get('code') || '', state: params.get('state') || '' }),
+    })
+      .then(async (response) => {
+        const data = await response.json()
+        if (!response.ok) {
+          setError(data.error || '单点登录失败')
+          return
+        }
+        saveTokens(data as TokenResponse)
+        navigate('/', { replace: true })
+      })
+      .catch((err) => {
+        setError('网络错误，请检查连接后重试')
+        console.error('单点登录错误:', err)
+      })
+  }, [params, navigate])
+
+  return (
+    <div className="min-h-screen bg-gradient-to-br from-blue-50 to-indigo-100 dark:from-gray-900 dark:to-blue-900 flex items-center justify-center px-4">
+      <div className="max-w-md w-full bg-white/80 dark:bg-gray-800/80 backdrop-blur-lg rounded-lg shadow-lg border border-gray-200 dark:border-gray-700 p-8 text-center">
+        {error ? (
+          <>
+            <div className="mb-4 p-3 bg-red-100 dark:bg-red-900/30 border border-red-400 dark:border-red-700 text-red-700 dark:text-red-400 rounded-lg text-sm">
+              {error}
+            </div>
+            <Link to="/login" className="text-blue-600 dark:text-blue-400 hover:underline font-semibold">
+              返回登录
+            </Link>
+          </>
+        ) : (
+          <p className="text-gray-700 dark:text-gray-300">正在完成单点登录...</p>
+        )}
+      </div>
+    </div>
+  )
+}
+
+export default OidcCallbackPage
@@ -39,8 +39,8 @@ const RegisterPage = () => {
       return
     }
 
-    if (formData.password.length < 6) {
-      setError('密码长度至少为6位')
+    if (formData.password.length < 8) {
+      setError('密码长度至少为8位')
       return
     }
 
@@ -139,7 +139,7 @@ const RegisterPage = () => {
               className="w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-green-500 dark:focus:ring-green-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500"
               placeholder="创建密码"
               required
-              minLength={6}
+              minLength={8}
               disabled={loading}
             />
           </div>
@@ -0,0 +1,145 @@
+import { useState, FormEvent } from 'react'
+import { Link, useNavigate, useSearchParams } from 'react-router-dom'
+import ThemeToggle from '../components/ThemeToggle'
+
+const inputClass =
+  'w-full px-4 py-3 border border-gray-300 dark:border-gray-600 bg-white dark:bg-gray-700 text-gray-900 dark:text-gray-100 rounded-lg focus:ring-2 focus:ring-blue-500 dark:focus:ring-blue-400 focus:border-transparent transition duration-200 placeholder-gray-400 dark:placeholder-gray-500'
+
+/**
+ * 找回密码
+ * 没有 token 时填写用户名或邮箱申请重置链接；从邮件链接进入时 (?token=) 设置新密码
+ */
+const ResetPasswordPage = () => {
+  const navigate = useNavigate()
+  const [params] = useSearchParams()
+  const token = params.get('token') || ''
+  const [username, setUsername] = useState('')
+  const [password, setPassword] = useState('')
+  const [confirmPassword, setConfirmPassword] = useState('')
+  const [loading, setLoading] = useState(false)
+  const [error, setError] = useState('')
+  const [message, setMessage] = useState('')
+
+  const submit = async (url: string, body: object) => {
+    setError('')
+    setLoading(true)
+    try {
+      const response = await fetch(url, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/json' },
+        body: JSON.stringify(body),
+      })
+      const data = await response.json()
+      if (!response.ok) {
+        setError(data.error || '操作失败，请稍后重试')
+        return false
+      }
+      setMessage(data.message)
+      return true
+    } catch (err) {
+      setError('网络错误，请检查连接后重试')
+      console.error('找回密码错误:', err)
+      return false
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const handleRequest = async (e: FormEvent<HTMLFormElement>) => {
+    e.preventDefault()
+    await submit('/api/v1/auth/password/reset', { username })
+  }
+
+  const handleConfirm = async (e: FormEvent<HTMLFormElement>) => {
+    e.preventDefault()
+    if (password !== confirmPassword) {
+      setError('两次输入的密码不一致')
+      return
+    }
+    if (await submit('/api/v1/auth/password/reset/confirm', { token, new_password: password })) {
+      setTimeout(() => navigate('/login', { replace: true }), 1500)
+    }
+  }
+
+  return (
+    <div className="min-h-screen bg-gradient-to-br from-blue-50 to-indigo-100 dark:from-gray-900 dark:to-blue-900 flex items-center justify-center px-4 relative">
+      <div className="absolute top-6 right-6">
+        <ThemeToggle />
+      </div>
+
+      <div className="max-w-md w-full bg-white/80 dark:bg-gray-800/80 backdrop-blur-lg rounded-lg shadow-lg border border-gray-200 dark:border-gray-700 p-8 mx-auto mt-20">
+        <h1 className="text-3xl font-bold text-gray-800 dark:text-gray-100 mb-6 text-center">
+          {token ? '设置新密码' : '找回密码'}
+        </h1>
+
+        {error && (
+          <div className="mb-4 p-3 bg-red-100 dark:bg-red-900/30 border border-red-400 dark:border-red-700 text-red-700 dark:text-red-400 rounded-lg text-sm">
+            {error}
+          </div>
+        )}
+        {message && (
+          <div className="mb-4 p-3 bg-green-100 dark:bg-green-900/30 border border-green-400 dark:border-green-700 text-green-700 dark:text-green-400 rounded-lg text-sm">
+            {message}
+          </div>
+        )}
+
+        {!message && (token ? (
+          <form onSubmit={handleConfirm} className="space-y-6">
+            <input
+              type="password"
+              value={password}
+              onChange={(e) => setPassword(e.target.value)}
+              className={inputClass}
+              placeholder="新密码 (至少 8 位，包含字母和数字)"
+              required
+              disabled={loading}
+            />
+            <input
+              type="password"
+              value={confirmPassword}
+              onChange={(e) => setConfirmPassword(e.target.value)}
+              className={inputClass}
+              placeholder="确认新密码"
+              required
+              disabled={loading}
+            />
+            <button
+              type="submit"
+              disabled={loading}
+              className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200 shadow-md hover:shadow-lg disabled:opacity-50 disabled:cursor-not-allowed"
+            >
+              {loading ? '提交中...' : '设置新密码'}
+            </button>
+          </form>
+        ) : (
+          <form onSubmit={handleRequest} className="space-y-6">
+            <input
+              type="text"
+              value={username}
+              onChange={(e) => setUsername(e.target.value)}
+              className={inputClass}
+              placeholder="用户名或邮箱"
+              required
+              disabled={loading}
+            />
+            <button
+              type="submit"
+              disabled={loading}
+              className="w-full bg-blue-600 dark:bg-blue-700 text-white py-3 rounded-lg font-semibold hover:bg-blue-700 dark:hover:bg-blue-600 transition duration-200 shadow-md hover:shadow-lg disabled:opacity-50 disabled:cursor-not-allowed"
+            >
+              {loading ? '发送中...' : '发送重置链接'}
+            </button>
+          </form>
+        ))}
+
+        <p className="mt-6 text-center text-sm text-gray-600 dark:text-gray-400">
+          <Link to="/login" className="text-blue-600 dark:text-blue-400 hover:underline font-semibold">
+            返回登录
+          </Link>
+        </p>
+      </div>
+    </div>
+  )
+}
+
+export default ResetPasswordPage
@@ -0,0 +1,105 @@
+package grpcauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxDeviceCacheEntries 缓存上限，防止随机凭证把内存打满
+const maxDeviceCacheEntries = 10000
+
+type deviceEntry struct {
+	principal *Principal // nil 表示凭证无效
+	expireAt  time.Time
+}
+
+// deviceAuthenticator 通过 VehicleService.AuthenticateDevice 校验设备凭证
+// 遥测上报频率很高，校验结果 (包括无效结果) 在 ttl 内缓存，停用设备最多延迟 ttl 生效
+type deviceAuthenticator struct {
+	client vehiclev1.VehicleServiceClient
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]deviceEntry
+}
+
+// NewDeviceAuthenticator 校验车载终端的设备凭证
+func NewDeviceAuthenticator(client vehiclev1.VehicleServiceClient, ttl time.Duration) Authenticator {
+	return &deviceAuthenticator{
+		client: client,
+		ttl:    ttl,
+		cache:  make(map[[sha256.Size]byte]deviceEntry),
+	}
+}
+
+func (a *deviceAuthenticator) Authenticate(ctx context.Context, credential string) (*Principal, error) {
+	key := sha256.Sum256([]byte(credential))
+	now := time.Now()
+
+	a.mu.Lock()
+	e, ok := a.cache[key]
+	a.mu.Unlock()
+	if !ok || now.After(e.expireAt) {
+		resp, err := a.client.AuthenticateDevice(metadata.NewOutgoingContext(ctx, nil), &vehiclev1.AuthenticateDeviceRequest{Credential: credential})
+		if err != nil {
+			return nil, err
+		}
+		e = deviceEntry{expireAt: now.Add(a.ttl)}
+		if resp.Active {
+			e.principal = &Principal{
+				UserID:   "device:" + resp.DeviceId,
+				Username: resp.Vin,
+				Role:     RoleDevice,
+				TenantID: resp.TenantId,
+				VIN:      resp.Vin,
+			}
+		}
+		a.store(key, e, now)
+	}
+	if e.principal == nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid or revoked device credential")
+	}
+	return e.principal, nil
+}
+
+func (a *deviceAuthenticator) store(key [sha256.Size]byte, e deviceEntry, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.cache) >= maxDeviceCacheEntries {
+		for k, v := range a.cache {
+			if now.After(v.expireAt) {
+				delete(a.cache, k)
+			}
+		}
+		if len(a.cache) >= maxDeviceCacheEntries {
+			a.cache = make(map[[sha256.Size]byte]deviceEntry)
+		}
+	}
+	a.cache[key] = e
+}
+
+// deviceDispatcher 设备凭证交给 devices 校验，其余 token 交给 next
+type deviceDispatcher struct {
+	next    Authenticator
+	devices Authenticator
+}
+
+// WithDevices 在 next 之外同时接受设备凭证
+func WithDevices(next, devices Authenticator) Authenticator {
+	return &deviceDispatcher{next: next, devices: devices}
+}
+
+func (a *deviceDispatcher) Authenticate(ctx context.Context, tokenString string) (*Principal, error) {
+	if token.IsDeviceCredential(tokenString) {
+		return a.devices.Authenticate(ctx, tokenString)
+	}
+	return a.next.Authenticate(ctx, tokenString)
+}
@@ -0,0 +1,311 @@
+// Package grpcauth 提供 gRPC 服务端鉴权拦截器
+// 拦截器从 metadata 的 authorization 头中读取 bearer token，校验后把调用者身份 (Principal) 放入 context，
+// 业务方法通过 FromContext / RequireRole 按调用者做权限控制；API key 另外按 WithMethodScopes 检查 scope。
+package grpcauth
+
+import (
+	"context"
+	"slices"
+
+	authv1 "github.com/xuewentao/cheya/api/auth/v1"
+	"github.com/xuewentao/cheya/pkg/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 角色
+const (
+	RolePlatformAdmin = "platform_admin" // 平台管理员，可以跨租户访问
+	RoleAdmin         = "admin"          // 租户管理员
+	RoleOperator      = "operator"
+	RoleViewer        = "viewer"
+	RoleDevice        = "device" // 车载终端，只能上报绑定车辆的遥测
+)
+
+// Principal 是通过鉴权的调用者
+type Principal struct {
+	UserID    string
+	Username  string
+	Role      string
+	SessionID string
+	TenantID  string
+	VIN       string   // 设备绑定的车辆，只有设备身份有值
+	Scopes    []string // API key 的权限范围，见 HasScope
+}
+
+// HasRole 调用者是否拥有任一角色，平台管理员拥有所有角色
+func (p *Principal) HasRole(roles ...string) bool {
+	return p.IsPlatformAdmin() || slices.Contains(roles, p.Role)
+}
+
+// IsPlatformAdmin 是否是平台管理员，平台管理员的查询不按租户过滤
+func (p *Principal) IsPlatformAdmin() bool {
+	return p.Role == RolePlatformAdmin
+}
+
+// HasScope 调用者是否可以访问 scope，只有 API key 受 scope 限制
+func (p *Principal) HasScope(scope string) bool {
+	return !token.IsAPIKeySubject(p.UserID) || slices.Contains(p.Scopes, scope)
+}
+
+// Authenticator 把 token 解析为调用者身份
+type Authenticator interface {
+	Authenticate(ctx context.Context, tokenString string) (*Principal, error)
+}
+
+// localAuthenticator 使用 JWKS 本地验签，并检查 Redis 吊销列表
+type localAuthenticator struct {
+	verifier *token.Verifier
+}
+
+// NewLocalAuthenticator 本地校验，不需要每次请求都访问 auth service
+func NewLocalAuthenticator(verifier *token.Verifier) Authenticator {
+	return &localAuthenticator{verifier: verifier}
+}
+
+func (a *localAuthenticator) Authenticate(ctx context.Context, tokenString string) (*Principal, error) {
+	claims, err := a.verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return nil, err
+	}
+	return &Principal{
+		UserID:    claims.UserID,
+		Username:  claims.Username,
+		Role:      claims.Role,
+		SessionID: claims.SessionID,
+		TenantID:  claims.TenantID,
+	}, nil
+}
+
+// introspectionAuthenticator 每次请求调用 AuthService.Introspect
+type introspectionAuthenticator struct {
+	client authv1.AuthServiceClient
+}
+
+// NewIntrospectionAuthenticator 适用于无法访问 Redis 吊销列表的服务
+func NewIntrospectionAuthenticator(client authv1.AuthServiceClient) Authenticator {
+	return &introspectionAuthenticator{client: client}
+}
+
+func (a *introspectionAuthenticator) Authenticate(ctx context.Context, tokenString string) (*Principal, error) {
+	//不把调用方的 metadata 透传给 auth service
+	resp, err := a.client.Introspect(metadata.NewOutgoingContext(ctx, nil), &authv1.IntrospectRequest{Token: tokenString})
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Active {
+		return nil, status.Error(codes.Unauthenticated, "token is not active")
+	}
+	return &Principal{
+		UserID:    resp.UserId,
+		Username:  resp.Username,
+		Role:      resp.Role,
+		SessionID: resp.SessionId,
+		TenantID:  resp.TenantId,
+		Scopes:    resp.Scopes,
+	}, nil
+}
+
+// apiKeyAuthenticator API key 交给 AuthService.Introspect 校验，其余 token 交给 next
+type apiKeyAuthenticator struct {
+	next       Authenticator
+	introspect Authenticator
+}
+
+// WithAPIKeys 在 next 之外同时接受 API key
+// API key 无法本地校验，每次请求都会访问 auth service，吊销后立即生效
+func WithAPIKeys(next Authenticator, client authv1.AuthServiceClient) Authenticator {
+	return &apiKeyAuthenticator{
+		next:       next,
+		introspect: NewIntrospectionAuthenticator(client),
+	}
+}
+
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, tokenString string) (*Principal, error) {
+	if token.IsAPIKey(tokenString) {
+		return a.introspect.Authenticate(ctx, tokenString)
+	}
+	return a.next.Authenticate(ctx, tokenString)
+}
+
+// JWKSFetcher 通过 gRPC 从 auth service 获取验签公钥
+func JWKSFetcher(client authv1.AuthServiceClient) token.JWKSFetcher {
+	return func(ctx context.Context) (*token.JWKS, error) {
+		resp, err := client.GetJWKS(ctx, &authv1.GetJWKSRequest{})
+		if err != nil {
+			return nil, err
+		}
+		set := &token.JWKS{Keys: make([]token.JWK, len(resp.Keys))}
+		for i, k := range resp.Keys {
+			set.Keys[i] = token.JWK{
+				Kty: k.Kty,
+				Kid: k.Kid,
+				Use: k.Use,
+				Alg: k.Alg,
+				N:   k.N,
+				E:   k.E,
+				Crv: k.Crv,
+				X:   k.X,
+			}
+		}
+		return set, nil
+	}
+}
+
+type principalKey struct{}
+
+// NewContext 把调用者身份放入 context
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext 取出拦截器放入的调用者身份
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// RequireRole 要求调用者拥有任一角色，否则返回 Unauthenticated / PermissionDenied
+func RequireRole(ctx context.Context, roles ...string) (*Principal, error) {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if !p.HasRole(roles...) {
+		return nil, status.Errorf(codes.PermissionDenied, "role %s is not allowed", p.Role)
+	}
+	return p, nil
+}
+
+// RequireDevice 要求调用者是车载终端
+// 用户 (包括平台管理员) 不能冒充设备上报数据
+func RequireDevice(ctx context.Context) (*Principal, error) {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if p.Role != RoleDevice || p.VIN == "" {
+		return nil, status.Error(codes.PermissionDenied, "device credential required")
+	}
+	return p, nil
+}
+
+// Option 拦截器配置
+type Option func(*options)
+
+type options struct {
+	public map[string]bool
+	scopes map[string]string
+}
+
+// WithPublicMethods 不需要鉴权的方法，例如 "/auth.v1.AuthService/Login"
+func WithPublicMethods(methods ...string) Option {
+	return func(o *options) {
+		for _, m := range methods {
+			o.public[m] = true
+		}
+	}
+}
+
+// WithMethodScopes 方法需要的 API key scope，key 为完整方法名
+// API key 调用不在表中的方法时返回 PermissionDenied，用户的 access token 不受影响
+func WithMethodScopes(scopes map[string]string) Option {
+	return func(o *options) {
+		for m, sc := range scopes {
+			o.scopes[m] = sc
+		}
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{public: map[string]bool{}, scopes: map[string]string{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// authenticate 从 metadata 中读取 token 并校验
+func authenticate(ctx context.Context, auth Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization")
+	}
+	tokenString, err := token.FromAuthorization(values[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	p, err := auth.Authenticate(ctx, tokenString)
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			return nil, st.Err()
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	return NewContext(ctx, p), nil
+}
+
+// checkScope API key 必须拥有方法需要的 scope
+func (o *options) checkScope(ctx context.Context, method string) error {
+	p, _ := FromContext(ctx)
+	scope, ok := o.scopes[method]
+	if !ok {
+		if token.IsAPIKeySubject(p.UserID) {
+			return status.Errorf(codes.PermissionDenied, "%s is not available to api keys", method)
+		}
+		return nil
+	}
+	if !p.HasScope(scope) {
+		return status.Errorf(codes.PermissionDenied, "api key scope %s required", scope)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor 一元调用鉴权拦截器
+func UnaryServerInterceptor(auth Authenticator, opts ...Option) grpc.UnaryServerInterceptor {
+	o := newOptions(opts)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if o.public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, auth)
+		if err != nil {
+			return nil, err
+		}
+		if err := o.checkScope(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor 流式调用鉴权拦截器
+func StreamServerInterceptor(auth Authenticator, opts ...Option) grpc.StreamServerInterceptor {
+	o := newOptions(opts)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if o.public[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), auth)
+		if err != nil {
+			return err
+		}
+		if err := o.checkScope(ctx, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// wrappedStream 替换 ServerStream 的 context
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
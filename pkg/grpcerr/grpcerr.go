@@ -0,0 +1,33 @@
+// Package grpcerr 提供各服务共用的 gRPC 错误构造
+// 字段级校验错误以 errdetails.BadRequest 返回，网关据此在响应中列出每个字段的错误。
+package grpcerr
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldErrors 收集字段级校验错误
+type FieldErrors []*errdetails.BadRequest_FieldViolation
+
+// Add 记录一个字段的错误
+func (e *FieldErrors) Add(field, description string) {
+	*e = append(*e, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// Err 没有错误时返回 nil，否则返回带 BadRequest 详情的 InvalidArgument
+// message 取第一条错误，方便前端直接展示
+func (e FieldErrors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	st := status.New(codes.InvalidArgument, e[0].Description)
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
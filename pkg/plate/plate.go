@@ -0,0 +1,55 @@
+// Package plate 校验和规范化中国大陆机动车号牌 (GA 36-2018)
+//
+//	普通号牌   省份简称 + 发牌机关代号 + 5 位序号，末位可以是 挂/学/警/港/澳，例如 沪A12345、粤Z1234港
+//	小型新能源 省份简称 + 发牌机关代号 + D/F + 5 位序号，例如 沪AD12345
+//	大型新能源 省份简称 + 发牌机关代号 + 5 位数字 + D/F，例如 沪A12345D
+//
+// 序号中的字母不使用 I 和 O，避免与数字 1 和 0 混淆。
+package plate
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var ErrFormat = errors.New("车牌号格式不正确")
+
+const (
+	provinces = "京津沪渝冀豫云辽黑湘皖鲁新苏浙赣鄂桂甘晋蒙陕吉闽贵粤青藏川宁琼"
+	letter    = "[A-HJ-NP-Z]"
+	serial    = "[A-HJ-NP-Z0-9]"
+)
+
+var (
+	regular     = regexp.MustCompile("^[" + provinces + "]" + letter + serial + "{4}(?:" + serial + "|[挂学警港澳])$")
+	newEnergy   = regexp.MustCompile("^[" + provinces + "]" + letter + "[DF]" + serial + "[0-9]{4}$")
+	largeEnergy = regexp.MustCompile("^[" + provinces + "]" + letter + "[0-9]{5}[DF]$")
+)
+
+// Normalize 去掉空白和分隔符 (- · •)，字母转为大写，例如 "沪a·12345" -> "沪A12345"
+func Normalize(p string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r), r == '-', r == '·', r == '•', r == '.':
+			return -1
+		case r >= 'a' && r <= 'z':
+			return unicode.ToUpper(r)
+		}
+		return r
+	}, p)
+}
+
+// IsNewEnergy 是否为新能源号牌 (8 位)，p 需已经 Normalize
+func IsNewEnergy(p string) bool {
+	return newEnergy.MatchString(p) || largeEnergy.MatchString(p)
+}
+
+// Validate 校验已经 Normalize 过的车牌号
+func Validate(p string) error {
+	if regular.MatchString(p) || IsNewEnergy(p) {
+		return nil
+	}
+	return ErrFormat
+}
@@ -0,0 +1,65 @@
+package plate
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"沪A12345", "沪A12345"},
+		{"沪a·12345", "沪A12345"},
+		{" 粤 B-12345 ", "粤B12345"},
+		{"京A•D12345", "京AD12345"},
+		{"苏e.12345", "苏E12345"},
+	}
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		plate     string
+		valid     bool
+		newEnergy bool
+	}{
+		//普通号牌
+		{"沪A12345", true, false},
+		{"京BX1234", true, false},
+		{"粤Z1234港", true, false},
+		{"粤Z1234澳", true, false},
+		{"苏E1234挂", true, false},
+		{"川A1234学", true, false},
+		{"闽D1234警", true, false},
+		{"沪AD1234", true, false}, // 序号以 D 开头的普通号牌，不是新能源
+		//小型新能源
+		{"沪AD12345", true, true},
+		{"沪AF12345", true, true},
+		{"京ADA1234", true, true},
+		//大型新能源
+		{"沪A12345D", true, true},
+		{"粤B12345F", true, true},
+		//格式错误
+		{"", false, false},
+		{"沪A1234", false, false},   // 序号不足 5 位
+		{"沪A123456", false, false}, // 普通号牌多一位
+		{"AA12345", false, false},  // 缺少省份简称
+		{"港A12345", false, false},  // 不是省份简称
+		{"沪I12345", false, false},  // 发牌机关代号不使用 I
+		{"沪AO1234", false, false},  // 序号不使用 O
+		{"沪a12345", false, false},  // 未 Normalize
+		{"沪AD123", false, false},   // 序号不足
+		{"沪AE12345", false, false}, // 新能源第 3 位只能是 D/F
+		{"沪AD1234K", false, false}, // 小型新能源后 4 位必须是数字
+		{"沪A1234KD", false, false}, // 大型新能源前 5 位必须是数字
+		{"沪A12345挂", false, false}, // 挂车号牌序号只有 4 位
+	}
+	for _, tt := range tests {
+		if err := Validate(tt.plate); (err == nil) != tt.valid {
+			t.Errorf("Validate(%q) = %v, want valid=%v", tt.plate, err, tt.valid)
+		}
+		if got := IsNewEnergy(tt.plate); got != tt.newEnergy {
+			t.Errorf("IsNewEnergy(%q) = %v, want %v", tt.plate, got, tt.newEnergy)
+		}
+	}
+}
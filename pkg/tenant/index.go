@@ -0,0 +1,88 @@
+// Package tenant 维护车辆 VIN 到所属租户的索引
+// vehicle service 在车辆写入时更新索引，网关据此把遥测推送和车辆指令限制在调用者的租户内，
+// 不需要为每条遥测消息调用 vehicle service。
+package tenant
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// IndexKey Redis hash: field 为 VIN，value 为租户 ID
+const IndexKey = "vehicle:tenant"
+
+// SetVehicle 记录车辆所属租户，tenantID 为空时删除
+func SetVehicle(ctx context.Context, rdb *redis.Client, vin, tenantID string) error {
+	if tenantID == "" {
+		return rdb.HDel(ctx, IndexKey, vin).Err()
+	}
+	return rdb.HSet(ctx, IndexKey, vin, tenantID).Err()
+}
+
+type entry struct {
+	tenantID string
+	expireAt time.Time
+}
+
+// Index 是带本地缓存的只读索引
+// 遥测消息频率很高，同一辆车的查询在 ttl 内直接命中本地缓存
+type Index struct {
+	rdb *redis.Client
+	ttl time.Duration
+
+	mu    sync.Mutex
+	cache map[string]entry
+}
+
+func NewIndex(rdb *redis.Client, ttl time.Duration) *Index {
+	return &Index{
+		rdb:   rdb,
+		ttl:   ttl,
+		cache: make(map[string]entry),
+	}
+}
+
+// Lookup 返回车辆所属租户，未登记的车辆返回空字符串
+func (i *Index) Lookup(ctx context.Context, vin string) (string, error) {
+	tenants, err := i.LookupMany(ctx, []string{vin})
+	if err != nil {
+		return "", err
+	}
+	return tenants[vin], nil
+}
+
+// LookupMany 批量查询，未命中缓存的 VIN 通过一次 HMGET 取回
+func (i *Index) LookupMany(ctx context.Context, vins []string) (map[string]string, error) {
+	result := make(map[string]string, len(vins))
+	var missing []string
+
+	now := time.Now()
+	i.mu.Lock()
+	for _, vin := range vins {
+		if e, ok := i.cache[vin]; ok && now.Before(e.expireAt) {
+			result[vin] = e.tenantID
+		} else {
+			missing = append(missing, vin)
+		}
+	}
+	i.mu.Unlock()
+	if len(missing) == 0 {
+		return result, nil
+	}
+
+	values, err := i.rdb.HMGet(ctx, IndexKey, missing...).Result()
+	if err != nil {
+		return nil, err
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for j, vin := range missing {
+		tenantID, _ := values[j].(string)
+		result[vin] = tenantID
+		i.cache[vin] = entry{tenantID: tenantID, expireAt: now.Add(i.ttl)}
+	}
+	return result, nil
+}
@@ -0,0 +1,202 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JWK 是 RFC 7517 中的一把公钥，只包含 cheya 用到的 RSA 和 OKP(Ed25519) 字段
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS 是 /.well-known/jwks.json 的内容
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// NewJWK 把公钥转换为 JWK
+func NewJWK(kid string, pub crypto.PublicKey) (*JWK, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return &JWK{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   b64.EncodeToString(k.N.Bytes()),
+			E:   b64.EncodeToString(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case ed25519.PublicKey:
+		return &JWK{
+			Kty: "OKP",
+			Kid: kid,
+			Use: "sig",
+			Alg: "EdDSA",
+			Crv: "Ed25519",
+			X:   b64.EncodeToString(k),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", pub)
+	}
+}
+
+// PublicKey 把 JWK 还原为公钥
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := b64.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := b64.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// JWKSFetcher 获取最新的 JWKS
+type JWKSFetcher func(ctx context.Context) (*JWKS, error)
+
+// JWKSCache 是校验方使用的远程公钥缓存
+// 缓存过期或遇到未知 kid 时重新拉取，签发方轮换密钥后无需重启校验方
+type JWKSCache struct {
+	fetch      JWKSFetcher
+	ttl        time.Duration
+	minRefresh time.Duration
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	raw         *JWKS
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func NewJWKSCache(fetch JWKSFetcher, ttl time.Duration) *JWKSCache {
+	return &JWKSCache{
+		fetch:      fetch,
+		ttl:        ttl,
+		minRefresh: 10 * time.Second,
+	}
+}
+
+// HTTPJWKSFetcher 从 URL 拉取 JWKS，供 cheya 之外的服务使用
+func HTTPJWKSFetcher(url string) JWKSFetcher {
+	return func(ctx context.Context) (*JWKS, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetch jwks: %s", resp.Status)
+		}
+		var set JWKS
+		if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+			return nil, err
+		}
+		return &set, nil
+	}
+}
+
+// PublicKey 实现 KeySource
+func (c *JWKSCache) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stale() {
+		if err := c.refresh(ctx); err != nil && c.keys == nil {
+			return nil, err
+		}
+	}
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	//未知 kid 可能是刚轮换的新密钥，限制频率避免被伪造 kid 打爆签发方
+	if time.Since(c.lastAttempt) > c.minRefresh {
+		if err := c.refresh(ctx); err != nil {
+			return nil, err
+		}
+		if key, ok := c.keys[kid]; ok {
+			return key, nil
+		}
+	}
+	return nil, ErrUnknownKey
+}
+
+// JWKS 返回缓存的 JWKS，用于对外发布
+func (c *JWKSCache) JWKS(ctx context.Context) (*JWKS, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.raw == nil || c.stale() {
+		if err := c.refresh(ctx); err != nil && c.raw == nil {
+			return nil, err
+		}
+	}
+	return c.raw, nil
+}
+
+// stale 缓存已过期，且距离上次拉取超过最小间隔 (签发方不可用时不会每次请求都去拉)
+func (c *JWKSCache) stale() bool {
+	return time.Since(c.fetchedAt) > c.ttl && time.Since(c.lastAttempt) > c.minRefresh
+}
+
+func (c *JWKSCache) refresh(ctx context.Context) error {
+	c.lastAttempt = time.Now()
+	set, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		pub, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = pub
+	}
+	c.keys, c.raw, c.fetchedAt = keys, set, time.Now()
+	return nil
+}
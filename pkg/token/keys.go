@@ -0,0 +1,156 @@
+package token
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrUnknownKey token 的 kid 不在已知公钥中
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySource 按 kid 查找验签公钥
+type KeySource interface {
+	PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// signingKey 一把签名私钥
+type signingKey struct {
+	kid     string
+	private crypto.Signer
+	method  jwt.SigningMethod
+}
+
+// KeySet 是签发方持有的私钥集合
+// 目录中的每个 <kid>.pem 都是一把私钥 (PKCS#8，RSA 或 Ed25519)。
+// 只有 active 那把用来签名，其余的仍然发布在 JWKS 中，
+// 这样轮换后用旧密钥签发的 token 在过期前依然可以通过校验。
+type KeySet struct {
+	keys   map[string]*signingKey
+	active *signingKey
+}
+
+// LoadKeySet 从目录加载私钥
+// activeKID 为空时使用文件名排序最后的一把；目录中没有私钥时自动生成一把 RSA 密钥，方便本地开发
+func LoadKeySet(dir, activeKID string) (*KeySet, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		kid := time.Now().Format("20060102")
+		if err := GenerateKeyFile(dir, kid); err != nil {
+			return nil, fmt.Errorf("generate signing key: %w", err)
+		}
+		files = []string{filepath.Join(dir, kid+".pem")}
+	}
+	sort.Strings(files)
+
+	ks := &KeySet{keys: make(map[string]*signingKey, len(files))}
+	for _, file := range files {
+		key, err := loadSigningKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("load %s: %w", file, err)
+		}
+		ks.keys[key.kid] = key
+		ks.active = key
+	}
+	if activeKID != "" {
+		key, ok := ks.keys[activeKID]
+		if !ok {
+			return nil, fmt.Errorf("active key %q not found in %s", activeKID, dir)
+		}
+		ks.active = key
+	}
+	return ks, nil
+}
+
+func loadSigningKey(file string) (*signingKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key := &signingKey{kid: strings.TrimSuffix(filepath.Base(file), ".pem")}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.private, key.method = k, jwt.SigningMethodRS256
+	case ed25519.PrivateKey:
+		key.private, key.method = k, jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+	return key, nil
+}
+
+// GenerateKeyFile 生成一把 RSA-2048 私钥并写入 <dir>/<kid>.pem
+func GenerateKeyFile(dir, kid string) error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	return os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600)
+}
+
+// ActiveKID 当前用于签名的 kid
+func (ks *KeySet) ActiveKID() string {
+	return ks.active.kid
+}
+
+// Sign 使用 active 私钥签名，并在 header 中写入 kid
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	t := jwt.NewWithClaims(ks.active.method, claims)
+	t.Header["kid"] = ks.active.kid
+	return t.SignedString(ks.active.private)
+}
+
+// PublicKey 实现 KeySource
+func (ks *KeySet) PublicKey(_ context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key.private.Public(), nil
+}
+
+// JWKS 导出全部公钥
+func (ks *KeySet) JWKS() *JWKS {
+	set := &JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		jwk, err := NewJWK(key.kid, key.private.Public())
+		if err != nil {
+			continue
+		}
+		set.Keys = append(set.Keys, *jwk)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	return set
+}
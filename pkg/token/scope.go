@@ -0,0 +1,45 @@
+package token
+
+import (
+	"slices"
+	"strings"
+)
+
+// APIKeySubjectPrefix API key 身份的 sub / user_id 形如 apikey:<id>
+const APIKeySubjectPrefix = "apikey:"
+
+// API key 的权限范围
+// 角色决定 key 最多能做什么，scope 进一步限制 key 能访问哪些接口；用户的 access token 不受 scope 限制
+const (
+	ScopeVehiclesRead  = "vehicles:read"  // 查询车辆、分组、司机和变更记录，导出车辆
+	ScopeVehiclesWrite = "vehicles:write" // 创建、修改、删除、导入车辆，管理分组和司机
+	ScopeDevicesRead   = "devices:read"   // 查询车载终端
+	ScopeDevicesWrite  = "devices:write"  // 签发和吊销车载终端凭证
+	ScopeCommandsRead  = "commands:read"  // 查询指令执行状态
+	ScopeCommandsWrite = "commands:write" // 下发单车、批量和定时指令
+	ScopeTelemetryRead = "telemetry:read" // 订阅实时遥测 (WebSocket)
+	ScopeUsersWrite    = "users:write"    // 审核用户、解锁账号、邀请码和组织管理
+)
+
+// Scopes 所有可分配给 API key 的权限范围
+var Scopes = []string{
+	ScopeVehiclesRead,
+	ScopeVehiclesWrite,
+	ScopeDevicesRead,
+	ScopeDevicesWrite,
+	ScopeCommandsRead,
+	ScopeCommandsWrite,
+	ScopeTelemetryRead,
+	ScopeUsersWrite,
+}
+
+// IsAPIKeySubject sub / user_id 是否是 API key 身份
+func IsAPIKeySubject(subject string) bool {
+	return strings.HasPrefix(subject, APIKeySubjectPrefix)
+}
+
+// HasScope 调用者是否可以访问 scope
+// 用户的 access token 只按角色鉴权；API key 还必须在创建时被授予该 scope
+func (c *Claims) HasScope(scope string) bool {
+	return !IsAPIKeySubject(c.Subject) || slices.Contains(c.Scopes, scope)
+}
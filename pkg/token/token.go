@@ -0,0 +1,149 @@
+// Package token 定义 cheya 签发的 access token 格式、签名密钥与 JWKS，
+// 以及签发方和校验方共用的吊销列表
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis 吊销列表 key 前缀
+// auth:revoked:jti:<jti>  单个 access token 被吊销 (登出)
+// auth:revoked:sid:<sid>  整个登录会话被吊销 (登出 / refresh token 复用)
+const (
+	RevokedJTIPrefix     = "auth:revoked:jti:"
+	RevokedSessionPrefix = "auth:revoked:sid:"
+)
+
+// Issuer 写入 access token 的 iss
+const Issuer = "cheya-auth"
+
+// 凭证前缀，bearer token 据此区分 API key、设备凭证和 JWT
+const (
+	APIKeyPrefix           = "ck_"
+	DeviceCredentialPrefix = "cd_"
+)
+
+// Introspect 返回的 token 类型
+const (
+	TypeAccessToken = "access_token"
+	TypeAPIKey      = "api_key"
+)
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrRevoked      = errors.New("token has been revoked")
+)
+
+// Claims 是 access token 中携带的用户信息
+type Claims struct {
+	UserID    string   `json:"user_id"`
+	Username  string   `json:"username"`
+	Role      string   `json:"role"`
+	SessionID string   `json:"sid"`                 // 登录会话 (refresh token 家族) ID
+	TenantID  string   `json:"tenant_id,omitempty"` // 所属租户，平台管理员可能为空
+	Scopes    []string `json:"scopes,omitempty"`    // API key 的权限范围，见 HasScope
+	jwt.RegisteredClaims
+}
+
+// Verifier 校验 access token 的签名、有效期和吊销状态
+type Verifier struct {
+	keys KeySource
+	rdb  *redis.Client
+}
+
+// NewVerifier keys 为验签公钥来源，签发方传 KeySet，其他服务传 JWKSCache
+// rdb 为 nil 时不检查吊销列表
+func NewVerifier(keys KeySource, rdb *redis.Client) *Verifier {
+	return &Verifier{
+		keys: keys,
+		rdb:  rdb,
+	}
+}
+
+// Verify 解析并校验 token
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			return nil, ErrUnknownKey
+		}
+		return v.keys.PublicKey(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.checkRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *Verifier) checkRevoked(ctx context.Context, claims *Claims) error {
+	if v.rdb == nil {
+		return nil
+	}
+	keys := []string{RevokedJTIPrefix + claims.ID}
+	if claims.SessionID != "" {
+		keys = append(keys, RevokedSessionPrefix+claims.SessionID)
+	}
+	n, err := v.rdb.Exists(ctx, keys...).Result()
+	if err != nil {
+		return fmt.Errorf("check revocation list: %w", err)
+	}
+	if n > 0 {
+		return ErrRevoked
+	}
+	return nil
+}
+
+// Revoke 吊销单个 access token，记录保留到 token 过期为止
+func Revoke(ctx context.Context, rdb *redis.Client, claims *Claims) error {
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		return nil
+	}
+	ttl := time.Until(claims.ExpiresAt.Time)
+	if ttl <= 0 {
+		return nil
+	}
+	return rdb.Set(ctx, RevokedJTIPrefix+claims.ID, 1, ttl).Err()
+}
+
+// RevokeSession 吊销某个登录会话下签发的所有 access token
+// ttl 取 access token 的最长有效期即可，之后旧 token 都已自然过期
+func RevokeSession(ctx context.Context, rdb *redis.Client, sessionID string, ttl time.Duration) error {
+	if sessionID == "" {
+		return nil
+	}
+	return rdb.Set(ctx, RevokedSessionPrefix+sessionID, 1, ttl).Err()
+}
+
+// IsAPIKey bearer token 是否是 API key (JWT 总是以 "ey" 开头)
+func IsAPIKey(tokenString string) bool {
+	return strings.HasPrefix(tokenString, APIKeyPrefix)
+}
+
+// IsDeviceCredential bearer token 是否是车载终端的设备凭证
+func IsDeviceCredential(tokenString string) bool {
+	return strings.HasPrefix(tokenString, DeviceCredentialPrefix)
+}
+
+// FromAuthorization 从 "Bearer xxx" 中取出 token
+func FromAuthorization(header string) (string, error) {
+	tokenString, ok := strings.CutPrefix(header, "Bearer ")
+	if !ok || tokenString == "" {
+		return "", ErrMissingToken
+	}
+	return tokenString, nil
+}
@@ -0,0 +1,233 @@
+// Package vehiclecache 车辆详情的 Redis 读穿缓存
+// vehicle service 的 GetVehicle 先查缓存，未命中时查数据库并回填；车辆变更提交后删除缓存。
+// 绕过 vehicle service 直接修改车辆数据的程序调用 Invalidate 发布失效消息。
+package vehiclecache
+
+import (
+	"context"
+	crand "crypto/rand"
+	"encoding/hex"
+	"errors"
+	"expvar"
+	"log"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+
+	vehiclev1 "github.com/xuewentao/cheya/api/vehicle/v1"
+)
+
+const (
+	// InvalidateChannel 失效消息的频道，消息内容为 VIN，多个 VIN 用逗号分隔
+	InvalidateChannel = "vehicle:cache:invalidate"
+
+	keyPrefix = "vehicle:cache:"
+
+	// 缓存值的第一个字节区分类型
+	tagVehicle  = 'v' // proto 序列化的车辆
+	tagNotFound = 'n' // 车辆不存在，避免不存在的 VIN 每次都查数据库
+	tagLoading  = 'l' // 某个副本正在查询数据库，后面是随机 token
+
+	notFoundTTL  = 30 * time.Second
+	loadTimeout  = 3 * time.Second // 查询数据库的超时，也是加载占位的过期时间
+	pollInterval = 20 * time.Millisecond
+)
+
+// stats 命中率指标，通过 expvar 的 /debug/vars 查看
+var (
+	stats         = expvar.NewMap("vehicle_cache")
+	hits          = new(expvar.Int)
+	misses        = new(expvar.Int)
+	waits         = new(expvar.Int) // 等待其他副本加载
+	errorsCount   = new(expvar.Int) // Redis 出错，直接查询数据库
+	invalidations = new(expvar.Int)
+)
+
+func init() {
+	stats.Set("hits", hits)
+	stats.Set("misses", misses)
+	stats.Set("waits", waits)
+	stats.Set("errors", errorsCount)
+	stats.Set("invalidations", invalidations)
+	stats.Set("hit_rate", expvar.Func(func() any {
+		h, m := hits.Value(), misses.Value()
+		if h+m == 0 {
+			return 0.0
+		}
+		return float64(h) / float64(h+m)
+	}))
+}
+
+// setIfLoading 只有占位还是自己的 token 时才回填，
+// 加载期间车辆被修改 (占位被删除) 时不会写入旧数据
+var setIfLoading = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+end
+return false
+`)
+
+// LoadFunc 从数据库查询车辆，不存在时返回 nil, nil
+type LoadFunc func(ctx context.Context, vin string) (*vehiclev1.Vehicle, error)
+
+// Cache 按 VIN 缓存车辆，不区分租户，调用方自行检查权限
+type Cache struct {
+	rdb   *redis.Client
+	ttl   time.Duration
+	group singleflight.Group
+}
+
+// New ttl 为车辆的缓存时间，实际时间随机增加最多 10%，避免同时过期
+func New(rdb *redis.Client, ttl time.Duration) *Cache {
+	return &Cache{rdb: rdb, ttl: ttl}
+}
+
+func key(vin string) string {
+	return keyPrefix + vin
+}
+
+// Get 查询缓存，未命中时调用 load 并回填
+// 同一进程内相同 VIN 的并发请求只加载一次；多个副本之间通过加载占位互斥，其它副本等待回填
+func (c *Cache) Get(ctx context.Context, vin string, load LoadFunc) (*vehiclev1.Vehicle, error) {
+	v, err, _ := c.group.Do(vin, func() (any, error) {
+		//调用方取消不影响同时等待的其它请求
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+		return c.get(ctx, vin, load)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*vehiclev1.Vehicle), nil
+}
+
+func (c *Cache) get(ctx context.Context, vin string, load LoadFunc) (*vehiclev1.Vehicle, error) {
+	k := key(vin)
+	waited := false
+	for {
+		//1.读缓存
+		b, err := c.rdb.Get(ctx, k).Bytes()
+		switch {
+		case errors.Is(err, redis.Nil):
+			//2.未命中: 抢到占位的副本负责加载
+			token := newToken()
+			ok, err := c.rdb.SetNX(ctx, k, token, loadTimeout).Result()
+			if err != nil {
+				return c.fallback(ctx, vin, load, err)
+			}
+			if ok {
+				misses.Add(1)
+				return c.fill(ctx, vin, token, load)
+			}
+			//刚被其它副本抢到，下一轮进入等待
+			continue
+		case err != nil:
+			return c.fallback(ctx, vin, load, err)
+		case len(b) > 0 && b[0] == tagVehicle:
+			v := &vehiclev1.Vehicle{}
+			if err := proto.Unmarshal(b[1:], v); err != nil {
+				return c.fallback(ctx, vin, load, err)
+			}
+			hits.Add(1)
+			return v, nil
+		case len(b) > 0 && b[0] == tagNotFound:
+			hits.Add(1)
+			return nil, nil
+		}
+
+		//3.其它副本正在加载，等待回填；超时后直接查询数据库
+		if !waited {
+			waits.Add(1)
+			waited = true
+		}
+		select {
+		case <-ctx.Done():
+			misses.Add(1)
+			ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+			defer cancel()
+			return load(ctx, vin)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// fill 加载并回填，回填失败不影响返回结果
+func (c *Cache) fill(ctx context.Context, vin, token string, load LoadFunc) (*vehiclev1.Vehicle, error) {
+	v, err := load(ctx, vin)
+	if err != nil {
+		//删除占位，其它副本不用等到过期
+		c.rdb.Del(context.WithoutCancel(ctx), key(vin))
+		return nil, err
+	}
+	value, ttl := []byte{tagNotFound}, notFoundTTL
+	if v != nil {
+		b, err := proto.Marshal(v)
+		if err != nil {
+			return v, nil
+		}
+		value, ttl = append([]byte{tagVehicle}, b...), c.ttl+rand.N(c.ttl/10+1)
+	}
+	if err := setIfLoading.Run(ctx, c.rdb, []string{key(vin)}, token, value, ttl.Milliseconds()).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		errorsCount.Add(1)
+		log.Printf("⚠️ vehicle cache fill %s failed: %v", vin, err)
+	}
+	return v, nil
+}
+
+// fallback Redis 不可用时直接查询数据库
+func (c *Cache) fallback(ctx context.Context, vin string, load LoadFunc, err error) (*vehiclev1.Vehicle, error) {
+	errorsCount.Add(1)
+	misses.Add(1)
+	log.Printf("⚠️ vehicle cache unavailable for %s: %v", vin, err)
+	return load(ctx, vin)
+}
+
+// Delete 删除缓存，正在进行的加载不会再回填
+func (c *Cache) Delete(ctx context.Context, vins ...string) error {
+	if len(vins) == 0 {
+		return nil
+	}
+	keys := make([]string, len(vins))
+	for i, vin := range vins {
+		keys[i] = key(vin)
+	}
+	invalidations.Add(int64(len(vins)))
+	return c.rdb.Del(ctx, keys...).Err()
+}
+
+// Subscribe 处理失效消息，ctx 取消时退出
+func (c *Cache) Subscribe(ctx context.Context) {
+	sub := c.rdb.Subscribe(ctx, InvalidateChannel)
+	defer sub.Close()
+	log.Printf("👂 Vehicle cache subscribing to Redis channel: %s", InvalidateChannel)
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			vins := strings.FieldsFunc(msg.Payload, func(r rune) bool { return r == ',' || r == ' ' })
+			if err := c.Delete(ctx, vins...); err != nil {
+				log.Printf("⚠️ vehicle cache invalidate %v failed: %v", vins, err)
+			}
+		}
+	}
+}
+
+// Invalidate 发布失效消息，供绕过 vehicle service 修改车辆数据的程序使用
+func Invalidate(ctx context.Context, rdb *redis.Client, vins ...string) error {
+	return rdb.Publish(ctx, InvalidateChannel, strings.Join(vins, ",")).Err()
+}
+
+func newToken() string {
+	b := make([]byte, 8)
+	crand.Read(b)
+	return string(tagLoading) + hex.EncodeToString(b)
+}
@@ -0,0 +1,77 @@
+// Package vin 校验车辆识别代号 (ISO 3779 / GB 16735)
+// VIN 固定 17 位，只包含数字和除 I、O、Q 以外的大写字母，第 9 位是校验位。
+package vin
+
+import (
+	"errors"
+	"strings"
+)
+
+// Length VIN 固定长度
+const Length = 17
+
+var (
+	ErrLength     = errors.New("VIN 必须为 17 位")
+	ErrCharacter  = errors.New("VIN 只能包含数字和除 I、O、Q 以外的字母")
+	ErrCheckDigit = errors.New("VIN 校验位错误")
+)
+
+// weights 各位置的加权系数，第 9 位是校验位本身，系数为 0
+var weights = [Length]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// value 字符对应的数值，字母按 ISO 3779 附录的对照表转换，非法字符返回 -1
+func value(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1
+	case c == 'P':
+		return 7
+	case c == 'R':
+		return 9
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2
+	default:
+		return -1
+	}
+}
+
+// Normalize 去掉首尾空白并转为大写
+func Normalize(v string) string {
+	return strings.ToUpper(strings.TrimSpace(v))
+}
+
+// CheckDigit 计算校验位，v 必须是 17 位合法字符，第 9 位的值不参与计算
+func CheckDigit(v string) (byte, error) {
+	if len(v) != Length {
+		return 0, ErrLength
+	}
+	sum := 0
+	for i := 0; i < Length; i++ {
+		n := value(v[i])
+		if n < 0 {
+			return 0, ErrCharacter
+		}
+		sum += n * weights[i]
+	}
+	if r := sum % 11; r != 10 {
+		return byte('0' + r), nil
+	}
+	return 'X', nil
+}
+
+// Validate 校验已经 Normalize 过的 VIN
+// lenient 为 true 时不检查校验位，用于测试数据和部分不填写校验位的进口车
+func Validate(v string, lenient bool) error {
+	c, err := CheckDigit(v)
+	if err != nil {
+		return err
+	}
+	if !lenient && v[8] != c {
+		return ErrCheckDigit
+	}
+	return nil
+}
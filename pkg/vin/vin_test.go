@@ -0,0 +1,65 @@
+package vin
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckDigit(t *testing.T) {
+	tests := []struct {
+		vin  string
+		want byte
+	}{
+		{"1M8GDM9AXKP042788", 'X'}, // ISO 3779 附录中的示例，余数为 10
+		{"11111111111111111", '1'},
+		{"1HGCM82633A004352", '3'},
+		{"LCHEYA0T8TS000001", '8'},
+		{"LSVAU2180N2183294", 'X'}, // 第 9 位的值不参与计算
+	}
+	for _, tt := range tests {
+		got, err := CheckDigit(tt.vin)
+		if err != nil {
+			t.Errorf("CheckDigit(%q) error: %v", tt.vin, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("CheckDigit(%q) = %c, want %c", tt.vin, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		vin     string
+		lenient bool
+		want    error
+	}{
+		{"valid", "1M8GDM9AXKP042788", false, nil},
+		{"valid digit", "1HGCM82633A004352", false, nil},
+		{"bad check digit", "1M8GDM9A1KP042788", false, ErrCheckDigit},
+		{"bad check digit lenient", "1M8GDM9A1KP042788", true, nil},
+		{"letter I", "1M8GDM9AXKI042788", false, ErrCharacter},
+		{"letter O", "1M8GDM9AXKO042788", false, ErrCharacter},
+		{"letter Q", "1M8GDM9AXKQ042788", true, ErrCharacter},
+		{"lowercase", "1m8gdm9axkp042788", false, ErrCharacter},
+		{"symbol", "1M8GDM9AXKP-42788", true, ErrCharacter},
+		{"too short", "1M8GDM9AXKP04278", false, ErrLength},
+		{"too long", "1M8GDM9AXKP0427880", false, ErrLength},
+		{"old test vin", "VIN-TEST-150405", true, ErrLength},
+		{"empty", "", true, ErrLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(tt.vin, tt.lenient); !errors.Is(err, tt.want) {
+				t.Errorf("Validate(%q, %v) = %v, want %v", tt.vin, tt.lenient, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	if got := Normalize("  1m8gdm9axkp042788\n"); got != "1M8GDM9AXKP042788" {
+		t.Errorf("Normalize = %q", got)
+	}
+}
@@ -0,0 +1,266 @@
+// mockoidc 本地调试用的模拟 OIDC IdP
+// 支持 discovery、授权码 + PKCE、JWKS，授权页面可以填写任意用户名和组，方便验证自动建号和角色映射
+//
+// 启动:
+//
+//	go run ./tools/mockoidc
+//
+// auth service 配置:
+//
+//	OIDC_ISSUER=http://localhost:9998 OIDC_CLIENT_ID=cheya OIDC_CLIENT_SECRET=cheya-secret \
+//	OIDC_ROLE_MAPPING="fleet-admins=admin,dispatchers=operator" OIDC_DEFAULT_ROLE=viewer go run ./apps/auth
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"html/template"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const keyID = "mock-1"
+
+// authRequest 已签发的授权码
+type authRequest struct {
+	clientID      string
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	subject       string
+	username      string
+	email         string
+	groups        []string
+	expiresAt     time.Time
+}
+
+type provider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	key          *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]*authRequest
+}
+
+func main() {
+	addr := flag.String("addr", ":9998", "listen address")
+	issuer := flag.String("issuer", "http://localhost:9998", "issuer URL, must match OIDC_ISSUER")
+	clientID := flag.String("client-id", "cheya", "client id")
+	clientSecret := flag.String("client-secret", "cheya-secret", "client secret")
+	flag.Parse()
+
+	//每次启动生成新的签名密钥，auth service 会按 kid 重新拉取 JWKS
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("❌ generate key: %v", err)
+	}
+	p := &provider{
+		issuer:       strings.TrimSuffix(*issuer, "/"),
+		clientID:     *clientID,
+		clientSecret: *clientSecret,
+		key:          key,
+		codes:        make(map[string]*authRequest),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("GET /jwks", p.jwks)
+	mux.HandleFunc("GET /authorize", p.authorizePage)
+	mux.HandleFunc("POST /authorize", p.authorize)
+	mux.HandleFunc("POST /token", p.token)
+
+	log.Printf("🪪 Mock OIDC issuer %s listening on %s (client_id=%s)", p.issuer, *addr, p.clientID)
+	log.Fatal(http.ListenAndServe(*addr, mux))
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+// oauthError RFC 6749 5.2 错误响应
+func oauthError(w http.ResponseWriter, code int, errCode, description string) {
+	writeJSON(w, code, map[string]string{"error": errCode, "error_description": description})
+}
+
+func (p *provider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"scopes_supported":                      []string{"openid", "profile", "email"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (p *provider) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+var authorizeTmpl = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Mock OIDC Login</title></head>
+<body style="font-family: sans-serif; max-width: 420px; margin: 60px auto;">
+    <h2>🪪 Mock OIDC Login</h2>
+    <form method="POST" action="/authorize?{{.Query}}">
+        <p>Username<br><input name="username" value="alice" required></p>
+        <p>Email<br><input name="email" value="alice@example.com"></p>
+        <p>Groups (comma separated)<br><input name="groups" value="dispatchers"></p>
+        <button type="submit">Sign in</button>
+    </form>
+</body>
+</html>`))
+
+// authorizePage 授权页面，校验参数后让调试者填写要模拟的用户
+func (p *provider) authorizePage(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != p.clientID || q.Get("response_type") != "code" || q.Get("redirect_uri") == "" {
+		http.Error(w, "invalid client_id, response_type or redirect_uri", http.StatusBadRequest)
+		return
+	}
+	authorizeTmpl.Execute(w, map[string]string{"Query": r.URL.RawQuery})
+}
+
+// authorize 签发授权码并跳回 redirect_uri
+func (p *provider) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != p.clientID || q.Get("redirect_uri") == "" {
+		http.Error(w, "invalid client_id or redirect_uri", http.StatusBadRequest)
+		return
+	}
+	username := strings.TrimSpace(r.FormValue("username"))
+	if username == "" {
+		http.Error(w, "username is required", http.StatusBadRequest)
+		return
+	}
+	var groups []string
+	for _, g := range strings.Split(r.FormValue("groups"), ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+
+	code := rand.Text()
+	p.mu.Lock()
+	p.codes[code] = &authRequest{
+		clientID:      p.clientID,
+		redirectURI:   q.Get("redirect_uri"),
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		subject:       "mock|" + username,
+		username:      username,
+		email:         strings.TrimSpace(r.FormValue("email")),
+		groups:        groups,
+		expiresAt:     time.Now().Add(time.Minute),
+	}
+	p.mu.Unlock()
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", q.Get("state"))
+	redirect.RawQuery = params.Encode()
+	log.Printf("✅ Issued code for %s groups=%v", username, groups)
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// token 用授权码换取 ID token
+func (p *provider) token(w http.ResponseWriter, r *http.Request) {
+	//1.客户端认证，支持 client_secret_basic 和 client_secret_post
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID, secret = r.FormValue("client_id"), r.FormValue("client_secret")
+	}
+	if clientID != p.clientID || secret != p.clientSecret {
+		oauthError(w, http.StatusUnauthorized, "invalid_client", "bad client credentials")
+		return
+	}
+	if r.FormValue("grant_type") != "authorization_code" {
+		oauthError(w, http.StatusBadRequest, "unsupported_grant_type", "only authorization_code is supported")
+		return
+	}
+
+	//2.授权码只能使用一次
+	p.mu.Lock()
+	req := p.codes[r.FormValue("code")]
+	delete(p.codes, r.FormValue("code"))
+	p.mu.Unlock()
+	if req == nil || time.Now().After(req.expiresAt) || req.redirectURI != r.FormValue("redirect_uri") {
+		oauthError(w, http.StatusBadRequest, "invalid_grant", "unknown or expired code")
+		return
+	}
+
+	//3.PKCE
+	if req.codeChallenge != "" {
+		sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != req.codeChallenge {
+			oauthError(w, http.StatusBadRequest, "invalid_grant", "code_verifier mismatch")
+			return
+		}
+	}
+
+	//4.签发 ID token
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":                p.issuer,
+		"sub":                req.subject,
+		"aud":                req.clientID,
+		"iat":                now.Unix(),
+		"exp":                now.Add(5 * time.Minute).Unix(),
+		"preferred_username": req.username,
+		"groups":             req.groups,
+	}
+	if req.nonce != "" {
+		claims["nonce"] = req.nonce
+	}
+	if req.email != "" {
+		claims["email"] = req.email
+		claims["email_verified"] = true
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	t.Header["kid"] = keyID
+	idToken, err := t.SignedString(p.key)
+	if err != nil {
+		oauthError(w, http.StatusInternalServerError, "server_error", err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": rand.Text(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,13 +36,22 @@ func main() {
 	//2.redis client
 	rdb := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
 
-	//VIN
-	vehicleID := "VIN-TEST-SIM-01"
+	//VIN: 17 位且校验位正确，CreateVehicle 才会接受
+	vehicleID := "LCHEYA0T8TS000001"
+
+	//设备凭证: 先创建该车辆并开通车载终端 (POST /api/v1/vehicles/LCHEYA0T8TS000001/devices)，
+	//再通过 DEVICE_CREDENTIAL 环境变量传入，否则遥测服务会丢弃上报的数据
+	credential := os.Getenv("DEVICE_CREDENTIAL")
+	if credential == "" {
+		log.Fatalf("❌ DEVICE_CREDENTIAL is not set, provision a device for %s first", vehicleID)
+	}
 
 	//控制标志位 - 使用 mutex 保护并发访问
 	var mu sync.Mutex
 	isRunning := true
 	wasStopped := false // 用于跟踪是否已经打印过停止日志
+	speedLimit := 0.0   // 限速 (km/h)，0 表示不限速
+	locked := false     // 上锁后停车，解锁前不响应启动指令
 
 	//启动指令监听协程
 	go func() {
@@ -54,10 +66,35 @@ func main() {
 				isRunning = false
 				mu.Unlock()
 			} else if msg.Payload == "START:"+vehicleID {
-				log.Println("▶️ 收到远程启动指令")
 				mu.Lock()
-				isRunning = true
-				wasStopped = false // 重置标志
+				if locked {
+					log.Println("⚠️ 车辆已上锁，忽略启动指令")
+				} else {
+					log.Println("▶️ 收到远程启动指令")
+					isRunning = true
+					wasStopped = false // 重置标志
+				}
+				mu.Unlock()
+			} else if msg.Payload == "LOCK:"+vehicleID {
+				log.Println("🔒 收到上锁指令，车辆停止")
+				mu.Lock()
+				locked = true
+				isRunning = false
+				mu.Unlock()
+			} else if msg.Payload == "UNLOCK:"+vehicleID {
+				log.Println("🔓 收到解锁指令，等待启动指令")
+				mu.Lock()
+				locked = false
+				mu.Unlock()
+			} else if strings.HasPrefix(msg.Payload, "SET_SPEED_LIMIT:"+vehicleID+":") {
+				limit, err := strconv.ParseFloat(strings.TrimPrefix(msg.Payload, "SET_SPEED_LIMIT:"+vehicleID+":"), 64)
+				if err != nil {
+					log.Printf("⚠️ 限速指令参数错误: %s", msg.Payload)
+					continue
+				}
+				log.Printf("🚦 收到限速指令: %.0f km/h", limit)
+				mu.Lock()
+				speedLimit = limit
 				mu.Unlock()
 			}
 		}
@@ -73,6 +110,7 @@ func main() {
 		mu.Lock()
 		running := isRunning
 		stopped := wasStopped
+		limit := speedLimit
 		mu.Unlock()
 
 		if !running {
@@ -97,6 +135,9 @@ func main() {
 		lat += (rand.Float64() - 0.5) * 0.001
 		lon += (rand.Float64() - 0.5) * 0.001
 		speed := 40.0 + (rand.Float64() * 40.0)
+		if limit > 0 && speed > limit {
+			speed = limit
+		}
 
 		//2.组装数据
 		data := TelemetryData{
@@ -113,6 +154,9 @@ func main() {
 			kafka.Message{
 				Key:   []byte(vehicleID), //保证同一辆车有序
 				Value: jsonData,
+				Headers: []kafka.Header{
+					{Key: "authorization", Value: []byte("Bearer " + credential)},
+				},
 			},
 		)
 